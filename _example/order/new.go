@@ -70,18 +70,6 @@ func main() {
 			//	Quantity: 1,
 			//	Type:     orderpb.OrderItem_sku,
 			//},
-			{
-				Amount:      -1000,
-				Description: "on the fly discount without parent",
-				Currency:    paymentpb.Currency_USD,
-				Type:        orderpb.OrderItem_discount,
-			},
-			{
-				Amount:      1000,
-				Description: "Tax (Included)",
-				Currency:    paymentpb.Currency_USD,
-				Type:        orderpb.OrderItem_tax,
-			},
 		},
 		Email: "yaron@digota.com",
 		Shipping: &orderpb.Shipping{
@@ -24,6 +24,7 @@ package main
 
 import (
 	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/payment/paymentpb"
 	"github.com/digota/digota/sdk"
 	"golang.org/x/net/context"
 	"log"
@@ -48,6 +49,14 @@ func main() {
 	// Return order
 	log.Println(orderpb.NewOrderServiceClient(c).Return(context.Background(), &orderpb.ReturnRequest{
 		Id: "order-uuid",
+		Items: []*orderpb.ReturnItem{
+			{
+				Parent:   "sku-uuid",
+				Quantity: 1,
+				Restock:  true,
+			},
+		},
+		Reason: paymentpb.RefundReason_RequestedByCustomer,
 	}))
 
 }
@@ -23,11 +23,19 @@
 package acl
 
 import (
+	"github.com/digota/digota/admin"
+	"github.com/digota/digota/cart"
 	"github.com/digota/digota/client"
+	"github.com/digota/digota/customer"
+	"github.com/digota/digota/invoice"
 	"github.com/digota/digota/order"
 	"github.com/digota/digota/payment"
 	"github.com/digota/digota/product"
+	"github.com/digota/digota/promotion"
+	"github.com/digota/digota/shipping"
 	"github.com/digota/digota/sku"
+	"github.com/digota/digota/subscription"
+	"github.com/digota/digota/tax"
 	"golang.org/x/net/context"
 	"regexp"
 )
@@ -62,6 +70,13 @@ var accessMap = map[client.Scope][][]*regexp.Regexp{
 		sku.WriteMethods(),
 		order.WriteMethods(),
 		product.WriteMethods(),
+		promotion.WriteMethods(),
+		shipping.WriteMethods(),
+		invoice.WriteMethods(),
+		subscription.WriteMethods(),
+		cart.WriteMethods(),
+		customer.WriteMethods(),
+		tax.WriteMethods(),
 	},
 	// Read only methods
 	client.ReadScope: {
@@ -69,6 +84,18 @@ var accessMap = map[client.Scope][][]*regexp.Regexp{
 		sku.ReadMethods(),
 		order.ReadMethods(),
 		product.ReadMethods(),
+		promotion.ReadMethods(),
+		shipping.ReadMethods(),
+		invoice.ReadMethods(),
+		subscription.ReadMethods(),
+		cart.ReadMethods(),
+		customer.ReadMethods(),
+		tax.ReadMethods(),
+	},
+	// Admin methods
+	client.AdminScope: {
+		admin.ReadMethods(),
+		admin.WriteMethods(),
 	},
 }
 
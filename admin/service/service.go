@@ -0,0 +1,159 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"time"
+
+	adminInterface "github.com/digota/digota/admin"
+	"github.com/digota/digota/admin/adminpb"
+	"github.com/digota/digota/exchange"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/locker/metrics"
+	"github.com/digota/digota/validation"
+	"golang.org/x/net/context"
+)
+
+func init() {
+	adminInterface.RegisterService(&adminService{})
+}
+
+type adminService struct{}
+
+// ListLocks returns the locks held by all the nodes, oldest first
+func (s *adminService) ListLocks(ctx context.Context, req *adminpb.ListLocksRequest) (*adminpb.LockList, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	locks, err := locker.Handler().Locks()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	list := &adminpb.LockList{}
+
+	for _, v := range locks {
+		if req.GetNamespace() != "" && req.GetNamespace() != v.Namespace {
+			continue
+		}
+		list.Locks = append(list.Locks, &adminpb.Lock{
+			Namespace: v.Namespace,
+			Id:        v.Id,
+			Owner:     v.Owner,
+			Acquired:  v.Acquired.Unix(),
+			Age:       millis(now.Sub(v.Acquired)),
+		})
+	}
+
+	list.Total = int32(len(list.Locks))
+
+	return list, nil
+
+}
+
+// LockStats returns the lock activity of this node per namespace since
+// start up
+func (s *adminService) LockStats(ctx context.Context, req *adminpb.LockStatsRequest) (*adminpb.LockStatsList, error) {
+
+	list := &adminpb.LockStatsList{Node: metrics.Node()}
+
+	for _, v := range locker.Handler().Metrics() {
+		stats := &adminpb.LockStats{
+			Namespace:      v.Namespace,
+			Acquired:       v.Acquired,
+			Timeouts:       v.Timeouts,
+			Failures:       v.Failures,
+			AcquireTimeMax: millis(v.MaxAcquireTime),
+			HoldTimeMax:    millis(v.MaxHoldTime),
+		}
+		if v.Acquired > 0 {
+			stats.AcquireTimeAvg = millis(v.AcquireTime) / v.Acquired
+			stats.HoldTimeAvg = millis(v.HoldTime) / v.Acquired
+		}
+		list.Stats = append(list.Stats, stats)
+	}
+
+	return list, nil
+
+}
+
+// ReleaseLock force releases a lock, no matter who holds it
+func (s *adminService) ReleaseLock(ctx context.Context, req *adminpb.ReleaseLockRequest) (*adminpb.Empty, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	if err := locker.Handler().Release(req.GetNamespace(), req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &adminpb.Empty{}, nil
+
+}
+
+// GetExchangeRates returns the exchange rates table orders are converted by
+func (s *adminService) GetExchangeRates(ctx context.Context, req *adminpb.GetExchangeRatesRequest) (*adminpb.ExchangeRates, error) {
+	return exchangeRates(exchange.Get()), nil
+}
+
+// SetExchangeRates stores the exchange rates table of all nodes, orders
+// keep the rates they were priced by
+func (s *adminService) SetExchangeRates(ctx context.Context, req *adminpb.SetExchangeRatesRequest) (*adminpb.ExchangeRates, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	table, err := exchange.Parse(req.GetBase(), req.GetRates())
+	if err != nil {
+		return nil, err
+	}
+	if err := exchange.Save(ctx, table); err != nil {
+		return nil, err
+	}
+
+	return exchangeRates(table), nil
+
+}
+
+func exchangeRates(t *exchange.Table) *adminpb.ExchangeRates {
+	rates := &adminpb.ExchangeRates{}
+	if t == nil {
+		return rates
+	}
+	rates.Base = t.Base.String()
+	rates.Updated = t.Updated.Unix()
+	rates.Rates = make(map[string]float64, len(t.Rates))
+	for k, v := range t.Rates {
+		rates.Rates[k.String()] = v
+	}
+	return rates
+}
+
+func millis(d time.Duration) int64 {
+	return int64(d / time.Millisecond)
+}
@@ -0,0 +1,210 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"github.com/digota/digota/admin/adminpb"
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/exchange"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/storage"
+	"github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"os"
+	"testing"
+	"time"
+)
+
+var service = &adminService{}
+
+type testObj struct {
+	Id string
+}
+
+func (o *testObj) GetNamespace() string {
+	return "admin_test"
+}
+
+func (o *testObj) GetId() string {
+	return o.Id
+}
+
+var db = "testing" + uuid.NewV4().String()
+
+func TestMain(m *testing.M) {
+
+	// setup
+	if err := storage.New(config.Storage{
+		Address:  []string{"localhost"},
+		Handler:  "mongodb",
+		Database: db,
+	}); err != nil {
+		panic(err)
+	}
+
+	// in-memory locker
+	locker.New(config.Locker{})
+
+	retCode := m.Run()
+	// teardown
+	storage.Handler().DropDatabase(db)
+	os.Exit(retCode)
+}
+
+func TestAdminService_ListLocks(t *testing.T) {
+
+	obj := &testObj{Id: uuid.NewV4().String()}
+
+	unlock, err := locker.Handler().TryLock(obj, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unlock()
+
+	list, err := service.ListLocks(context.Background(), &adminpb.ListLocksRequest{Namespace: obj.GetNamespace()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if list.GetTotal() != 1 || list.Locks[0].GetId() != obj.GetId() || list.Locks[0].GetOwner() == "" {
+		t.Fatal(list)
+	}
+
+	// other namespace
+	list, err = service.ListLocks(context.Background(), &adminpb.ListLocksRequest{Namespace: "order"})
+	if err != nil || list.GetTotal() != 0 {
+		t.Fatal(list, err)
+	}
+
+}
+
+func TestAdminService_LockStats(t *testing.T) {
+
+	obj := &testObj{Id: uuid.NewV4().String()}
+
+	unlock, err := locker.Handler().TryLock(obj, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlock()
+
+	list, err := service.LockStats(context.Background(), &adminpb.LockStatsRequest{})
+	if err != nil || list.GetNode() == "" {
+		t.Fatal(list, err)
+	}
+
+	for _, v := range list.GetStats() {
+		if v.GetNamespace() == obj.GetNamespace() && v.GetAcquired() > 0 {
+			return
+		}
+	}
+
+	t.Fatal(list)
+
+}
+
+func TestAdminService_ReleaseLock(t *testing.T) {
+
+	obj := &testObj{Id: uuid.NewV4().String()}
+
+	if _, err := locker.Handler().TryLock(obj, time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.ReleaseLock(context.Background(), &adminpb.ReleaseLockRequest{
+		Namespace: obj.GetNamespace(),
+		Id:        obj.GetId(),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// lock is free again
+	unlock, err := locker.Handler().TryLock(obj, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlock()
+
+	// not held
+	if _, err := service.ReleaseLock(context.Background(), &adminpb.ReleaseLockRequest{
+		Namespace: obj.GetNamespace(),
+		Id:        obj.GetId(),
+	}); status.Code(err) != codes.NotFound {
+		t.Fatal(err)
+	}
+
+	// bad request
+	if _, err := service.ReleaseLock(context.Background(), &adminpb.ReleaseLockRequest{}); err == nil {
+		t.Fatal()
+	}
+
+}
+
+func TestAdminService_SetExchangeRates(t *testing.T) {
+
+	defer exchange.Set(nil)
+
+	rates, err := service.GetExchangeRates(context.Background(), &adminpb.GetExchangeRatesRequest{})
+	if err != nil || rates.GetBase() != "" {
+		t.Fatal(rates, err)
+	}
+
+	rates, err = service.SetExchangeRates(context.Background(), &adminpb.SetExchangeRatesRequest{
+		Base:  "USD",
+		Rates: map[string]float64{"eur": 0.5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rates.GetBase() != "USD" || rates.GetRates()["EUR"] != 0.5 || rates.GetRates()["USD"] != 1 {
+		t.Fatal(rates)
+	}
+
+	rates, err = service.GetExchangeRates(context.Background(), &adminpb.GetExchangeRatesRequest{})
+	if err != nil || rates.GetRates()["EUR"] != 0.5 {
+		t.Fatal(rates, err)
+	}
+
+	// the table of this node gives way to the stored one
+	exchange.Set(nil)
+	rates, err = service.GetExchangeRates(context.Background(), &adminpb.GetExchangeRatesRequest{})
+	if err != nil || rates.GetRates()["EUR"] != 0.5 {
+		t.Fatal(rates, err)
+	}
+
+	// invalid rate
+	if _, err := service.SetExchangeRates(context.Background(), &adminpb.SetExchangeRatesRequest{
+		Base:  "USD",
+		Rates: map[string]float64{"EUR": -1},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+
+	// bad request
+	if _, err := service.SetExchangeRates(context.Background(), &adminpb.SetExchangeRatesRequest{}); err == nil {
+		t.Fatal()
+	}
+
+}
@@ -0,0 +1,139 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cart
+
+import (
+	"github.com/digota/digota/cart/cartpb"
+	"github.com/digota/digota/config"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"regexp"
+	"time"
+)
+
+const (
+	baseMethod = "^(.cartpb.CartService/)"
+	// DefaultTTL is the time an open cart is kept since its last change if
+	// no ttl is configured
+	DefaultTTL = time.Hour * 24 * 7
+	// DefaultSweepInterval is the time between abandoned carts sweeps if no
+	// interval is configured
+	DefaultSweepInterval = time.Minute * 10
+)
+
+var service Interface
+
+var conf config.Cart
+
+// Interface defines the functionality of the cart service
+type Interface interface {
+	cartpb.CartServiceServer
+}
+
+// Expirer is implemented by services which remove abandoned carts, Expire
+// returns the number of carts it removed
+type Expirer interface {
+	Expire(ctx context.Context) (int, error)
+}
+
+// New saves the cart config and starts the abandoned carts sweeper of the
+// registered service in the background
+func New(c config.Cart) {
+	conf = c
+	if e, ok := Service().(Expirer); ok {
+		go sweep(e, SweepInterval())
+	}
+}
+
+// TTL returns the configured cart ttl
+func TTL() time.Duration {
+	if conf.TTL <= 0 {
+		return DefaultTTL
+	}
+	return conf.TTL
+}
+
+// SweepInterval returns the configured time between abandoned carts sweeps
+func SweepInterval() time.Duration {
+	if conf.SweepInterval <= 0 {
+		return DefaultSweepInterval
+	}
+	return conf.SweepInterval
+}
+
+// sweep removes abandoned carts every interval
+func sweep(e Expirer, interval time.Duration) {
+	for range time.Tick(interval) {
+		n, err := e.Expire(context.Background())
+		switch {
+		// another node is sweeping
+		case status.Code(err) == codes.Aborted:
+		case err != nil:
+			log.Warnf("Cart expiry sweep failed => %s", err.Error())
+		case n > 0:
+			log.Infof("Cart expiry sweep removed %d carts", n)
+		}
+	}
+}
+
+// RegisterService register p as the service provider
+func RegisterService(p Interface) {
+	if service != nil {
+		panic("CartService is already registered")
+	}
+	service = p
+}
+
+// RegisterCartServer register service to the grpc server
+func RegisterCartServer(server *grpc.Server) {
+	cartpb.RegisterCartServiceServer(server, Service())
+}
+
+// Service returns the registered service
+func Service() Interface {
+	if service == nil {
+		panic("CartService is not registered")
+	}
+	return service
+}
+
+// ReadMethods returns regexp slice of readable methods, mostly used by the acl
+func ReadMethods() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		regexp.MustCompile(baseMethod + "Get"),
+	}
+}
+
+// WriteMethods returns regexp slice of writable methods, mostly used by the acl
+func WriteMethods() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		regexp.MustCompile(baseMethod + "New"),
+		regexp.MustCompile(baseMethod + "AddItem"),
+		regexp.MustCompile(baseMethod + "UpdateItem"),
+		regexp.MustCompile(baseMethod + "RemoveItem"),
+		regexp.MustCompile(baseMethod + "Checkout"),
+	}
+}
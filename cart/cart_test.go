@@ -0,0 +1,161 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cart
+
+import (
+	"github.com/digota/digota/cart/cartpb"
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/order/orderpb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+)
+
+// dummy service
+type dummyService struct{}
+
+// dummy implementations
+func (s *dummyService) New(context.Context, *cartpb.NewRequest) (*cartpb.Cart, error) {
+	return nil, nil
+}
+func (s *dummyService) Get(context.Context, *cartpb.GetRequest) (*cartpb.Cart, error) {
+	return nil, nil
+}
+func (s *dummyService) AddItem(context.Context, *cartpb.AddItemRequest) (*cartpb.Cart, error) {
+	return nil, nil
+}
+func (s *dummyService) UpdateItem(context.Context, *cartpb.UpdateItemRequest) (*cartpb.Cart, error) {
+	return nil, nil
+}
+func (s *dummyService) RemoveItem(context.Context, *cartpb.RemoveItemRequest) (*cartpb.Cart, error) {
+	return nil, nil
+}
+func (s *dummyService) Checkout(context.Context, *cartpb.CheckoutRequest) (*orderpb.Order, error) {
+	return nil, nil
+}
+
+// dummy expirer
+type dummyExpirer struct {
+	dummyService
+	swept chan struct{}
+}
+
+func (s *dummyExpirer) Expire(context.Context) (int, error) {
+	select {
+	case s.swept <- struct{}{}:
+	default:
+	}
+	return 0, nil
+}
+
+func TestNew(t *testing.T) {
+	defer func() { conf = config.Cart{} }()
+
+	e := &dummyExpirer{swept: make(chan struct{}, 1)}
+	service = e
+
+	New(config.Cart{TTL: time.Hour, SweepInterval: time.Millisecond})
+
+	if TTL() != time.Hour || SweepInterval() != time.Millisecond {
+		t.Fatal(conf)
+	}
+
+	select {
+	case <-e.swept:
+	case <-time.After(time.Second):
+		t.Fatal("sweeper did not run")
+	}
+}
+
+func TestTTL(t *testing.T) {
+	conf = config.Cart{}
+	if TTL() != DefaultTTL || SweepInterval() != DefaultSweepInterval {
+		t.Fatal()
+	}
+}
+
+func TestRegisterCartServer(t *testing.T) {
+	service = &dummyService{}
+	server := grpc.NewServer()
+	RegisterCartServer(server)
+}
+
+func TestRegisterService(t *testing.T) {
+	service = nil
+	s := &dummyService{}
+	RegisterService(s)
+	if !reflect.DeepEqual(service, s) {
+		t.Fatal()
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal(r)
+		}
+	}()
+	RegisterService(s)
+}
+
+func TestService(t *testing.T) {
+	service = &dummyService{}
+	if !reflect.DeepEqual(Service(), service) {
+		t.FailNow()
+	}
+	service = nil
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal(r)
+		}
+	}()
+	Service()
+}
+
+func TestReadMethods(t *testing.T) {
+	methods := []*regexp.Regexp{
+		regexp.MustCompile(baseMethod + "Get"),
+	}
+	// check methods in same order
+	for k, v := range ReadMethods() {
+		if v.String() != methods[k].String() {
+			t.FailNow()
+		}
+	}
+}
+
+func TestWriteMethods(t *testing.T) {
+	methods := []*regexp.Regexp{
+		regexp.MustCompile(baseMethod + "New"),
+		regexp.MustCompile(baseMethod + "AddItem"),
+		regexp.MustCompile(baseMethod + "UpdateItem"),
+		regexp.MustCompile(baseMethod + "RemoveItem"),
+		regexp.MustCompile(baseMethod + "Checkout"),
+	}
+	// check methods in same order
+	for k, v := range WriteMethods() {
+		if v.String() != methods[k].String() {
+			t.FailNow()
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"github.com/digota/digota/cart/cartpb"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/storage"
+	"github.com/digota/digota/storage/object"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+// sweepBatch is the max number of carts removed by a single sweep
+const sweepBatch = 500
+
+// sweeper is locked by the expiry sweep, the node holding the lock is the
+// only one sweeping
+type sweeper struct{}
+
+// implements object.Interface interface
+func (s *sweeper) GetNamespace() string { return ns }
+
+// implements object.Interface interface
+func (s *sweeper) GetId() string { return "sweeper" }
+
+// Expire implements the cart.Expirer interface.
+// Removes the open carts which were not changed for the cart ttl, returns
+// the number of removed carts.
+func (s *cartService) Expire(ctx context.Context) (int, error) {
+	// only one node sweeps at a time
+	unlock, err := locker.Handler().TryLockContext(ctx, &sweeper{}, locker.DefaultTimeout)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	now := time.Now()
+
+	slice := carts{}
+	if _, err := storage.Handler().List(&slice, object.ListOpt{
+		Limit: sweepBatch,
+		Sort:  object.SortCreatedAsc,
+		Filters: []object.Filter{
+			{Field: "status", Op: object.OpEq, Value: cartpb.Cart_Open},
+			{Field: "expires", Op: object.OpGt, Value: 0},
+			{Field: "expires", Op: object.OpLt, Value: now.Unix()},
+		},
+	}); err != nil {
+		return 0, err
+	}
+
+	var n int
+	for _, v := range slice {
+		removed, err := expire(ctx, v.GetId(), now)
+		if err != nil {
+			log.Warnf("Could not expire cart %s => %s", v.GetId(), err.Error())
+			continue
+		}
+		if removed {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// expire removes the cart if it is still expired, returns whether it was
+// removed
+func expire(ctx context.Context, id string, now time.Time) (bool, error) {
+	c := &cart{
+		Cart: cartpb.Cart{
+			Id: id,
+		},
+	}
+	unlock, err := locker.Handler().TryLockContext(ctx, c, locker.DefaultTimeout)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	// it may have changed since listed
+	if err := storage.Handler().One(c); err != nil {
+		return false, err
+	}
+	if !c.expired(now) {
+		return false, nil
+	}
+
+	return true, storage.Handler().Remove(c)
+}
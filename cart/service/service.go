@@ -0,0 +1,393 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	cartInterface "github.com/digota/digota/cart"
+	"github.com/digota/digota/cart/cartpb"
+	"github.com/digota/digota/exchange"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/order"
+	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/sku"
+	"github.com/digota/digota/sku/skupb"
+	"github.com/digota/digota/storage"
+	"github.com/digota/digota/util"
+	"github.com/digota/digota/validation"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+const ns = "cart"
+
+func init() {
+	cartInterface.RegisterService(&cartService{})
+}
+
+type carts []*cartpb.Cart
+
+func (c *carts) GetNamespace() string { return ns }
+
+// Cart wrapper
+type cart struct {
+	cartpb.Cart `bson:",inline"`
+	fence       int64
+}
+
+// implements object.Interface interface
+func (c *cart) GetNamespace() string { return ns }
+
+// implements object.IdSetter interface
+func (c *cart) SetId(id string) { c.Id = id }
+
+// implements object.TimeTracker interface
+func (c *cart) SetCreated(t int64) { c.Created = t }
+
+// implements object.TimeTracker interface
+func (c *cart) SetUpdated(t int64) { c.Updated = t }
+
+// implements object.Fencer interface
+func (c *cart) SetFence(t int64) { c.fence = t }
+
+// implements object.Fencer interface
+func (c *cart) GetFence() int64 { return c.fence }
+
+// expired returns whether the open cart was not changed for the ttl
+func (c *cart) expired(now time.Time) bool {
+	return c.GetStatus() == cartpb.Cart_Open && c.GetExpires() > 0 && c.GetExpires() < now.Unix()
+}
+
+// touch keeps the cart for another ttl
+func (c *cart) touch() {
+	c.Expires = time.Now().Add(cartInterface.TTL()).Unix()
+}
+
+// add adds quantity of the sku to the cart
+func (c *cart) add(sku string, quantity int64) {
+	if v := c.item(sku); v != nil {
+		v.Quantity += quantity
+		return
+	}
+	c.Items = append(c.Items, &cartpb.CartItem{Sku: sku, Quantity: quantity})
+}
+
+// item returns the item of the sku or nil if it is not in the cart
+func (c *cart) item(sku string) *cartpb.CartItem {
+	for _, v := range c.Items {
+		if v.GetSku() == sku {
+			return v
+		}
+	}
+	return nil
+}
+
+// price updates the items by the current sku prices, converted into the cart
+// currency, and sums the amount. missing and inactive skus are marked
+// unavailable and left out of the amount.
+func (c *cart) price(ctx context.Context) error {
+	c.Amount = 0
+	for _, v := range c.Items {
+		item, err := sku.Service().Get(ctx, &skupb.GetRequest{Id: v.GetSku()})
+		if err != nil || !item.GetActive() {
+			v.Amount, v.Unavailable = 0, true
+			continue
+		}
+		amount := int64(item.GetPrice())
+		if item.GetCurrency() != c.GetCurrency() {
+			if amount, _, err = exchange.Convert(amount, item.GetCurrency(), c.GetCurrency()); err != nil {
+				return err
+			}
+		}
+		v.Amount, v.Description, v.Unavailable = amount, item.GetName(), false
+		c.Amount += amount * v.GetQuantity()
+	}
+	return nil
+}
+
+type cartService struct{}
+
+// New creates an open cart of the items, the cart is removed if it is not
+// changed for the cart ttl
+func (s *cartService) New(ctx context.Context, req *cartpb.NewRequest) (*cartpb.Cart, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	if err := validation.Metadata(req.GetMetadata()); err != nil {
+		return nil, err
+	}
+
+	c := &cart{
+		Cart: cartpb.Cart{
+			Currency: req.GetCurrency(),
+			Email:    req.GetEmail(),
+			Shipping: req.GetShipping(),
+			Metadata: req.GetMetadata(),
+			Status:   cartpb.Cart_Open,
+		},
+	}
+
+	for _, v := range req.GetItems() {
+		if err := activeSku(ctx, v.GetSku()); err != nil {
+			return nil, err
+		}
+		c.add(v.GetSku(), v.GetQuantity())
+	}
+
+	c.touch()
+
+	if err := storage.Handler().Insert(c); err != nil {
+		return nil, err
+	}
+
+	return &c.Cart, c.price(ctx)
+
+}
+
+// Get returns the cart priced by the current sku prices
+func (s *cartService) Get(ctx context.Context, req *cartpb.GetRequest) (*cartpb.Cart, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	c := &cart{
+		Cart: cartpb.Cart{
+			Id: req.GetId(),
+		},
+	}
+
+	if err := storage.Handler().One(c); err != nil {
+		return nil, err
+	}
+
+	// it may not be swept yet
+	if c.expired(time.Now()) {
+		return nil, status.Error(codes.NotFound, "Cart has expired.")
+	}
+
+	return &c.Cart, c.price(ctx)
+
+}
+
+// AddItem adds the sku to the cart, or adds to its quantity if it is
+// already in the cart
+func (s *cartService) AddItem(ctx context.Context, req *cartpb.AddItemRequest) (*cartpb.Cart, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	if err := activeSku(ctx, req.GetSku()); err != nil {
+		return nil, err
+	}
+
+	c, unlock, err := lockCart(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	c.add(req.GetSku(), req.GetQuantity())
+
+	return c.save(ctx)
+
+}
+
+// UpdateItem sets the quantity of the sku in the cart
+func (s *cartService) UpdateItem(ctx context.Context, req *cartpb.UpdateItemRequest) (*cartpb.Cart, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	c, unlock, err := lockCart(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	v := c.item(req.GetSku())
+	if v == nil {
+		return nil, status.Errorf(codes.NotFound, "Sku %s is not in the cart.", req.GetSku())
+	}
+	v.Quantity = req.GetQuantity()
+
+	return c.save(ctx)
+
+}
+
+// RemoveItem removes the sku from the cart
+func (s *cartService) RemoveItem(ctx context.Context, req *cartpb.RemoveItemRequest) (*cartpb.Cart, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	c, unlock, err := lockCart(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	items := c.Items[:0]
+	for _, v := range c.Items {
+		if v.GetSku() != req.GetSku() {
+			items = append(items, v)
+		}
+	}
+	if len(items) == len(c.Items) {
+		return nil, status.Errorf(codes.NotFound, "Sku %s is not in the cart.", req.GetSku())
+	}
+	c.Items = items
+
+	return c.save(ctx)
+
+}
+
+// Checkout creates the order of the cart items and closes the cart, the
+// order is then paid by OrderService.Pay. the cart email and shipping are
+// used if the request ones are empty.
+func (s *cartService) Checkout(ctx context.Context, req *cartpb.CheckoutRequest) (*orderpb.Order, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	c, unlock, err := lockCart(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if len(c.GetItems()) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "Cart is empty.")
+	}
+
+	if err := c.price(ctx); err != nil {
+		return nil, err
+	}
+
+	var items []*orderpb.OrderItem
+	for _, v := range c.GetItems() {
+		if v.GetUnavailable() {
+			return nil, status.Errorf(codes.FailedPrecondition, "Sku %s is not available.", v.GetSku())
+		}
+		items = append(items, &orderpb.OrderItem{
+			Type:     orderpb.OrderItem_sku,
+			Parent:   v.GetSku(),
+			Quantity: v.GetQuantity(),
+		})
+	}
+
+	metadata := map[string]string{"cart": c.GetId()}
+	for k, v := range c.GetMetadata() {
+		metadata[k] = v
+	}
+
+	email, shipping := req.GetEmail(), req.GetShipping()
+	if email == "" {
+		email = c.GetEmail()
+	}
+	if shipping == nil {
+		shipping = c.GetShipping()
+	}
+
+	// the cart is checked out once, a retry after a failed save gets the
+	// same order
+	o, err := order.Service().New(ctx, &orderpb.NewRequest{
+		Currency:       c.GetCurrency(),
+		Items:          items,
+		Email:          email,
+		Shipping:       shipping,
+		Metadata:       metadata,
+		Coupons:        req.GetCoupons(),
+		ShippingRate:   req.GetShippingRate(),
+		IdempotencyKey: "cart/" + c.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.Status = cartpb.Cart_CheckedOut
+	c.OrderId = o.GetId()
+	c.Expires = 0
+
+	if err := util.Retry(func() error { return storage.Handler().Update(c) }); err != nil {
+		return nil, status.Error(codes.DataLoss, "Storage could not update object.")
+	}
+
+	return o, nil
+
+}
+
+// save keeps the changed cart for another ttl and returns it priced
+func (c *cart) save(ctx context.Context) (*cartpb.Cart, error) {
+	c.touch()
+	if err := storage.Handler().Update(c); err != nil {
+		return nil, err
+	}
+	return &c.Cart, c.price(ctx)
+}
+
+// activeSku returns an error if the sku is missing or not active
+func activeSku(ctx context.Context, id string) error {
+	item, err := sku.Service().Get(ctx, &skupb.GetRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	if !item.GetActive() {
+		return status.Errorf(codes.FailedPrecondition, "Sku %s is not active.", id)
+	}
+	return nil
+}
+
+// lockCart locks the open cart of id and gets it, unlock must be called
+// when done
+func lockCart(ctx context.Context, id string) (*cart, func() error, error) {
+	c := &cart{
+		Cart: cartpb.Cart{
+			Id: id,
+		},
+	}
+	unlock, err := locker.Handler().TryLockContext(ctx, c, locker.DefaultTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := storage.Handler().One(c); err != nil {
+		unlock()
+		return nil, nil, err
+	}
+	if c.expired(time.Now()) {
+		unlock()
+		return nil, nil, status.Error(codes.NotFound, "Cart has expired.")
+	}
+	if c.GetStatus() != cartpb.Cart_Open {
+		unlock()
+		return nil, nil, status.Error(codes.FailedPrecondition, "Cart is checked out.")
+	}
+	return c, unlock, nil
+}
@@ -0,0 +1,363 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	_ "github.com/digota/digota/order/service"
+	_ "github.com/digota/digota/payment/service"
+	_ "github.com/digota/digota/product/service"
+	_ "github.com/digota/digota/promotion/service"
+	_ "github.com/digota/digota/shipping/service"
+	_ "github.com/digota/digota/sku/service"
+	_ "github.com/digota/digota/tax/service"
+)
+
+import (
+	"github.com/digota/digota/cart/cartpb"
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/exchange"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/payment/paymentpb"
+	"github.com/digota/digota/product"
+	"github.com/digota/digota/product/productpb"
+	"github.com/digota/digota/sku"
+	"github.com/digota/digota/sku/skupb"
+	"github.com/digota/digota/storage"
+	"github.com/icrowley/fake"
+	"github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"os"
+	"testing"
+	"time"
+)
+
+var service = &cartService{}
+var db = "testing-cart-" + uuid.NewV4().String()
+
+func TestMain(m *testing.M) {
+	// storage
+	if err := storage.New(config.Storage{
+		Address:  []string{"localhost"},
+		Handler:  "mongodb",
+		Database: db,
+	}); err != nil {
+		panic(err)
+	}
+
+	// in-memory locker
+	locker.New(config.Locker{})
+
+	retCode := m.Run()
+	storage.Handler().DropDatabase(db)
+	// teardown
+	os.Exit(retCode)
+}
+
+func createSku(currency paymentpb.Currency) (*skupb.Sku, error) {
+	p, err := product.Service().New(context.Background(), &productpb.NewRequest{
+		Active:      true,
+		Name:        fake.Sentences(),
+		Description: fake.Sentences(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sku.Service().New(context.Background(), &skupb.NewRequest{
+		Parent:    p.GetId(),
+		Name:      fake.Sentences(),
+		Currency:  currency,
+		Active:    true,
+		Price:     1500,
+		Image:     "http://" + fake.Characters() + ".com",
+		Inventory: &skupb.Inventory{Type: skupb.Inventory_Infinite},
+	})
+}
+
+func TestCarts_GetNamespace(t *testing.T) {
+	c := carts{}
+	if c.GetNamespace() != ns {
+		t.FailNow()
+	}
+}
+
+func TestCart_expired(t *testing.T) {
+	now := time.Now()
+	for _, v := range []struct {
+		cart    cartpb.Cart
+		expired bool
+	}{
+		{cartpb.Cart{Status: cartpb.Cart_Open, Expires: now.Unix() - 1}, true},
+		{cartpb.Cart{Status: cartpb.Cart_Open, Expires: now.Unix() + 1}, false},
+		{cartpb.Cart{Status: cartpb.Cart_Open}, false},
+		{cartpb.Cart{Status: cartpb.Cart_CheckedOut, Expires: now.Unix() - 1}, false},
+	} {
+		c := &cart{Cart: v.cart}
+		if c.expired(now) != v.expired {
+			t.Fatal(v.cart)
+		}
+	}
+}
+
+func TestCart_add(t *testing.T) {
+	c := &cart{}
+	c.add("a", 1)
+	c.add("b", 2)
+	c.add("a", 3)
+	if len(c.Items) != 2 || c.item("a").GetQuantity() != 4 || c.item("b").GetQuantity() != 2 || c.item("c") != nil {
+		t.Fatal(c.Items)
+	}
+}
+
+func TestService_New(t *testing.T) {
+	// bad request
+	if _, err := service.New(context.Background(), &cartpb.NewRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+
+	// missing sku
+	if _, err := service.New(context.Background(), &cartpb.NewRequest{
+		Currency: paymentpb.Currency_USD,
+		Items:    []*cartpb.Item{{Sku: uuid.NewV4().String(), Quantity: 1}},
+	}); err == nil {
+		t.Fatal(err)
+	}
+
+	s, err := createSku(paymentpb.Currency_USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := service.New(context.Background(), &cartpb.NewRequest{
+		Currency: paymentpb.Currency_USD,
+		Items:    []*cartpb.Item{{Sku: s.GetId(), Quantity: 1}, {Sku: s.GetId(), Quantity: 1}},
+		Email:    "yaron@digota.com",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.GetStatus() != cartpb.Cart_Open || len(c.GetItems()) != 1 || c.GetAmount() != 3000 || c.GetExpires() <= time.Now().Unix() {
+		t.Fatal(c)
+	}
+	if v := c.GetItems()[0]; v.GetQuantity() != 2 || v.GetAmount() != 1500 || v.GetDescription() != s.GetName() {
+		t.Fatal(v)
+	}
+}
+
+func TestService_Get(t *testing.T) {
+	defer exchange.Set(nil)
+
+	table, err := exchange.Parse("USD", map[string]float64{"EUR": 0.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exchange.Set(table)
+
+	usd, err := createSku(paymentpb.Currency_USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	eur, err := createSku(paymentpb.Currency_EUR)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := service.New(context.Background(), &cartpb.NewRequest{
+		Currency: paymentpb.Currency_USD,
+		Items:    []*cartpb.Item{{Sku: usd.GetId(), Quantity: 1}, {Sku: eur.GetId(), Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the eur sku is converted into the cart currency
+	if c.GetAmount() != 4500 {
+		t.Fatal(c)
+	}
+
+	// priced by the current sku prices
+	if _, err := sku.Service().Update(context.Background(), &skupb.UpdateRequest{Id: usd.GetId(), Active: true, Price: 2000}); err != nil {
+		t.Fatal(err)
+	}
+	if c, err = service.Get(context.Background(), &cartpb.GetRequest{Id: c.GetId()}); err != nil || c.GetAmount() != 5000 {
+		t.Fatal(c, err)
+	}
+
+	// inactive skus are unavailable
+	if _, err := sku.Service().Update(context.Background(), &skupb.UpdateRequest{Id: eur.GetId(), Active: false}); err != nil {
+		t.Fatal(err)
+	}
+	if c, err = service.Get(context.Background(), &cartpb.GetRequest{Id: c.GetId()}); err != nil || c.GetAmount() != 2000 || !c.GetItems()[1].GetUnavailable() {
+		t.Fatal(c, err)
+	}
+	if _, err := service.Checkout(context.Background(), &cartpb.CheckoutRequest{Id: c.GetId()}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	if _, err := service.Get(context.Background(), &cartpb.GetRequest{Id: uuid.NewV4().String()}); err == nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_Items(t *testing.T) {
+	a, err := createSku(paymentpb.Currency_USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := createSku(paymentpb.Currency_USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := service.New(context.Background(), &cartpb.NewRequest{Currency: paymentpb.Currency_USD})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.GetItems()) != 0 || c.GetAmount() != 0 {
+		t.Fatal(c)
+	}
+
+	// add
+	if _, err := service.AddItem(context.Background(), &cartpb.AddItemRequest{Id: c.GetId(), Sku: a.GetId(), Quantity: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if c, err = service.AddItem(context.Background(), &cartpb.AddItemRequest{Id: c.GetId(), Sku: a.GetId(), Quantity: 2}); err != nil || c.GetAmount() != 4500 {
+		t.Fatal(c, err)
+	}
+	if c, err = service.AddItem(context.Background(), &cartpb.AddItemRequest{Id: c.GetId(), Sku: b.GetId(), Quantity: 1}); err != nil || len(c.GetItems()) != 2 || c.GetAmount() != 6000 {
+		t.Fatal(c, err)
+	}
+	if _, err := service.AddItem(context.Background(), &cartpb.AddItemRequest{Id: c.GetId(), Sku: uuid.NewV4().String(), Quantity: 1}); err == nil {
+		t.Fatal(err)
+	}
+
+	// update
+	if c, err = service.UpdateItem(context.Background(), &cartpb.UpdateItemRequest{Id: c.GetId(), Sku: a.GetId(), Quantity: 1}); err != nil || c.GetAmount() != 3000 {
+		t.Fatal(c, err)
+	}
+	if _, err := service.UpdateItem(context.Background(), &cartpb.UpdateItemRequest{Id: c.GetId(), Sku: uuid.NewV4().String(), Quantity: 1}); status.Code(err) != codes.NotFound {
+		t.Fatal(err)
+	}
+
+	// remove
+	if c, err = service.RemoveItem(context.Background(), &cartpb.RemoveItemRequest{Id: c.GetId(), Sku: a.GetId()}); err != nil || len(c.GetItems()) != 1 || c.GetAmount() != 1500 {
+		t.Fatal(c, err)
+	}
+	if _, err := service.RemoveItem(context.Background(), &cartpb.RemoveItemRequest{Id: c.GetId(), Sku: a.GetId()}); status.Code(err) != codes.NotFound {
+		t.Fatal(err)
+	}
+
+	// the cart is saved
+	if c, err = service.Get(context.Background(), &cartpb.GetRequest{Id: c.GetId()}); err != nil || len(c.GetItems()) != 1 || c.GetItems()[0].GetSku() != b.GetId() {
+		t.Fatal(c, err)
+	}
+}
+
+func TestService_Checkout(t *testing.T) {
+	s, err := createSku(paymentpb.Currency_USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := service.New(context.Background(), &cartpb.NewRequest{
+		Currency: paymentpb.Currency_USD,
+		Email:    "yaron@digota.com",
+		Metadata: map[string]string{"key": "val"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.Checkout(context.Background(), &cartpb.CheckoutRequest{Id: c.GetId()}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	if _, err := service.AddItem(context.Background(), &cartpb.AddItemRequest{Id: c.GetId(), Sku: s.GetId(), Quantity: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	o, err := service.Checkout(context.Background(), &cartpb.CheckoutRequest{Id: c.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.GetStatus() != orderpb.Order_Created || o.GetAmount() != 3000 || o.GetEmail() != "yaron@digota.com" {
+		t.Fatal(o)
+	}
+	if o.GetMetadata()["cart"] != c.GetId() || o.GetMetadata()["key"] != "val" {
+		t.Fatal(o.GetMetadata())
+	}
+
+	if c, err = service.Get(context.Background(), &cartpb.GetRequest{Id: c.GetId()}); err != nil || c.GetStatus() != cartpb.Cart_CheckedOut || c.GetOrderId() != o.GetId() {
+		t.Fatal(c, err)
+	}
+
+	// checked out carts can't be changed
+	if _, err := service.AddItem(context.Background(), &cartpb.AddItemRequest{Id: c.GetId(), Sku: s.GetId(), Quantity: 1}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+	if _, err := service.Checkout(context.Background(), &cartpb.CheckoutRequest{Id: c.GetId()}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+}
+
+func TestService_Expire(t *testing.T) {
+	s, err := createSku(paymentpb.Currency_USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := service.New(context.Background(), &cartpb.NewRequest{
+		Currency: paymentpb.Currency_USD,
+		Items:    []*cartpb.Item{{Sku: s.GetId(), Quantity: 1}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// abandoned a while ago
+	stale := &cart{Cart: *c}
+	stale.Expires = time.Now().Add(-time.Minute).Unix()
+	if err := storage.Handler().Update(stale); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.Get(context.Background(), &cartpb.GetRequest{Id: c.GetId()}); status.Code(err) != codes.NotFound {
+		t.Fatal(err)
+	}
+	if _, err := service.AddItem(context.Background(), &cartpb.AddItemRequest{Id: c.GetId(), Sku: s.GetId(), Quantity: 1}); status.Code(err) != codes.NotFound {
+		t.Fatal(err)
+	}
+
+	n, err := service.Expire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n < 1 {
+		t.Fatal(n)
+	}
+
+	if err := storage.Handler().One(&cart{Cart: cartpb.Cart{Id: c.GetId()}}); err == nil {
+		t.Fatal("expired cart was not removed")
+	}
+}
@@ -39,6 +39,8 @@ const (
 	WriteScope Scope = "WRITE"
 	// ReadScope represents access to all read methods
 	ReadScope Scope = "READ"
+	// AdminScope represents access to all admin methods
+	AdminScope Scope = "ADMIN"
 )
 
 type (
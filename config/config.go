@@ -23,19 +23,28 @@
 package config
 
 import (
+	"time"
+
 	"github.com/kelseyhightower/envconfig"
 )
 
 // AppConfig is the main config structure
 // export DIGOTA_LOCKER...=val
 type AppConfig struct {
-	TLS      TLS
-	Clients  []Client
-	Payment  []PaymentProvider
-	Storage  Storage
-	Locker   Locker
-	Insecure bool
-	Address  string
+	TLS          TLS
+	Clients      []Client
+	Payment      []PaymentProvider
+	Storage      Storage
+	Locker       Locker
+	Order        Order
+	Exchange     Exchange
+	Idempotency  Idempotency
+	Invoice      Invoice
+	Outbox       Outbox
+	Subscription Subscription
+	Cart         Cart
+	Insecure     bool
+	Address      string
 }
 
 // Client is the client config structure
@@ -65,10 +74,87 @@ type Storage struct {
 	Database string
 }
 
-// Locker is the lock server handler config
+// Locker is the lock server handler config, redis locks that are never
+// released expire after the lease
+// export DIGOTA_LOCKER_LEASE=1m
 type Locker struct {
 	Handler string
 	Address []string
+	Lease   time.Duration
+}
+
+// Order is the order service config, orders can't ask for a ttl longer
+// than the max ttl
+// export DIGOTA_ORDER_TTL=15m
+// export DIGOTA_ORDER_MAXTTL=24h
+// export DIGOTA_ORDER_SWEEPINTERVAL=1m
+type Order struct {
+	TTL           time.Duration
+	MaxTTL        time.Duration
+	SweepInterval time.Duration
+}
+
+// Cart is the cart service config, open carts which are not changed for
+// the ttl are removed by a sweep every sweep interval
+// export DIGOTA_CART_TTL=168h
+// export DIGOTA_CART_SWEEPINTERVAL=10m
+type Cart struct {
+	TTL           time.Duration
+	SweepInterval time.Duration
+}
+
+// Exchange is the exchange rates table config, rates are the price of
+// one base currency unit. the file is a json of the same fields. the table
+// is used till one is stored, stored tables are reloaded every refresh.
+// export DIGOTA_EXCHANGE_BASE=USD
+// export DIGOTA_EXCHANGE_RATES=EUR:0.92,ILS:3.6
+// export DIGOTA_EXCHANGE_FILE=/etc/digota/rates.json
+// export DIGOTA_EXCHANGE_REFRESH=30s
+type Exchange struct {
+	Base    string
+	Rates   map[string]float64
+	File    string
+	Refresh time.Duration
+}
+
+// Idempotency is the idempotency keys config, responses are replayed
+// for duplicate requests within the window
+// export DIGOTA_IDEMPOTENCY_WINDOW=24h
+type Idempotency struct {
+	Window time.Duration
+}
+
+// Outbox is the pending compensations reconciler config, entries older than
+// the grace are reconciled every interval
+// export DIGOTA_OUTBOX_INTERVAL=1m
+// export DIGOTA_OUTBOX_GRACE=5m
+type Outbox struct {
+	Interval time.Duration
+	Grace    time.Duration
+}
+
+// Subscription is the subscriptions config, due renewals are billed every
+// interval. failed renewals are retried retries times, retryInterval times
+// the failed attempts apart, before the subscription is canceled.
+// export DIGOTA_SUBSCRIPTION_INTERVAL=1m
+// export DIGOTA_SUBSCRIPTION_RETRIES=3
+// export DIGOTA_SUBSCRIPTION_RETRYINTERVAL=24h
+type Subscription struct {
+	Interval      time.Duration
+	Retries       int
+	RetryInterval time.Duration
+}
+
+// Invoice is the invoices config, invoice numbers are sequential per store
+// and start with the prefix. templates is a directory of invoice.html and
+// invoice.txt (the pdf text) replacing the default templates.
+// export DIGOTA_INVOICE_STORE=digota
+// export DIGOTA_INVOICE_PREFIX=INV-
+// export DIGOTA_INVOICE_TEMPLATES=/etc/digota/invoices
+type Invoice struct {
+	Store     string
+	Prefix    string
+	Templates string
 }
 
 // PaymentProvider is the payment provider config
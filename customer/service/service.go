@@ -0,0 +1,325 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	customerInterface "github.com/digota/digota/customer"
+	"github.com/digota/digota/customer/customerpb"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/order"
+	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/storage"
+	"github.com/digota/digota/storage/object"
+	"github.com/digota/digota/validation"
+	"github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const ns = "customer"
+
+func init() {
+	customerInterface.RegisterService(&customerService{})
+}
+
+type customers []*customerpb.Customer
+
+func (c *customers) GetNamespace() string { return ns }
+
+// Customer wrapper
+type customer struct {
+	customerpb.Customer `bson:",inline"`
+	fence               int64
+}
+
+// implements object.Interface interface
+func (c *customer) GetNamespace() string { return ns }
+
+// implements object.IdSetter interface
+func (c *customer) SetId(id string) { c.Id = id }
+
+// implements object.TimeTracker interface
+func (c *customer) SetCreated(t int64) { c.Created = t }
+
+// implements object.TimeTracker interface
+func (c *customer) SetUpdated(t int64) { c.Updated = t }
+
+// implements object.Fencer interface
+func (c *customer) SetFence(t int64) { c.fence = t }
+
+// implements object.Fencer interface
+func (c *customer) GetFence() int64 { return c.fence }
+
+// email is locked while its uniqueness is checked and the customer saved
+type email string
+
+// implements object.Interface interface
+func (e email) GetNamespace() string { return ns }
+
+// implements object.Interface interface
+func (e email) GetId() string { return "email/" + string(e) }
+
+// setAddresses replaces the customer addresses, addresses with a known id
+// keep it and the others get a new one. the first default address is the
+// default one, the first address if none is.
+func (c *customer) setAddresses(addresses []*customerpb.Address) {
+	known := make(map[string]bool)
+	for _, v := range c.GetAddresses() {
+		known[v.GetId()] = true
+	}
+	def := -1
+	for k, v := range addresses {
+		if !known[v.GetId()] {
+			v.Id = uuid.NewV4().String()
+		}
+		if v.GetDefault() && def < 0 {
+			def = k
+		}
+		v.Default = false
+	}
+	if def < 0 {
+		def = 0
+	}
+	if len(addresses) > 0 {
+		addresses[def].Default = true
+	}
+	c.Addresses = addresses
+}
+
+type customerService struct{}
+
+// New creates a customer, the email must not belong to another customer
+func (s *customerService) New(ctx context.Context, req *customerpb.NewRequest) (*customerpb.Customer, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	if err := validation.Metadata(req.GetMetadata()); err != nil {
+		return nil, err
+	}
+
+	c := &customer{
+		Customer: customerpb.Customer{
+			Email:    strings.ToLower(req.GetEmail()),
+			Name:     req.GetName(),
+			Phone:    req.GetPhone(),
+			Metadata: req.GetMetadata(),
+		},
+	}
+
+	c.setAddresses(req.GetAddresses())
+
+	unlock, err := lockEmail(ctx, c.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := storage.Handler().Insert(c); err != nil {
+		return nil, err
+	}
+
+	return &c.Customer, nil
+
+}
+
+// Get returns the customer
+func (s *customerService) Get(ctx context.Context, req *customerpb.GetRequest) (*customerpb.Customer, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	c := &customer{
+		Customer: customerpb.Customer{
+			Id: req.GetId(),
+		},
+	}
+
+	if err := storage.Handler().One(c); err != nil {
+		return nil, err
+	}
+
+	return &c.Customer, nil
+
+}
+
+// Update updates the set fields and keeps the rest the same
+func (s *customerService) Update(ctx context.Context, req *customerpb.UpdateRequest) (*customerpb.Customer, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	if err := validation.Metadata(req.GetMetadata()); err != nil {
+		return nil, err
+	}
+
+	c := &customer{
+		Customer: customerpb.Customer{
+			Id: req.GetId(),
+		},
+	}
+
+	unlock, err := locker.Handler().TryLockContext(ctx, c, locker.DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := storage.Handler().One(c); err != nil {
+		return nil, err
+	}
+
+	if x := strings.ToLower(req.GetEmail()); x != "" && x != c.GetEmail() {
+		unlockEmail, err := lockEmail(ctx, x)
+		if err != nil {
+			return nil, err
+		}
+		defer unlockEmail()
+		c.Email = x
+	}
+
+	if x := req.GetName(); x != "" {
+		c.Name = x
+	}
+
+	if x := req.GetPhone(); x != "" {
+		c.Phone = x
+	}
+
+	if x := req.GetAddresses(); len(x) > 0 {
+		c.setAddresses(x)
+	}
+
+	if x := req.GetMetadata(); len(x) > 0 {
+		c.Metadata = x
+	}
+
+	return &c.Customer, storage.Handler().Update(c)
+
+}
+
+// List returns the customers, or the customer of the email
+func (s *customerService) List(ctx context.Context, req *customerpb.ListRequest) (*customerpb.CustomerList, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var filters []object.Filter
+	if x := req.GetEmail(); x != "" {
+		filters = append(filters, object.Filter{Field: "email", Op: object.OpEq, Value: strings.ToLower(x)})
+	}
+
+	slice := &customers{}
+
+	n, err := storage.Handler().List(slice, object.ListOpt{
+		Limit:   req.GetLimit(),
+		Page:    req.GetPage(),
+		Sort:    object.SortNatural,
+		Filters: filters,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &customerpb.CustomerList{Customers: *slice, Total: int32(n)}, nil
+
+}
+
+// Delete removes the customer, its orders and charges keep the customer id
+func (s *customerService) Delete(ctx context.Context, req *customerpb.DeleteRequest) (*customerpb.Empty, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	c := &customer{
+		Customer: customerpb.Customer{
+			Id: req.GetId(),
+		},
+	}
+
+	unlock, err := locker.Handler().TryLockContext(ctx, c, locker.DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return &customerpb.Empty{}, storage.Handler().Remove(c)
+
+}
+
+// ListOrders returns the orders of the customer, newest first
+func (s *customerService) ListOrders(ctx context.Context, req *customerpb.ListOrdersRequest) (*orderpb.OrderList, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	c := &customer{
+		Customer: customerpb.Customer{
+			Id: req.GetId(),
+		},
+	}
+
+	if err := storage.Handler().One(c); err != nil {
+		return nil, err
+	}
+
+	return order.Service().List(ctx, &orderpb.ListRequest{
+		Page:       req.GetPage(),
+		Limit:      req.GetLimit(),
+		Sort:       orderpb.ListRequest_CreatedDesc,
+		CustomerId: c.GetId(),
+	})
+
+}
+
+// lockEmail locks the email and checks it does not belong to a customer,
+// unlock must be called when done
+func lockEmail(ctx context.Context, e string) (func() error, error) {
+	unlock, err := locker.Handler().TryLockContext(ctx, email(e), locker.DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	n, err := storage.Handler().List(&customers{}, object.ListOpt{
+		Limit:   1,
+		Sort:    object.SortNatural,
+		Filters: []object.Filter{{Field: "email", Op: object.OpEq, Value: e}},
+	})
+	if err != nil {
+		unlock()
+		return nil, err
+	}
+	if n > 0 {
+		unlock()
+		return nil, status.Errorf(codes.AlreadyExists, "Customer with email %s already exists.", e)
+	}
+	return unlock, nil
+}
@@ -0,0 +1,325 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	_ "github.com/digota/digota/order/service"
+	_ "github.com/digota/digota/payment/service"
+	_ "github.com/digota/digota/product/service"
+	_ "github.com/digota/digota/promotion/service"
+	_ "github.com/digota/digota/shipping/service"
+	_ "github.com/digota/digota/sku/service"
+	_ "github.com/digota/digota/tax/service"
+)
+
+import (
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/customer/customerpb"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/order"
+	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/payment/paymentpb"
+	"github.com/digota/digota/product"
+	"github.com/digota/digota/product/productpb"
+	"github.com/digota/digota/sku"
+	"github.com/digota/digota/sku/skupb"
+	"github.com/digota/digota/storage"
+	"github.com/icrowley/fake"
+	"github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"os"
+	"strings"
+	"testing"
+)
+
+var service = &customerService{}
+var db = "testing-customer-" + uuid.NewV4().String()
+
+func TestMain(m *testing.M) {
+	// storage
+	if err := storage.New(config.Storage{
+		Address:  []string{"localhost"},
+		Handler:  "mongodb",
+		Database: db,
+	}); err != nil {
+		panic(err)
+	}
+
+	// in-memory locker
+	locker.New(config.Locker{})
+
+	retCode := m.Run()
+	storage.Handler().DropDatabase(db)
+	// teardown
+	os.Exit(retCode)
+}
+
+func address(def bool) *customerpb.Address {
+	return &customerpb.Address{
+		Name: fake.FullName(),
+		Address: &orderpb.Shipping_Address{
+			Line1:      fake.StreetAddress(),
+			City:       fake.City(),
+			Country:    "US",
+			PostalCode: fake.Zip(),
+		},
+		Default: def,
+	}
+}
+
+func createCustomer(addresses ...*customerpb.Address) (*customerpb.Customer, error) {
+	return service.New(context.Background(), &customerpb.NewRequest{
+		Email:     strings.ToUpper(uuid.NewV4().String()[:8]) + "@Example.com",
+		Name:      fake.FullName(),
+		Phone:     fake.Phone(),
+		Addresses: addresses,
+	})
+}
+
+func TestCustomers_GetNamespace(t *testing.T) {
+	c := customers{}
+	if c.GetNamespace() != ns {
+		t.FailNow()
+	}
+}
+
+func TestCustomer_setAddresses(t *testing.T) {
+	c := &customer{}
+	c.setAddresses([]*customerpb.Address{address(false), address(false)})
+	if c.Addresses[0].GetId() == "" || !c.Addresses[0].GetDefault() || c.Addresses[1].GetDefault() {
+		t.Fatal(c.Addresses)
+	}
+	id := c.Addresses[1].GetId()
+	// known ids are kept, unknown ones replaced, the first default wins
+	c.setAddresses([]*customerpb.Address{
+		address(false),
+		{Id: id, Default: true},
+		{Id: "unknown", Default: true},
+	})
+	if c.Addresses[1].GetId() != id || c.Addresses[2].GetId() == "unknown" {
+		t.Fatal(c.Addresses)
+	}
+	if c.Addresses[0].GetDefault() || !c.Addresses[1].GetDefault() || c.Addresses[2].GetDefault() {
+		t.Fatal(c.Addresses)
+	}
+}
+
+func TestService_New(t *testing.T) {
+	// bad request
+	if _, err := service.New(context.Background(), &customerpb.NewRequest{Email: "nope"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+
+	c, err := createCustomer(address(false), address(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.GetId() == "" || c.GetEmail() != strings.ToLower(c.GetEmail()) || !c.GetAddresses()[1].GetDefault() {
+		t.Fatal(c)
+	}
+
+	// emails are unique regardless of case
+	if _, err := service.New(context.Background(), &customerpb.NewRequest{
+		Email: strings.ToUpper(c.GetEmail()),
+	}); status.Code(err) != codes.AlreadyExists {
+		t.Fatal(err)
+	}
+}
+
+func TestService_Get(t *testing.T) {
+	if _, err := service.Get(context.Background(), &customerpb.GetRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+	if _, err := service.Get(context.Background(), &customerpb.GetRequest{Id: uuid.NewV4().String()}); status.Code(err) != codes.NotFound {
+		t.Fatal(err)
+	}
+
+	c, err := createCustomer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := service.Get(context.Background(), &customerpb.GetRequest{Id: c.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetEmail() != c.GetEmail() || got.GetName() != c.GetName() {
+		t.Fatal(got)
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	c, err := createCustomer(address(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := createCustomer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// email of another customer
+	if _, err := service.Update(context.Background(), &customerpb.UpdateRequest{
+		Id:    c.GetId(),
+		Email: other.GetEmail(),
+	}); status.Code(err) != codes.AlreadyExists {
+		t.Fatal(err)
+	}
+
+	// add a default address and keep the saved one
+	saved := c.GetAddresses()[0]
+	saved.Default = false
+	u, err := service.Update(context.Background(), &customerpb.UpdateRequest{
+		Id:        c.GetId(),
+		Name:      "name",
+		Addresses: []*customerpb.Address{saved, address(true)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.GetName() != "name" || u.GetEmail() != c.GetEmail() || len(u.GetAddresses()) != 2 {
+		t.Fatal(u)
+	}
+	if u.GetAddresses()[0].GetId() != saved.GetId() || u.GetAddresses()[0].GetDefault() || !u.GetAddresses()[1].GetDefault() {
+		t.Fatal(u.GetAddresses())
+	}
+}
+
+func TestService_List(t *testing.T) {
+	c, err := createCustomer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := service.List(context.Background(), &customerpb.ListRequest{Email: strings.ToUpper(c.GetEmail())})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.GetTotal() != 1 || l.GetCustomers()[0].GetId() != c.GetId() {
+		t.Fatal(l)
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	c, err := createCustomer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.Delete(context.Background(), &customerpb.DeleteRequest{Id: c.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.Get(context.Background(), &customerpb.GetRequest{Id: c.GetId()}); status.Code(err) != codes.NotFound {
+		t.Fatal(err)
+	}
+	// the email can be used again
+	if _, err := service.New(context.Background(), &customerpb.NewRequest{Email: c.GetEmail()}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_ListOrders(t *testing.T) {
+	c, err := createCustomer(address(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := product.Service().New(context.Background(), &productpb.NewRequest{
+		Active:      true,
+		Name:        fake.Sentences(),
+		Description: fake.Sentences(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := sku.Service().New(context.Background(), &skupb.NewRequest{
+		Parent:    p.GetId(),
+		Name:      fake.Sentences(),
+		Currency:  paymentpb.Currency_USD,
+		Active:    true,
+		Price:     1500,
+		Image:     "http://" + fake.Characters() + ".com",
+		Inventory: &skupb.Inventory{Type: skupb.Inventory_Infinite},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// unknown customer
+	if _, err := order.Service().New(context.Background(), &orderpb.NewRequest{
+		Currency:   paymentpb.Currency_USD,
+		Items:      []*orderpb.OrderItem{{Type: orderpb.OrderItem_sku, Parent: s.GetId(), Quantity: 1}},
+		CustomerId: uuid.NewV4().String(),
+	}); status.Code(err) != codes.NotFound {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for i := 0; i < 2; i++ {
+		o, err := order.Service().New(context.Background(), &orderpb.NewRequest{
+			Currency:   paymentpb.Currency_USD,
+			Items:      []*orderpb.OrderItem{{Type: orderpb.OrderItem_sku, Parent: s.GetId(), Quantity: 1}},
+			CustomerId: c.GetId(),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the customer email and default address are used
+		if o.GetCustomerId() != c.GetId() || o.GetEmail() != c.GetEmail() {
+			t.Fatal(o)
+		}
+		if o.GetShipping().GetAddress().GetLine1() != c.GetAddresses()[0].GetAddress().GetLine1() {
+			t.Fatal(o.GetShipping())
+		}
+		ids = append(ids, o.GetId())
+	}
+
+	// an order of another customer
+	if _, err := order.Service().New(context.Background(), &orderpb.NewRequest{
+		Currency: paymentpb.Currency_USD,
+		Items:    []*orderpb.OrderItem{{Type: orderpb.OrderItem_sku, Parent: s.GetId(), Quantity: 1}},
+		Email:    fake.EmailAddress(),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := service.ListOrders(context.Background(), &customerpb.ListOrdersRequest{Id: c.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.GetTotal() != 2 || len(l.GetOrders()) != 2 {
+		t.Fatal(l)
+	}
+	// newest first
+	if l.GetOrders()[0].GetCreated() < l.GetOrders()[1].GetCreated() {
+		t.Fatal(l)
+	}
+	for _, v := range l.GetOrders() {
+		if v.GetId() != ids[0] && v.GetId() != ids[1] {
+			t.Fatal(v)
+		}
+	}
+
+	if _, err := service.ListOrders(context.Background(), &customerpb.ListOrdersRequest{Id: uuid.NewV4().String()}); status.Code(err) != codes.NotFound {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,293 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package exchange
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/payment/paymentpb"
+	"github.com/digota/digota/storage"
+	"github.com/rhymond/go-money"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ns = "exchange"
+	// storedId is the id of the stored table
+	storedId = "rates"
+	// DefaultRefresh is the time between reloads of the stored table if
+	// not configured
+	DefaultRefresh = 30 * time.Second
+)
+
+// Table is an exchange rates table, every rate is the price of one
+// base currency unit in that currency
+type Table struct {
+	Base    paymentpb.Currency
+	Rates   map[paymentpb.Currency]float64
+	Updated time.Time
+}
+
+// stored is the table as it is kept in the storage, shared by all nodes
+type stored struct {
+	Id      string `bson:"_id"`
+	Base    paymentpb.Currency
+	Rates   map[string]float64
+	Updated int64
+}
+
+// implements object.Interface interface
+func (s *stored) GetNamespace() string { return ns }
+
+// implements object.Interface interface
+func (s *stored) GetId() string { return storedId }
+
+var (
+	mtx     sync.RWMutex
+	table   *Table
+	local   *Table
+	loaded  time.Time
+	refresh = DefaultRefresh
+)
+
+// New loads the rates table from the config.Exchange file if set or
+// from the config rates, an empty config leaves the table empty. the
+// stored table takes over once there is one.
+func New(c config.Exchange) error {
+	refresh = DefaultRefresh
+	if c.Refresh > 0 {
+		refresh = c.Refresh
+	}
+	base, rates := c.Base, c.Rates
+	if c.File != "" {
+		bs, err := ioutil.ReadFile(c.File)
+		if err != nil {
+			return err
+		}
+		f := struct {
+			Base  string             `json:"base"`
+			Rates map[string]float64 `json:"rates"`
+		}{}
+		if err := json.Unmarshal(bs, &f); err != nil {
+			return err
+		}
+		base, rates = f.Base, f.Rates
+	}
+	if base == "" && len(rates) == 0 {
+		Set(nil)
+		return nil
+	}
+	t, err := Parse(base, rates)
+	if err != nil {
+		return err
+	}
+	Set(t)
+	return nil
+}
+
+// Parse returns the table of base currency code and rates by currency code
+func Parse(base string, rates map[string]float64) (*Table, error) {
+	t := &Table{
+		Rates:   make(map[paymentpb.Currency]float64),
+		Updated: time.Now(),
+	}
+	var err error
+	if t.Base, err = parseCurrency(base); err != nil {
+		return nil, err
+	}
+	for k, v := range rates {
+		c, err := parseCurrency(k)
+		if err != nil {
+			return nil, err
+		}
+		if v <= 0 || math.IsInf(v, 0) || math.IsNaN(v) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid exchange rate %v for %s.", v, k))
+		}
+		t.Rates[c] = v
+	}
+	t.Rates[t.Base] = 1
+	return t, nil
+}
+
+// Set replaces the rates table of this node, it is used till a table
+// is stored
+func Set(t *Table) {
+	mtx.Lock()
+	defer mtx.Unlock()
+	table, local, loaded = t, t, time.Time{}
+}
+
+// Save stores t as the rates table of all nodes, the other nodes pick it
+// up within their refresh
+func Save(ctx context.Context, t *Table) error {
+	s := &stored{
+		Id:      storedId,
+		Base:    t.Base,
+		Rates:   make(map[string]float64, len(t.Rates)),
+		Updated: t.Updated.Unix(),
+	}
+	for k, v := range t.Rates {
+		s.Rates[k.String()] = v
+	}
+	unlock, err := locker.Handler().TryLockContext(ctx, s, locker.DefaultTimeout)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if err := storage.Handler().One(&stored{}); err != nil {
+		if status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err := storage.Handler().Insert(s); err != nil {
+			return err
+		}
+	} else if err := storage.Handler().Update(s); err != nil {
+		return err
+	}
+	mtx.Lock()
+	defer mtx.Unlock()
+	table, loaded = t, time.Now()
+	return nil
+}
+
+// Get returns a copy of the rates table, nil if there is none
+func Get() *Table {
+	current := current()
+	if current == nil {
+		return nil
+	}
+	t := &Table{
+		Base:    current.Base,
+		Rates:   make(map[paymentpb.Currency]float64, len(current.Rates)),
+		Updated: current.Updated,
+	}
+	for k, v := range current.Rates {
+		t.Rates[k] = v
+	}
+	return t
+}
+
+// Rate returns the rate of converting from currency to currency or
+// FailedPrecondition error if the table has no rate of either
+func Rate(from, to paymentpb.Currency) (float64, error) {
+	if from == to {
+		return 1, nil
+	}
+	if table := current(); table != nil {
+		f, fok := table.Rates[from]
+		t, tok := table.Rates[to]
+		if fok && tok {
+			return t / f, nil
+		}
+	}
+	return 0, status.Error(codes.FailedPrecondition, fmt.Sprintf("No exchange rate from %s to %s.", from, to))
+}
+
+// current returns the rates table, the stored table is reloaded once the
+// refresh passed. tables are never modified once set.
+func current() *Table {
+	mtx.RLock()
+	t, fresh := table, time.Since(loaded) < refresh
+	mtx.RUnlock()
+	if fresh || storage.Handler() == nil {
+		return t
+	}
+	s, err := load()
+	mtx.Lock()
+	defer mtx.Unlock()
+	loaded = time.Now()
+	switch {
+	// keep the last table till the storage is back
+	case err != nil:
+		log.Warnf("Could not load exchange rates => %s", err.Error())
+	case s != nil:
+		table = s
+	default:
+		table = local
+	}
+	return table
+}
+
+// load returns the stored table, nil if there is none
+func load() (*Table, error) {
+	s := &stored{}
+	if err := storage.Handler().One(s); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	t := &Table{
+		Base:    s.Base,
+		Rates:   make(map[paymentpb.Currency]float64, len(s.Rates)),
+		Updated: time.Unix(s.Updated, 0),
+	}
+	for k, v := range s.Rates {
+		c, err := parseCurrency(k)
+		if err != nil {
+			return nil, err
+		}
+		t.Rates[c] = v
+	}
+	return t, nil
+}
+
+// Convert returns amount of from currency minor units in to currency minor
+// units and the applied rate, amounts are rounded to the nearest unit
+func Convert(amount int64, from, to paymentpb.Currency) (int64, float64, error) {
+	rate, err := Rate(from, to)
+	if err != nil {
+		return 0, 0, err
+	}
+	if from == to {
+		return amount, rate, nil
+	}
+	exp := fraction(to) - fraction(from)
+	return int64(math.Floor(float64(amount)*rate*math.Pow10(exp) + 0.5)), rate, nil
+}
+
+// fraction returns the number of minor unit digits of c, two if unknown
+func fraction(c paymentpb.Currency) int {
+	if v := money.GetCurrency(c.String()); v != nil {
+		return v.Fraction
+	}
+	return 2
+}
+
+func parseCurrency(code string) (paymentpb.Currency, error) {
+	if v, ok := paymentpb.Currency_value[strings.ToUpper(code)]; ok && v != 0 {
+		return paymentpb.Currency(v), nil
+	}
+	return 0, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid currency %q.", code))
+}
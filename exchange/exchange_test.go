@@ -0,0 +1,117 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package exchange
+
+import (
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/payment/paymentpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	table, err := Parse("usd", map[string]float64{"EUR": 0.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if table.Base != paymentpb.Currency_USD || table.Rates[paymentpb.Currency_USD] != 1 || table.Rates[paymentpb.Currency_EUR] != 0.5 {
+		t.Fatal(table)
+	}
+	if _, err := Parse("XYZ", nil); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+	if _, err := Parse("USD", map[string]float64{"EUR": 0}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+}
+
+func TestNew(t *testing.T) {
+	defer Set(nil)
+
+	if err := New(config.Exchange{}); err != nil || Get() != nil {
+		t.Fatal(err)
+	}
+
+	if err := New(config.Exchange{Base: "USD", Rates: map[string]float64{"EUR": 0.5}}); err != nil {
+		t.Fatal(err)
+	}
+	if table := Get(); table == nil || table.Rates[paymentpb.Currency_EUR] != 0.5 {
+		t.Fatal(table)
+	}
+
+	f, err := ioutil.TempFile("", "rates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString(`{"base":"EUR","rates":{"USD":2}}`)
+	f.Close()
+
+	if err := New(config.Exchange{File: f.Name()}); err != nil {
+		t.Fatal(err)
+	}
+	if table := Get(); table == nil || table.Base != paymentpb.Currency_EUR || table.Rates[paymentpb.Currency_USD] != 2 {
+		t.Fatal(table)
+	}
+
+	if err := New(config.Exchange{File: f.Name() + "missing"}); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestConvert(t *testing.T) {
+	defer Set(nil)
+
+	// same currency without table
+	if amount, rate, err := Convert(1000, paymentpb.Currency_USD, paymentpb.Currency_USD); err != nil || amount != 1000 || rate != 1 {
+		t.Fatal(amount, rate, err)
+	}
+	if _, _, err := Convert(1000, paymentpb.Currency_USD, paymentpb.Currency_EUR); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	table, err := Parse("USD", map[string]float64{"EUR": 0.5, "JPY": 110})
+	if err != nil {
+		t.Fatal(err)
+	}
+	Set(table)
+
+	// 10.00$ => 5.00€
+	if amount, rate, err := Convert(1000, paymentpb.Currency_USD, paymentpb.Currency_EUR); err != nil || amount != 500 || rate != 0.5 {
+		t.Fatal(amount, rate, err)
+	}
+	// cross rate 10.01€ => 20.02$ => 2202.2¥
+	if amount, rate, err := Convert(1001, paymentpb.Currency_EUR, paymentpb.Currency_JPY); err != nil || amount != 2202 || rate != 220 {
+		t.Fatal(amount, rate, err)
+	}
+	// 1000¥ => 9.09$
+	if amount, _, err := Convert(1000, paymentpb.Currency_JPY, paymentpb.Currency_USD); err != nil || amount != 909 {
+		t.Fatal(amount, err)
+	}
+	if _, _, err := Convert(1000, paymentpb.Currency_USD, paymentpb.Currency_ILS); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+}
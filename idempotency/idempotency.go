@@ -0,0 +1,231 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package idempotency
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/storage"
+	"github.com/golang/protobuf/proto"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ns = "idempotency"
+	// MetadataKey is the grpc metadata key the idempotency key can be sent by
+	MetadataKey = "idempotency-key"
+	// DefaultWindow is how long responses are replayed for
+	DefaultWindow = 24 * time.Hour
+)
+
+var window = DefaultWindow
+
+// record is a stored response of a method call by idempotency key
+type record struct {
+	Id       string `bson:"_id"`
+	Method   string
+	Key      string
+	Hash     string
+	Response []byte
+	Created  int64
+	Expires  int64
+}
+
+func (r *record) GetNamespace() string {
+	return ns
+}
+
+func (r *record) GetId() string {
+	return r.Id
+}
+
+// New sets the config.Idempotency replay window, DefaultWindow if empty
+func New(c config.Idempotency) {
+	window = DefaultWindow
+	if c.Window > 0 {
+		window = c.Window
+	}
+}
+
+// Window returns the replay window
+func Window() time.Duration {
+	return window
+}
+
+// Key returns key or the idempotency key of the ctx grpc metadata if empty
+func Key(ctx context.Context, key string) string {
+	if key != "" {
+		return key
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md[MetadataKey]; len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
+
+// Do calls fn once per idempotency key of method. duplicates of req within
+// the window get the first response unmarshalled into res, duplicates with
+// other payload are rejected with InvalidArgument error. failed calls are
+// not stored and can be retried with the same key. without a key fn is
+// just called. fn gets ctx without the idempotency key metadata, so the
+// calls it makes to other services don't reuse the key.
+func Do(ctx context.Context, method, key string, req interface{}, res proto.Message, fn func(ctx context.Context) (proto.Message, error)) (proto.Message, error) {
+
+	key, ctx = Key(ctx, key), withoutKey(ctx)
+	if key == "" {
+		return fn(ctx)
+	}
+
+	hash, err := payloadHash(req)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &record{Id: recordId(method, key)}
+
+	// duplicates wait for the first call to finish
+	unlock, err := locker.Handler().TryLockContext(ctx, r, locker.DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	now := time.Now()
+	found := storage.Handler().One(r) == nil
+	if found && r.Expires > now.Unix() {
+		if r.Hash != hash {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Idempotency key %s was used with other request.", key))
+		}
+		if err := proto.Unmarshal(r.Response, res); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return res, nil
+	}
+
+	out, err := fn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bs, err := proto.Marshal(out)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	*r = record{
+		Id:       r.Id,
+		Method:   method,
+		Key:      key,
+		Hash:     hash,
+		Response: bs,
+		Created:  now.Unix(),
+		Expires:  now.Add(window).Unix(),
+	}
+
+	// expired records are replaced. the call already took effect, so a
+	// failed write is logged rather than returned
+	if found {
+		err = storage.Handler().Update(r)
+	} else {
+		err = storage.Handler().Insert(r)
+	}
+	if err != nil {
+		log.Warnf("Could not store %s response of idempotency key %s => %s", method, key, err.Error())
+	}
+
+	return out, nil
+
+}
+
+// withoutKey returns ctx without the idempotency key metadata
+func withoutKey(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md[MetadataKey]) == 0 {
+		return ctx
+	}
+	md = md.Copy()
+	delete(md, MetadataKey)
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// recordId scopes key to method
+func recordId(method, key string) string {
+	h := sha256.Sum256([]byte(method + "\x00" + key))
+	return hex.EncodeToString(h[:])
+}
+
+// keyField is the json field of the idempotency key in requests
+const keyField = "idempotencyKey"
+
+// payloadHash returns the hash of req json without its idempotency key
+// fields, so retries with the key in the request or in the metadata hash
+// the same. map keys are sorted by the json encoder so equal requests hash
+// the same too.
+func payloadHash(req interface{}) (string, error) {
+	bs, err := json.Marshal(req)
+	if err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(bs))
+	// keep numbers as they are
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	if bs, err = json.Marshal(withoutKeyField(v)); err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	h := sha256.Sum256(bs)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// withoutKeyField removes the idempotency key fields of v and of the
+// requests nested in it
+func withoutKeyField(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		delete(t, keyField)
+		for k, e := range t {
+			t[k] = withoutKeyField(e)
+		}
+	case []interface{}:
+		for i, e := range t {
+			t[i] = withoutKeyField(e)
+		}
+	}
+	return v
+}
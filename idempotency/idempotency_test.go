@@ -0,0 +1,192 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package idempotency
+
+import (
+	"errors"
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/payment/paymentpb"
+	"github.com/digota/digota/storage"
+	"github.com/golang/protobuf/proto"
+	"github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"os"
+	"testing"
+	"time"
+)
+
+var db = "testing" + uuid.NewV4().String()
+
+func TestMain(m *testing.M) {
+
+	// setup
+	if err := storage.New(config.Storage{
+		Address:  []string{"localhost"},
+		Handler:  "mongodb",
+		Database: db,
+	}); err != nil {
+		panic(err)
+	}
+
+	// in-memory locker
+	locker.New(config.Locker{})
+
+	retCode := m.Run()
+	// teardown
+	storage.Handler().DropDatabase(db)
+	os.Exit(retCode)
+}
+
+func TestNew(t *testing.T) {
+	defer New(config.Idempotency{})
+	New(config.Idempotency{Window: time.Hour})
+	if Window() != time.Hour {
+		t.Fatal(Window())
+	}
+	New(config.Idempotency{})
+	if Window() != DefaultWindow {
+		t.Fatal(Window())
+	}
+}
+
+func TestKey(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, "md"))
+	if Key(ctx, "field") != "field" || Key(ctx, "") != "md" || Key(context.Background(), "") != "" {
+		t.FailNow()
+	}
+	if Key(withoutKey(ctx), "") != "" {
+		t.FailNow()
+	}
+}
+
+func TestDo(t *testing.T) {
+
+	key := uuid.NewV4().String()
+	req := &paymentpb.ChargeRequest{Total: 1000, Currency: paymentpb.Currency_USD, Metadata: map[string]string{"a": "1", "b": "2"}}
+
+	calls := 0
+	fn := func(ctx context.Context) (proto.Message, error) {
+		if Key(ctx, "") != "" {
+			t.Fatal("key passed on")
+		}
+		calls++
+		return &paymentpb.Charge{Id: uuid.NewV4().String(), ChargeAmount: req.GetTotal()}, nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, key))
+
+	first, err := Do(ctx, "test", "", req, &paymentpb.Charge{}, fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// replayed
+	second, err := Do(ctx, "test", "", req, &paymentpb.Charge{}, fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 || second.(*paymentpb.Charge).GetId() != first.(*paymentpb.Charge).GetId() {
+		t.Fatal(calls, first, second)
+	}
+
+	// same key of other method
+	if _, err := Do(ctx, "other", "", req, &paymentpb.Charge{}, fn); err != nil || calls != 2 {
+		t.Fatal(calls, err)
+	}
+
+	// key in the request instead of the metadata
+	withKey := *req
+	withKey.IdempotencyKey = key
+	if third, err := Do(context.Background(), "test", key, &withKey, &paymentpb.Charge{}, fn); err != nil || calls != 2 || third.(*paymentpb.Charge).GetId() != first.(*paymentpb.Charge).GetId() {
+		t.Fatal(calls, err)
+	}
+
+	// other payload
+	if _, err := Do(ctx, "test", "", &paymentpb.ChargeRequest{Total: 1}, &paymentpb.Charge{}, fn); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+
+	// without key
+	if _, err := Do(context.Background(), "test", "", req, &paymentpb.Charge{}, fn); err != nil || calls != 3 {
+		t.Fatal(calls, err)
+	}
+
+	// failed calls can be retried
+	failed := uuid.NewV4().String()
+	if _, err := Do(context.Background(), "test", failed, req, &paymentpb.Charge{}, func(ctx context.Context) (proto.Message, error) {
+		return nil, errors.New("failed")
+	}); err == nil {
+		t.Fatal()
+	}
+	if _, err := Do(context.Background(), "test", failed, req, &paymentpb.Charge{}, fn); err != nil || calls != 4 {
+		t.Fatal(calls, err)
+	}
+
+	// expired responses are not replayed
+	defer New(config.Idempotency{})
+	New(config.Idempotency{Window: time.Nanosecond})
+	expired := uuid.NewV4().String()
+	if _, err := Do(context.Background(), "test", expired, req, &paymentpb.Charge{}, fn); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Second)
+	if _, err := Do(context.Background(), "test", expired, req, &paymentpb.Charge{}, fn); err != nil || calls != 6 {
+		t.Fatal(calls, err)
+	}
+
+}
+
+func TestPayloadHash(t *testing.T) {
+	type wrapper struct {
+		Charge *paymentpb.ChargeRequest
+		Amount int64
+	}
+
+	hash := func(req interface{}) string {
+		h, err := payloadHash(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h
+	}
+
+	req := &paymentpb.ChargeRequest{Total: 1000, Currency: paymentpb.Currency_USD}
+	withKey := &paymentpb.ChargeRequest{Total: 1000, Currency: paymentpb.Currency_USD, IdempotencyKey: "key"}
+
+	// the key is left out
+	if hash(req) != hash(withKey) {
+		t.FailNow()
+	}
+	// of nested requests too
+	if hash(&wrapper{Charge: req, Amount: 1}) != hash(&wrapper{Charge: withKey, Amount: 1}) {
+		t.FailNow()
+	}
+	// numbers are kept as they are
+	if hash(&wrapper{Amount: 1<<53 + 1}) == hash(&wrapper{Amount: 1 << 53}) {
+		t.FailNow()
+	}
+}
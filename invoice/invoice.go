@@ -0,0 +1,108 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package invoice
+
+import (
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/invoice/invoicepb"
+	"google.golang.org/grpc"
+	"regexp"
+)
+
+const (
+	baseMethod = "^(.invoicepb.InvoiceService/)"
+	// DefaultStore is the store invoices are numbered by if not set
+	DefaultStore = "digota"
+	// DefaultPrefix is the invoice numbers prefix if not set
+	DefaultPrefix = "INV-"
+)
+
+var (
+	service Interface
+	store   = DefaultStore
+	prefix  = DefaultPrefix
+)
+
+// Interface defines the functionality of the invoice service
+type Interface interface {
+	invoicepb.InvoiceServiceServer
+}
+
+// New sets the config.Invoice store and prefix and loads its templates,
+// the defaults are used for whatever is empty
+func New(c config.Invoice) error {
+	store, prefix = DefaultStore, DefaultPrefix
+	if c.Store != "" {
+		store = c.Store
+	}
+	if c.Prefix != "" {
+		prefix = c.Prefix
+	}
+	return loadTemplates(c.Templates)
+}
+
+// Store returns the store invoices are numbered by
+func Store() string {
+	return store
+}
+
+// Prefix returns the invoice numbers prefix
+func Prefix() string {
+	return prefix
+}
+
+// RegisterService register p as the service provider
+func RegisterService(p Interface) {
+	if service != nil {
+		panic("InvoiceService is already registered")
+	}
+	service = p
+}
+
+// Service return the registered service
+func Service() Interface {
+	if service == nil {
+		panic("InvoiceService is not registered")
+	}
+	return service
+}
+
+// RegisterInvoiceServer register service to the grpc server
+func RegisterInvoiceServer(server *grpc.Server) {
+	invoicepb.RegisterInvoiceServiceServer(server, Service())
+}
+
+// ReadMethods returns regexp slice of readable methods, mostly used by the acl
+func ReadMethods() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		regexp.MustCompile(baseMethod + "Get"),
+		regexp.MustCompile(baseMethod + "List"),
+		regexp.MustCompile(baseMethod + "GetInvoice"),
+	}
+}
+
+// WriteMethods returns regexp slice of writable methods, mostly used by the acl.
+// invoices are issued by reading their documents, nothing is written directly.
+func WriteMethods() []*regexp.Regexp {
+	return []*regexp.Regexp{}
+}
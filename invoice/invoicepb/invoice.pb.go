@@ -0,0 +1,1871 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: invoice/invoicepb/invoice.proto
+
+/*
+	Package invoicepb is a generated protocol buffer package.
+
+	It is generated from these files:
+		invoice/invoicepb/invoice.proto
+
+	It has these top-level messages:
+		Invoice
+		InvoiceList
+		Chunk
+		GetRequest
+		ListRequest
+		GetInvoiceRequest
+*/
+package invoicepb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import paymentpb "github.com/digota/digota/payment/paymentpb"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type DocumentType int32
+
+const (
+	DocumentType_TaxInvoice DocumentType = 0
+	// issued for an order return
+	DocumentType_CreditNote DocumentType = 1
+)
+
+var DocumentType_name = map[int32]string{
+	0: "TaxInvoice",
+	1: "CreditNote",
+}
+var DocumentType_value = map[string]int32{
+	"TaxInvoice": 0,
+	"CreditNote": 1,
+}
+
+func (x DocumentType) String() string {
+	return proto.EnumName(DocumentType_name, int32(x))
+}
+func (DocumentType) EnumDescriptor() ([]byte, []int) { return fileDescriptorInvoice, []int{0} }
+
+type Format int32
+
+const (
+	Format_HTML Format = 0
+	Format_PDF  Format = 1
+)
+
+var Format_name = map[int32]string{
+	0: "HTML",
+	1: "PDF",
+}
+var Format_value = map[string]int32{
+	"HTML": 0,
+	"PDF":  1,
+}
+
+func (x Format) String() string {
+	return proto.EnumName(Format_name, int32(x))
+}
+func (Format) EnumDescriptor() ([]byte, []int) { return fileDescriptorInvoice, []int{1} }
+
+type Invoice struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
+	// sequential per store, prefixed by the store prefix
+	Number  string       `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	Store   string       `protobuf:"bytes,3,opt,name=store,proto3" json:"store,omitempty"`
+	Type    DocumentType `protobuf:"varint,4,opt,name=type,proto3,enum=invoicepb.DocumentType" json:"type,omitempty"`
+	OrderId string       `protobuf:"bytes,5,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	// position of the order return a credit note is issued for
+	ReturnIndex int32              `protobuf:"varint,6,opt,name=returnIndex,proto3" json:"returnIndex,omitempty"`
+	Amount      int64              `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency    paymentpb.Currency `protobuf:"varint,8,opt,name=currency,proto3,enum=paymentpb.Currency" json:"currency,omitempty"`
+	Created     int64              `protobuf:"varint,998,opt,name=created,proto3" json:"created,omitempty"`
+	Updated     int64              `protobuf:"varint,999,opt,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (m *Invoice) Reset()                    { *m = Invoice{} }
+func (m *Invoice) String() string            { return proto.CompactTextString(m) }
+func (*Invoice) ProtoMessage()               {}
+func (*Invoice) Descriptor() ([]byte, []int) { return fileDescriptorInvoice, []int{0} }
+
+func (m *Invoice) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Invoice) GetNumber() string {
+	if m != nil {
+		return m.Number
+	}
+	return ""
+}
+
+func (m *Invoice) GetStore() string {
+	if m != nil {
+		return m.Store
+	}
+	return ""
+}
+
+func (m *Invoice) GetType() DocumentType {
+	if m != nil {
+		return m.Type
+	}
+	return DocumentType_TaxInvoice
+}
+
+func (m *Invoice) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *Invoice) GetReturnIndex() int32 {
+	if m != nil {
+		return m.ReturnIndex
+	}
+	return 0
+}
+
+func (m *Invoice) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Invoice) GetCurrency() paymentpb.Currency {
+	if m != nil {
+		return m.Currency
+	}
+	return paymentpb.Currency_CUR_RESERVED
+}
+
+func (m *Invoice) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *Invoice) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
+}
+
+type InvoiceList struct {
+	Invoices []*Invoice `protobuf:"bytes,1,rep,name=invoices" json:"invoices,omitempty"`
+	Total    int32      `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *InvoiceList) Reset()                    { *m = InvoiceList{} }
+func (m *InvoiceList) String() string            { return proto.CompactTextString(m) }
+func (*InvoiceList) ProtoMessage()               {}
+func (*InvoiceList) Descriptor() ([]byte, []int) { return fileDescriptorInvoice, []int{1} }
+
+func (m *InvoiceList) GetInvoices() []*Invoice {
+	if m != nil {
+		return m.Invoices
+	}
+	return nil
+}
+
+func (m *InvoiceList) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+// document bytes, the first chunk carries the invoice as well
+type Chunk struct {
+	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice" json:"invoice,omitempty"`
+	Data    []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *Chunk) Reset()                    { *m = Chunk{} }
+func (m *Chunk) String() string            { return proto.CompactTextString(m) }
+func (*Chunk) ProtoMessage()               {}
+func (*Chunk) Descriptor() ([]byte, []int) { return fileDescriptorInvoice, []int{2} }
+
+func (m *Chunk) GetInvoice() *Invoice {
+	if m != nil {
+		return m.Invoice
+	}
+	return nil
+}
+
+func (m *Chunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type GetRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+}
+
+func (m *GetRequest) Reset()                    { *m = GetRequest{} }
+func (m *GetRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()               {}
+func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptorInvoice, []int{3} }
+
+func (m *GetRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ListRequest struct {
+	Page  int64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty" validate:"omitempty,required,gte=0"`
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" validate:"omitempty,required,gt=0"`
+	// only the invoices of the order
+	OrderId string `protobuf:"bytes,3,opt,name=orderId,proto3" json:"orderId,omitempty" validate:"omitempty,uuid4"`
+}
+
+func (m *ListRequest) Reset()                    { *m = ListRequest{} }
+func (m *ListRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListRequest) ProtoMessage()               {}
+func (*ListRequest) Descriptor() ([]byte, []int) { return fileDescriptorInvoice, []int{4} }
+
+func (m *ListRequest) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *ListRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+type GetInvoiceRequest struct {
+	OrderId string       `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty" validate:"uuid4,required"`
+	Type    DocumentType `protobuf:"varint,2,opt,name=type,proto3,enum=invoicepb.DocumentType" json:"type,omitempty" validate:"omitempty,gte=0,lte=1"`
+	// order return of the credit note
+	ReturnIndex int32  `protobuf:"varint,3,opt,name=returnIndex,proto3" json:"returnIndex,omitempty" validate:"omitempty,gte=0"`
+	Format      Format `protobuf:"varint,4,opt,name=format,proto3,enum=invoicepb.Format" json:"format,omitempty" validate:"omitempty,gte=0,lte=1"`
+}
+
+func (m *GetInvoiceRequest) Reset()                    { *m = GetInvoiceRequest{} }
+func (m *GetInvoiceRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetInvoiceRequest) ProtoMessage()               {}
+func (*GetInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptorInvoice, []int{5} }
+
+func (m *GetInvoiceRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *GetInvoiceRequest) GetType() DocumentType {
+	if m != nil {
+		return m.Type
+	}
+	return DocumentType_TaxInvoice
+}
+
+func (m *GetInvoiceRequest) GetReturnIndex() int32 {
+	if m != nil {
+		return m.ReturnIndex
+	}
+	return 0
+}
+
+func (m *GetInvoiceRequest) GetFormat() Format {
+	if m != nil {
+		return m.Format
+	}
+	return Format_HTML
+}
+
+func init() {
+	proto.RegisterType((*Invoice)(nil), "invoicepb.Invoice")
+	proto.RegisterType((*InvoiceList)(nil), "invoicepb.InvoiceList")
+	proto.RegisterType((*Chunk)(nil), "invoicepb.Chunk")
+	proto.RegisterType((*GetRequest)(nil), "invoicepb.GetRequest")
+	proto.RegisterType((*ListRequest)(nil), "invoicepb.ListRequest")
+	proto.RegisterType((*GetInvoiceRequest)(nil), "invoicepb.GetInvoiceRequest")
+	proto.RegisterEnum("invoicepb.DocumentType", DocumentType_name, DocumentType_value)
+	proto.RegisterEnum("invoicepb.Format", Format_name, Format_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for InvoiceService service
+
+type InvoiceServiceClient interface {
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Invoice, error)
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*InvoiceList, error)
+	GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...grpc.CallOption) (InvoiceService_GetInvoiceClient, error)
+}
+
+type invoiceServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewInvoiceServiceClient(cc *grpc.ClientConn) InvoiceServiceClient {
+	return &invoiceServiceClient{cc}
+}
+
+func (c *invoiceServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Invoice, error) {
+	out := new(Invoice)
+	err := grpc.Invoke(ctx, "/invoicepb.InvoiceService/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*InvoiceList, error) {
+	out := new(InvoiceList)
+	err := grpc.Invoke(ctx, "/invoicepb.InvoiceService/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...grpc.CallOption) (InvoiceService_GetInvoiceClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_InvoiceService_serviceDesc.Streams[0], c.cc, "/invoicepb.InvoiceService/GetInvoice", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &invoiceServiceGetInvoiceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type InvoiceService_GetInvoiceClient interface {
+	Recv() (*Chunk, error)
+	grpc.ClientStream
+}
+
+type invoiceServiceGetInvoiceClient struct {
+	grpc.ClientStream
+}
+
+func (x *invoiceServiceGetInvoiceClient) Recv() (*Chunk, error) {
+	m := new(Chunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for InvoiceService service
+
+type InvoiceServiceServer interface {
+	Get(context.Context, *GetRequest) (*Invoice, error)
+	List(context.Context, *ListRequest) (*InvoiceList, error)
+	GetInvoice(*GetInvoiceRequest, InvoiceService_GetInvoiceServer) error
+}
+
+func RegisterInvoiceServiceServer(s *grpc.Server, srv InvoiceServiceServer) {
+	s.RegisterService(&_InvoiceService_serviceDesc, srv)
+}
+
+func _InvoiceService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicepb.InvoiceService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicepb.InvoiceService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_GetInvoice_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetInvoiceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InvoiceServiceServer).GetInvoice(m, &invoiceServiceGetInvoiceServer{stream})
+}
+
+type InvoiceService_GetInvoiceServer interface {
+	Send(*Chunk) error
+	grpc.ServerStream
+}
+
+type invoiceServiceGetInvoiceServer struct {
+	grpc.ServerStream
+}
+
+func (x *invoiceServiceGetInvoiceServer) Send(m *Chunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _InvoiceService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "invoicepb.InvoiceService",
+	HandlerType: (*InvoiceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _InvoiceService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _InvoiceService_List_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetInvoice",
+			Handler:       _InvoiceService_GetInvoice_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "invoice/invoicepb/invoice.proto",
+}
+
+func (m *Invoice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Invoice) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Number) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(len(m.Number)))
+		i += copy(dAtA[i:], m.Number)
+	}
+	if len(m.Store) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(len(m.Store)))
+		i += copy(dAtA[i:], m.Store)
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(m.Type))
+	}
+	if len(m.OrderId) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(len(m.OrderId)))
+		i += copy(dAtA[i:], m.OrderId)
+	}
+	if m.ReturnIndex != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(m.ReturnIndex))
+	}
+	if m.Amount != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(m.Amount))
+	}
+	if m.Currency != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(m.Currency))
+	}
+	if m.Created != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(m.Created))
+	}
+	if m.Updated != 0 {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(m.Updated))
+	}
+	return i, nil
+}
+
+func (m *InvoiceList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvoiceList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Invoices) > 0 {
+		for _, msg := range m.Invoices {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintInvoice(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Total != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(m.Total))
+	}
+	return i, nil
+}
+
+func (m *Chunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Chunk) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Invoice != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(m.Invoice.Size()))
+		n1, err := m.Invoice.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *ListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Page != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(m.Limit))
+	}
+	if len(m.OrderId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(len(m.OrderId)))
+		i += copy(dAtA[i:], m.OrderId)
+	}
+	return i, nil
+}
+
+func (m *GetInvoiceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetInvoiceRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(len(m.OrderId)))
+		i += copy(dAtA[i:], m.OrderId)
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(m.Type))
+	}
+	if m.ReturnIndex != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(m.ReturnIndex))
+	}
+	if m.Format != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintInvoice(dAtA, i, uint64(m.Format))
+	}
+	return i, nil
+}
+
+func encodeFixed64Invoice(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Invoice(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintInvoice(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Invoice) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovInvoice(uint64(l))
+	}
+	l = len(m.Number)
+	if l > 0 {
+		n += 1 + l + sovInvoice(uint64(l))
+	}
+	l = len(m.Store)
+	if l > 0 {
+		n += 1 + l + sovInvoice(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovInvoice(uint64(m.Type))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovInvoice(uint64(l))
+	}
+	if m.ReturnIndex != 0 {
+		n += 1 + sovInvoice(uint64(m.ReturnIndex))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovInvoice(uint64(m.Amount))
+	}
+	if m.Currency != 0 {
+		n += 1 + sovInvoice(uint64(m.Currency))
+	}
+	if m.Created != 0 {
+		n += 2 + sovInvoice(uint64(m.Created))
+	}
+	if m.Updated != 0 {
+		n += 2 + sovInvoice(uint64(m.Updated))
+	}
+	return n
+}
+
+func (m *InvoiceList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Invoices) > 0 {
+		for _, e := range m.Invoices {
+			l = e.Size()
+			n += 1 + l + sovInvoice(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 1 + sovInvoice(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *Chunk) Size() (n int) {
+	var l int
+	_ = l
+	if m.Invoice != nil {
+		l = m.Invoice.Size()
+		n += 1 + l + sovInvoice(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovInvoice(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovInvoice(uint64(l))
+	}
+	return n
+}
+
+func (m *ListRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovInvoice(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovInvoice(uint64(m.Limit))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovInvoice(uint64(l))
+	}
+	return n
+}
+
+func (m *GetInvoiceRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovInvoice(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovInvoice(uint64(m.Type))
+	}
+	if m.ReturnIndex != 0 {
+		n += 1 + sovInvoice(uint64(m.ReturnIndex))
+	}
+	if m.Format != 0 {
+		n += 1 + sovInvoice(uint64(m.Format))
+	}
+	return n
+}
+
+func sovInvoice(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozInvoice(x uint64) (n int) {
+	return sovInvoice(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Invoice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInvoice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Invoice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Invoice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInvoice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInvoice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Number = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInvoice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Store = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (DocumentType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInvoice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnIndex", wireType)
+			}
+			m.ReturnIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReturnIndex |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			m.Currency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Currency |= (paymentpb.Currency(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 998:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 999:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			m.Updated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updated |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInvoice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInvoice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvoiceList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInvoice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvoiceList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvoiceList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invoices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInvoice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invoices = append(m.Invoices, &Invoice{})
+			if err := m.Invoices[len(m.Invoices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInvoice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInvoice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Chunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInvoice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Chunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Chunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invoice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInvoice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Invoice == nil {
+				m.Invoice = &Invoice{}
+			}
+			if err := m.Invoice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInvoice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInvoice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInvoice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInvoice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInvoice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInvoice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInvoice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInvoice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInvoice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInvoice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInvoice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetInvoiceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInvoice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetInvoiceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetInvoiceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInvoice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (DocumentType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnIndex", wireType)
+			}
+			m.ReturnIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReturnIndex |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= (Format(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInvoice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInvoice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInvoice(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInvoice
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInvoice
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthInvoice
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowInvoice
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipInvoice(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthInvoice = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInvoice   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("invoice/invoicepb/invoice.proto", fileDescriptorInvoice) }
+
+var fileDescriptorInvoice = []byte{
+	// 739 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x6e, 0xd3, 0x4a,
+	0x10, 0x8e, 0xf3, 0xdf, 0x49, 0x15, 0xa5, 0x7b, 0xce, 0xe9, 0x71, 0x73, 0x0e, 0x49, 0xb4, 0x20,
+	0x88, 0x4a, 0xea, 0x94, 0x80, 0x44, 0xd5, 0x1f, 0xa9, 0x4a, 0xab, 0x96, 0x48, 0xe5, 0xcf, 0xed,
+	0x15, 0x37, 0xc8, 0x89, 0xb7, 0xa9, 0x45, 0xec, 0x75, 0x37, 0xeb, 0xaa, 0x79, 0x13, 0x9e, 0x83,
+	0x17, 0x00, 0x71, 0xc5, 0x25, 0x4f, 0x10, 0xa1, 0x22, 0xc1, 0x7d, 0x9e, 0x00, 0x79, 0xbd, 0x76,
+	0x1c, 0x35, 0x85, 0x5e, 0xed, 0xce, 0xce, 0x7c, 0xb3, 0xf3, 0xcd, 0x7e, 0xb3, 0x50, 0xb5, 0x9c,
+	0x0b, 0x6a, 0xf5, 0x48, 0x53, 0xae, 0x6e, 0x37, 0xdc, 0x69, 0x2e, 0xa3, 0x9c, 0xa2, 0x85, 0xc8,
+	0x51, 0x5e, 0xeb, 0x5b, 0xfc, 0xcc, 0xeb, 0x6a, 0x3d, 0x6a, 0x37, 0xfb, 0xb4, 0x4f, 0x9b, 0x22,
+	0xa2, 0xeb, 0x9d, 0x0a, 0x4b, 0x18, 0x62, 0x17, 0x20, 0xcb, 0x1b, 0xb1, 0x70, 0xd3, 0xea, 0x53,
+	0x6e, 0x84, 0x8b, 0x6b, 0x8c, 0x6c, 0xe2, 0xf0, 0x70, 0x75, 0xbb, 0xe1, 0x2e, 0x40, 0xe2, 0x8f,
+	0x49, 0xc8, 0x75, 0x82, 0x6b, 0x51, 0x05, 0x92, 0x96, 0xa9, 0x2a, 0x35, 0xa5, 0xbe, 0xd0, 0x2e,
+	0x4e, 0xc6, 0x55, 0xe8, 0x0e, 0xa9, 0xb3, 0x89, 0xdf, 0x5a, 0x26, 0xd6, 0x93, 0x96, 0x89, 0x96,
+	0x21, 0xeb, 0x78, 0x76, 0x97, 0x30, 0x35, 0xe9, 0xc7, 0xe8, 0xd2, 0x42, 0x7f, 0x43, 0x66, 0xc8,
+	0x29, 0x23, 0x6a, 0x4a, 0x1c, 0x07, 0x06, 0x7a, 0x08, 0x69, 0x3e, 0x72, 0x89, 0x9a, 0xae, 0x29,
+	0xf5, 0x62, 0xeb, 0x5f, 0x2d, 0x22, 0xa7, 0xed, 0xd3, 0x9e, 0xe7, 0x97, 0x70, 0x32, 0x72, 0x89,
+	0x2e, 0x82, 0x90, 0x0a, 0x39, 0xca, 0x4c, 0xc2, 0x3a, 0xa6, 0x9a, 0x11, 0x49, 0x42, 0x13, 0xd5,
+	0xa0, 0xc0, 0x08, 0xf7, 0x98, 0xd3, 0x71, 0x4c, 0x72, 0xa9, 0x66, 0x6b, 0x4a, 0x3d, 0xa3, 0xc7,
+	0x8f, 0xfc, 0xb2, 0x0c, 0x9b, 0x7a, 0x0e, 0x57, 0x73, 0x35, 0xa5, 0x9e, 0xd2, 0xa5, 0x85, 0x9a,
+	0x90, 0xef, 0x79, 0x8c, 0x11, 0xa7, 0x37, 0x52, 0xf3, 0xa2, 0x88, 0xbf, 0xb4, 0xa8, 0x0d, 0xda,
+	0x9e, 0x74, 0xe9, 0x51, 0x10, 0x5a, 0x81, 0x5c, 0x8f, 0x11, 0x83, 0x13, 0x53, 0xfd, 0x11, 0xa4,
+	0x0a, 0x6d, 0xdf, 0xe5, 0xb9, 0xa6, 0x70, 0xfd, 0x94, 0x2e, 0x69, 0xe3, 0x63, 0x28, 0xc8, 0x06,
+	0x1e, 0x59, 0x43, 0x8e, 0x34, 0xc8, 0x4b, 0xa6, 0x43, 0x55, 0xa9, 0xa5, 0xea, 0x85, 0x16, 0x8a,
+	0x51, 0x97, 0x91, 0x7a, 0x14, 0xe3, 0x37, 0x8f, 0x53, 0x6e, 0x0c, 0x44, 0x4f, 0x33, 0x7a, 0x60,
+	0xe0, 0x0e, 0x64, 0xf6, 0xce, 0x3c, 0xe7, 0x1d, 0x6a, 0x40, 0x4e, 0x86, 0x8a, 0x87, 0x99, 0x9f,
+	0x2d, 0x0c, 0x41, 0x08, 0xd2, 0xa6, 0xc1, 0x0d, 0x91, 0x6b, 0x51, 0x17, 0x7b, 0xbc, 0x05, 0x70,
+	0x48, 0xb8, 0x4e, 0xce, 0x3d, 0x32, 0xe4, 0x68, 0x2d, 0xf6, 0xc6, 0x77, 0x26, 0xe3, 0xea, 0xca,
+	0x85, 0x31, 0xb0, 0x7c, 0x22, 0x9b, 0xd8, 0xf3, 0x2c, 0xf3, 0x49, 0x83, 0x91, 0x73, 0xcf, 0x62,
+	0x24, 0x78, 0x72, 0xfc, 0x59, 0x81, 0x82, 0x4f, 0x2b, 0x84, 0x6f, 0x41, 0xda, 0x35, 0xfa, 0x41,
+	0x2d, 0xa9, 0xf6, 0x83, 0xc9, 0xb8, 0x7a, 0x77, 0x9a, 0x80, 0xda, 0x16, 0x27, 0xb6, 0xcb, 0x47,
+	0x51, 0x92, 0x46, 0x9f, 0x93, 0x9d, 0x75, 0xac, 0x0b, 0x10, 0xda, 0x86, 0xcc, 0xc0, 0xb2, 0x2d,
+	0x2e, 0xca, 0x4b, 0xb5, 0xef, 0x4f, 0xc6, 0x55, 0xfc, 0x07, 0xb4, 0x0f, 0x0e, 0x40, 0x68, 0x63,
+	0x2a, 0x11, 0xa1, 0xb3, 0x76, 0x65, 0x32, 0xae, 0x96, 0xe7, 0xe1, 0x05, 0x11, 0x1c, 0x49, 0x08,
+	0x7f, 0x48, 0xc2, 0xd2, 0x21, 0xe1, 0x61, 0xb7, 0x24, 0x95, 0xa7, 0xd3, 0x7c, 0xb7, 0x6a, 0x47,
+	0xa4, 0xc8, 0xd7, 0x52, 0xd8, 0xc9, 0xdf, 0x0a, 0xbb, 0x7d, 0x6f, 0x32, 0xae, 0xd6, 0xe6, 0x95,
+	0x27, 0x7a, 0xd2, 0x18, 0x70, 0xb2, 0xf3, 0x08, 0x4b, 0xf9, 0xef, 0xce, 0x8a, 0xdc, 0xe7, 0x97,
+	0xb9, 0x99, 0x9f, 0x6c, 0xea, 0xcc, 0x10, 0xbc, 0x84, 0xec, 0x29, 0x65, 0xb6, 0xc1, 0xe5, 0xbc,
+	0x2d, 0xc5, 0xca, 0x3a, 0x10, 0x8e, 0x5b, 0x16, 0x24, 0xd3, 0xac, 0x6a, 0xb0, 0x18, 0xa7, 0x83,
+	0x8a, 0x00, 0x27, 0xc6, 0xa5, 0xec, 0x61, 0x29, 0xe1, 0xdb, 0x7b, 0x8c, 0x98, 0x16, 0x7f, 0x41,
+	0x39, 0x29, 0x29, 0xab, 0xff, 0x41, 0x36, 0xb8, 0x07, 0xe5, 0x21, 0xfd, 0xec, 0xe4, 0xf9, 0x51,
+	0x29, 0x81, 0x72, 0x90, 0x7a, 0xb5, 0x7f, 0x50, 0x52, 0x5a, 0x9f, 0x14, 0x28, 0x4a, 0xe8, 0x31,
+	0x61, 0x17, 0xbe, 0x54, 0x5b, 0x90, 0x3a, 0x24, 0x1c, 0xfd, 0x13, 0xab, 0x73, 0x2a, 0xd3, 0xf2,
+	0x1c, 0x95, 0xe3, 0x04, 0xda, 0x80, 0xb4, 0x98, 0xb1, 0xe5, 0x98, 0x37, 0xa6, 0xce, 0xf2, 0xf2,
+	0x75, 0x94, 0xef, 0xc6, 0x09, 0xb4, 0x2b, 0x86, 0x40, 0x9e, 0xa1, 0xff, 0x67, 0x2f, 0x9d, 0x15,
+	0x46, 0xb9, 0x14, 0xf3, 0x8a, 0x21, 0xc4, 0x89, 0x75, 0xa5, 0xbd, 0xfd, 0xe5, 0xaa, 0xa2, 0x7c,
+	0xbd, 0xaa, 0x28, 0xdf, 0xae, 0x2a, 0xca, 0xfb, 0xef, 0x95, 0xc4, 0x9b, 0xd5, 0x1b, 0x3f, 0xdd,
+	0x6b, 0x1f, 0x7d, 0x37, 0x2b, 0x7e, 0xdb, 0xc7, 0xbf, 0x06, 0x00, 0xa2, 0xdc, 0x47, 0x4c, 0x04,
+	0x06, 0x00, 0x00,
+}
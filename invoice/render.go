@@ -0,0 +1,204 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package invoice
+
+import (
+	"bytes"
+	"github.com/digota/digota/invoice/invoicepb"
+	"github.com/digota/digota/order/orderpb"
+	"github.com/rhymond/go-money"
+	htmlTemplate "html/template"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	textTemplate "text/template"
+	"time"
+)
+
+const (
+	htmlTemplateName = "invoice.html"
+	// the pdf is rendered from plain text lines
+	textTemplateName = "invoice.txt"
+)
+
+var (
+	htmlTemplates = htmlTemplate.Must(htmlTemplate.New(htmlTemplateName).Parse(defaultHTML))
+	textTemplates = textTemplate.Must(textTemplate.New(textTemplateName).Parse(defaultText))
+)
+
+type (
+	// Document is the data invoice templates are executed with
+	Document struct {
+		Invoice *invoicepb.Invoice
+		// invoice a credit note is issued for, nil for invoices
+		Original *invoicepb.Invoice
+		Order    *orderpb.Order
+		Store    string
+		Date     string
+		Lines    []Line
+		Total    string
+	}
+	// Line is a single row of the document
+	Line struct {
+		Description string
+		Quantity    int64
+		Price       string
+		Amount      string
+	}
+)
+
+// IsCreditNote returns whether the document is a credit note
+func (d *Document) IsCreditNote() bool {
+	return d.Invoice.GetType() == invoicepb.DocumentType_CreditNote
+}
+
+// NewDocument returns the document of invoice inv of order o, original is the
+// invoice of the order if inv is a credit note
+func NewDocument(inv, original *invoicepb.Invoice, o *orderpb.Order) *Document {
+	d := &Document{
+		Invoice:  inv,
+		Original: original,
+		Order:    o,
+		Store:    inv.GetStore(),
+		Date:     time.Unix(inv.GetCreated(), 0).UTC().Format("2006-01-02"),
+		Total:    display(inv.GetAmount(), inv.GetCurrency().String()),
+	}
+	currency := o.GetCurrency().String()
+	// credit notes list the returned items
+	if d.IsCreditNote() {
+		prices := make(map[string]*orderpb.OrderItem)
+		for _, v := range o.GetItems() {
+			if v.GetType() == orderpb.OrderItem_sku {
+				prices[v.GetParent()] = v
+			}
+		}
+		if i := int(inv.GetReturnIndex()); i < len(o.GetReturns()) {
+			for _, v := range o.GetReturns()[i].GetItems() {
+				item := prices[v.GetParent()]
+				d.Lines = append(d.Lines, Line{
+					Description: item.GetDescription(),
+					Quantity:    v.GetQuantity(),
+					Price:       display(item.GetAmount(), currency),
+					Amount:      display(item.GetAmount()*v.GetQuantity(), currency),
+				})
+			}
+		}
+		return d
+	}
+	for _, v := range o.GetItems() {
+		quantity := v.GetQuantity()
+		if quantity == 0 {
+			quantity = 1
+		}
+		d.Lines = append(d.Lines, Line{
+			Description: v.GetDescription(),
+			Quantity:    quantity,
+			Price:       display(v.GetAmount(), currency),
+			Amount:      display(v.GetAmount()*quantity, currency),
+		})
+	}
+	return d
+}
+
+// display formats amount of currency for people
+func display(amount int64, currency string) string {
+	return money.New(amount, currency).Display()
+}
+
+// Render returns the document rendered in format f
+func Render(d *Document, f invoicepb.Format) ([]byte, error) {
+	var b bytes.Buffer
+	if f == invoicepb.Format_PDF {
+		if err := textTemplates.ExecuteTemplate(&b, textTemplateName, d); err != nil {
+			return nil, err
+		}
+		return pdf(b.String()), nil
+	}
+	if err := htmlTemplates.ExecuteTemplate(&b, htmlTemplateName, d); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// loadTemplates replaces the default templates by the ones found in dir,
+// the defaults are restored if dir is empty
+func loadTemplates(dir string) error {
+	h := htmlTemplate.Must(htmlTemplate.New(htmlTemplateName).Parse(defaultHTML))
+	t := textTemplate.Must(textTemplate.New(textTemplateName).Parse(defaultText))
+	if dir != "" {
+		if b, err := ioutil.ReadFile(filepath.Join(dir, htmlTemplateName)); err == nil {
+			if h, err = htmlTemplate.New(htmlTemplateName).Parse(string(b)); err != nil {
+				return err
+			}
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+		if b, err := ioutil.ReadFile(filepath.Join(dir, textTemplateName)); err == nil {
+			if t, err = textTemplate.New(textTemplateName).Parse(string(b)); err != nil {
+				return err
+			}
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+	}
+	htmlTemplates, textTemplates = h, t
+	return nil
+}
+
+const defaultHTML = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{if .IsCreditNote}}Credit note{{else}}Invoice{{end}} {{.Invoice.Number}}</title>
+</head>
+<body>
+<h1>{{.Store}}</h1>
+<h2>{{if .IsCreditNote}}Credit note{{else}}Invoice{{end}} {{.Invoice.Number}}</h2>
+<p>Date: {{.Date}}<br>Order: {{.Order.Id}}{{if .Original}}<br>Credits invoice: {{.Original.Number}}{{end}}</p>
+{{with .Order.Shipping}}<p>{{.Name}}{{with .Address}}<br>{{.Line1}} {{.Line2}}<br>{{.City}} {{.State}} {{.PostalCode}}<br>{{.Country}}{{end}}</p>{{end}}
+<table>
+<tr><th>Description</th><th>Quantity</th><th>Price</th><th>Amount</th></tr>
+{{range .Lines}}<tr><td>{{.Description}}</td><td>{{.Quantity}}</td><td>{{.Price}}</td><td>{{.Amount}}</td></tr>
+{{end}}</table>
+<p><strong>{{if .IsCreditNote}}Total credited{{else}}Total{{end}}: {{.Total}}</strong></p>
+</body>
+</html>
+`
+
+const defaultText = `{{.Store}}
+
+{{if .IsCreditNote}}CREDIT NOTE{{else}}INVOICE{{end}} {{.Invoice.Number}}
+Date: {{.Date}}
+Order: {{.Order.Id}}
+{{if .Original}}Credits invoice: {{.Original.Number}}
+{{end}}{{with .Order.Shipping}}
+{{.Name}}{{with .Address}}
+{{.Line1}} {{.Line2}}
+{{.City}} {{.State}} {{.PostalCode}}
+{{.Country}}{{end}}
+{{end}}
+{{printf "%-40s %8s %14s %14s" "Description" "Quantity" "Price" "Amount"}}
+{{range .Lines}}{{printf "%-40.40s %8d %14s %14s" .Description .Quantity .Price .Amount}}
+{{end}}
+{{if .IsCreditNote}}Total credited{{else}}Total{{end}}: {{.Total}}
+`
@@ -0,0 +1,138 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package invoice
+
+import (
+	"bytes"
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/invoice/invoicepb"
+	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/payment/paymentpb"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func testOrder() *orderpb.Order {
+	return &orderpb.Order{
+		Id:       "3f1b2c9e-0d55-4b52-9a4c-6f3f0f1f2a11",
+		Amount:   3500,
+		Currency: paymentpb.Currency_USD,
+		Items: []*orderpb.OrderItem{
+			{Type: orderpb.OrderItem_sku, Parent: "sku1", Quantity: 2, Amount: 1500, Description: "Red <shirt>"},
+			{Type: orderpb.OrderItem_shipping, Amount: 500, Description: "Shipping"},
+		},
+		Returns: []*orderpb.OrderReturn{
+			{Items: []*orderpb.ReturnItem{{Parent: "sku1", Quantity: 1}}, Amount: 1750},
+		},
+	}
+}
+
+func TestNewDocument(t *testing.T) {
+	o := testOrder()
+	inv := &invoicepb.Invoice{Number: "INV-000001", Amount: 3500, Currency: paymentpb.Currency_USD}
+
+	d := NewDocument(inv, nil, o)
+	if d.IsCreditNote() || len(d.Lines) != 2 || d.Total != "$35.00" {
+		t.Fatal(d)
+	}
+	if l := d.Lines[0]; l.Quantity != 2 || l.Price != "$15.00" || l.Amount != "$30.00" {
+		t.Fatal(l)
+	}
+	// items without quantity are a single line
+	if l := d.Lines[1]; l.Quantity != 1 || l.Amount != "$5.00" {
+		t.Fatal(l)
+	}
+
+	note := &invoicepb.Invoice{Number: "INV-000002", Type: invoicepb.DocumentType_CreditNote, Amount: 1750, Currency: paymentpb.Currency_USD}
+	d = NewDocument(note, inv, o)
+	if !d.IsCreditNote() || len(d.Lines) != 1 || d.Lines[0].Quantity != 1 || d.Total != "$17.50" {
+		t.Fatal(d)
+	}
+}
+
+func TestRender(t *testing.T) {
+	if err := New(config.Invoice{}); err != nil {
+		t.Fatal(err)
+	}
+	o := testOrder()
+	inv := &invoicepb.Invoice{Number: "INV-000001", Amount: 3500, Currency: paymentpb.Currency_USD}
+
+	b, err := Render(NewDocument(inv, nil, o), invoicepb.Format_HTML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// html is escaped
+	if s := string(b); !strings.Contains(s, "Invoice INV-000001") || !strings.Contains(s, "Red &lt;shirt&gt;") || !strings.Contains(s, "$35.00") {
+		t.Fatal(s)
+	}
+
+	note := &invoicepb.Invoice{Number: "INV-000002", Type: invoicepb.DocumentType_CreditNote, Amount: 1750, Currency: paymentpb.Currency_USD}
+	b, err = Render(NewDocument(note, inv, o), invoicepb.Format_PDF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(b, []byte("%PDF-1.4")) || !bytes.HasSuffix(b, []byte("%%EOF\n")) {
+		t.Fatal(string(b))
+	}
+	if !bytes.Contains(b, []byte("CREDIT NOTE INV-000002")) || !bytes.Contains(b, []byte("Credits invoice: INV-000001")) {
+		t.Fatal(string(b))
+	}
+}
+
+func TestRenderTemplates(t *testing.T) {
+	dir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(dir, htmlTemplateName), []byte("<p>{{.Invoice.Number}} {{.Total}}</p>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := New(config.Invoice{Templates: dir}); err != nil {
+		t.Fatal(err)
+	}
+	defer New(config.Invoice{})
+
+	inv := &invoicepb.Invoice{Number: "INV-000001", Amount: 3500, Currency: paymentpb.Currency_USD}
+	b, err := Render(NewDocument(inv, nil, testOrder()), invoicepb.Format_HTML)
+	if err != nil || string(b) != "<p>INV-000001 $35.00</p>" {
+		t.Fatal(string(b), err)
+	}
+
+	// bad template
+	if err := ioutil.WriteFile(filepath.Join(dir, textTemplateName), []byte("{{.Invoice"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := New(config.Invoice{Templates: dir}); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestPdf(t *testing.T) {
+	// long text is wrapped into pages
+	b := pdf(strings.Repeat(strings.Repeat("x", lineWidth*2)+"\n", linesPerPage))
+	if !bytes.Contains(b, []byte("/Count 2")) {
+		t.Fatal(string(b))
+	}
+	if s := pdfString(`a(b)c\ €ש`); s != `a\(b\)c\\ `+"\x80?" {
+		t.Fatal(s)
+	}
+}
@@ -0,0 +1,306 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"fmt"
+	invoiceInterface "github.com/digota/digota/invoice"
+	"github.com/digota/digota/invoice/invoicepb"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/order"
+	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/payment"
+	"github.com/digota/digota/payment/paymentpb"
+	"github.com/digota/digota/storage"
+	"github.com/digota/digota/storage/object"
+	"github.com/digota/digota/util"
+	"github.com/digota/digota/validation"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ns        = "invoice"
+	counterNs = "invoice_counter"
+	// size of the streamed document chunks
+	chunkSize = 32 * 1024
+)
+
+func init() {
+	invoiceInterface.RegisterService(&invoiceService{})
+}
+
+type invoices []*invoicepb.Invoice
+
+func (i *invoices) GetNamespace() string { return ns }
+
+type invoice struct {
+	invoicepb.Invoice `bson:",inline"`
+}
+
+func (i *invoice) GetNamespace() string { return ns }
+
+func (i *invoice) SetId(id string) { i.Id = id }
+
+func (i *invoice) SetCreated(t int64) { i.Created = t }
+
+func (i *invoice) SetUpdated(t int64) { i.Updated = t }
+
+// counter is the last invoice number issued by a store
+type counter struct {
+	Id    string `bson:"_id"`
+	Last  int64
+	fence int64
+}
+
+func (c *counter) GetNamespace() string { return counterNs }
+
+func (c *counter) GetId() string { return c.Id }
+
+func (c *counter) SetFence(t int64) { c.fence = t }
+
+func (c *counter) GetFence() int64 { return c.fence }
+
+type invoiceService struct{}
+
+// Get
+func (s *invoiceService) Get(ctx context.Context, req *invoicepb.GetRequest) (*invoicepb.Invoice, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	i := &invoice{
+		Invoice: invoicepb.Invoice{
+			Id: req.GetId(),
+		},
+	}
+
+	return &i.Invoice, storage.Handler().One(i)
+
+}
+
+// List
+func (s *invoiceService) List(ctx context.Context, req *invoicepb.ListRequest) (*invoicepb.InvoiceList, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	opt := object.ListOpt{
+		Limit: req.GetLimit(),
+		Page:  req.GetPage(),
+		Sort:  object.SortCreatedAsc,
+	}
+
+	if x := req.GetOrderId(); x != "" {
+		opt.Filters = append(opt.Filters, object.Filter{Field: "orderid", Op: object.OpEq, Value: x})
+	}
+
+	slice := &invoices{}
+
+	n, err := storage.Handler().List(slice, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &invoicepb.InvoiceList{Invoices: *slice, Total: int32(n)}, nil
+
+}
+
+// GetInvoice streams the invoice or credit note document of an order, its
+// number is issued the first time it is requested
+func (s *invoiceService) GetInvoice(req *invoicepb.GetInvoiceRequest, stream invoicepb.InvoiceService_GetInvoiceServer) error {
+
+	if err := validation.Validate(req); err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+
+	o, err := order.Service().Get(ctx, &orderpb.GetRequest{Id: req.GetOrderId()})
+	if err != nil {
+		return err
+	}
+
+	// unpaid orders are not invoiced
+	if err := paid(ctx, o); err != nil {
+		return err
+	}
+
+	// the order invoice, credit notes are issued against it
+	original, err := issue(ctx, o, invoicepb.DocumentType_TaxInvoice, 0, o.GetAmount())
+	if err != nil {
+		return err
+	}
+
+	inv, d := original, invoiceInterface.NewDocument(original, nil, o)
+
+	if req.GetType() == invoicepb.DocumentType_CreditNote {
+		i := int(req.GetReturnIndex())
+		if i >= len(o.GetReturns()) {
+			return status.Errorf(codes.FailedPrecondition, "Order has no return %d.", i)
+		}
+		if inv, err = issue(ctx, o, invoicepb.DocumentType_CreditNote, req.GetReturnIndex(), o.GetReturns()[i].GetAmount()); err != nil {
+			return err
+		}
+		d = invoiceInterface.NewDocument(inv, original, o)
+	}
+
+	b, err := invoiceInterface.Render(d, req.GetFormat())
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	for first := true; first || len(b) > 0; first = false {
+		c := &invoicepb.Chunk{}
+		if first {
+			c.Invoice = inv
+		}
+		n := chunkSize
+		if len(b) < n {
+			n = len(b)
+		}
+		c.Data, b = b[:n], b[n:]
+		if err := stream.Send(c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
+
+// paid returns FailedPrecondition error unless the charge of o has been
+// captured, authorized charges may still be voided
+func paid(ctx context.Context, o *orderpb.Order) error {
+	switch o.GetStatus() {
+	case orderpb.Order_Paid, orderpb.Order_Fulfilled, orderpb.Order_Returned, orderpb.Order_Canceled:
+	default:
+		return status.Error(codes.FailedPrecondition, "Order has not been paid.")
+	}
+	if o.GetChargeId() == "" {
+		return status.Error(codes.FailedPrecondition, "Order has not been paid.")
+	}
+	c, err := payment.Service().Get(ctx, &paymentpb.GetRequest{Id: o.GetChargeId()})
+	if err != nil {
+		return err
+	}
+	if !c.GetPaid() {
+		return status.Error(codes.FailedPrecondition, "Order charge has not been captured.")
+	}
+	return nil
+}
+
+// issue returns the invoice of type t for the order o return at index i,
+// it is issued with the next number of the store if not issued yet
+func issue(ctx context.Context, o *orderpb.Order, t invoicepb.DocumentType, i int32, amount int64) (*invoicepb.Invoice, error) {
+
+	if inv, err := find(o.GetId(), t, i); err != nil || inv != nil {
+		return inv, err
+	}
+
+	c := &counter{Id: invoiceInterface.Store()}
+
+	// numbers are handed out one at a time per store
+	unlock, err := locker.Handler().TryLockContext(ctx, c, locker.DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	// issued meanwhile
+	if inv, err := find(o.GetId(), t, i); err != nil || inv != nil {
+		return inv, err
+	}
+
+	// first invoice of the store
+	if err := storage.Handler().One(c); err != nil {
+		if status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		if err := storage.Handler().Insert(c); err != nil {
+			return nil, err
+		}
+	}
+
+	inv := &invoice{
+		Invoice: invoicepb.Invoice{
+			Number:      fmt.Sprintf("%s%06d", invoiceInterface.Prefix(), c.Last+1),
+			Store:       c.Id,
+			Type:        t,
+			OrderId:     o.GetId(),
+			ReturnIndex: i,
+			Amount:      amount,
+			Currency:    o.GetCurrency(),
+		},
+	}
+
+	// the number is taken once the invoice is saved, so a failed insert
+	// leaves no gap
+	if err := storage.Handler().Insert(inv); err != nil {
+		return nil, err
+	}
+
+	c.Last++
+	if err := util.Retry(func() error {
+		return storage.Handler().Update(c)
+	}); err != nil {
+		// the number is handed out again
+		if err := storage.Handler().Remove(inv); err != nil {
+			log.Errorf("Could not remove invoice %s of an untaken number => %s", inv.GetNumber(), err.Error())
+		}
+		return nil, err
+	}
+
+	return &inv.Invoice, nil
+
+}
+
+// find returns the invoice of type t for the order return at index i, nil if
+// not issued yet
+func find(orderId string, t invoicepb.DocumentType, i int32) (*invoicepb.Invoice, error) {
+
+	slice := invoices{}
+
+	if _, err := storage.Handler().List(&slice, object.ListOpt{
+		Limit: 1,
+		Filters: []object.Filter{
+			{Field: "orderid", Op: object.OpEq, Value: orderId},
+			{Field: "type", Op: object.OpEq, Value: t},
+			{Field: "returnindex", Op: object.OpEq, Value: i},
+			{Field: "store", Op: object.OpEq, Value: invoiceInterface.Store()},
+		},
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if len(slice) == 0 {
+		return nil, nil
+	}
+
+	return slice[0], nil
+
+}
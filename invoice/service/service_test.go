@@ -0,0 +1,247 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"bytes"
+	"github.com/digota/digota/config"
+	invoiceInterface "github.com/digota/digota/invoice"
+	"github.com/digota/digota/invoice/invoicepb"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/order/orderpb"
+	_ "github.com/digota/digota/order/service"
+	"github.com/digota/digota/payment/paymentpb"
+	_ "github.com/digota/digota/payment/service"
+	"github.com/digota/digota/storage"
+	"github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"os"
+	"testing"
+)
+
+var service = &invoiceService{}
+var db = "testing-invoice-" + uuid.NewV4().String()
+
+func TestMain(m *testing.M) {
+	// storage
+	if err := storage.New(config.Storage{
+		Address:  []string{"localhost"},
+		Handler:  "mongodb",
+		Database: db,
+	}); err != nil {
+		panic(err)
+	}
+
+	// in-memory locker
+	locker.New(config.Locker{})
+
+	if err := invoiceInterface.New(config.Invoice{Store: "test", Prefix: "T-"}); err != nil {
+		panic(err)
+	}
+
+	retCode := m.Run()
+	storage.Handler().DropDatabase(db)
+	// teardown
+	os.Exit(retCode)
+}
+
+// storedOrder saves orders the order service reads
+type storedOrder struct {
+	orderpb.Order `bson:",inline"`
+}
+
+func (o *storedOrder) GetNamespace() string { return "order" }
+
+func (o *storedOrder) SetId(id string) { o.Id = id }
+
+// storedCharge saves charges the payment service reads
+type storedCharge struct {
+	paymentpb.Charge `bson:",inline"`
+}
+
+func (c *storedCharge) GetNamespace() string { return "charge" }
+
+func (c *storedCharge) SetId(id string) { c.Id = id }
+
+// newOrder saves an order of status s paid by a charge, captured if
+// captured is true
+func newOrder(s orderpb.OrderStatus, captured bool) (*orderpb.Order, error) {
+	c := &storedCharge{
+		Charge: paymentpb.Charge{
+			ChargeAmount: 3500,
+			Currency:     paymentpb.Currency_USD,
+			Paid:         captured,
+		},
+	}
+	if !captured {
+		c.Status = paymentpb.Charge_Authorized
+	}
+	if err := storage.Handler().Insert(c); err != nil {
+		return nil, err
+	}
+	o := &storedOrder{
+		Order: orderpb.Order{
+			Amount:   3500,
+			Currency: paymentpb.Currency_USD,
+			ChargeId: c.GetId(),
+			Status:   s,
+			Items: []*orderpb.OrderItem{
+				{Type: orderpb.OrderItem_sku, Parent: uuid.NewV4().String(), Quantity: 2, Amount: 1500, Description: "Shirt"},
+				{Type: orderpb.OrderItem_shipping, Amount: 500, Description: "Shipping"},
+			},
+		},
+	}
+	o.Returns = []*orderpb.OrderReturn{
+		{Items: []*orderpb.ReturnItem{{Parent: o.Items[0].Parent, Quantity: 1}}, Amount: 1750},
+	}
+	return &o.Order, storage.Handler().Insert(o)
+}
+
+// stream collects the sent chunks
+type stream struct {
+	grpc.ServerStream
+	chunks []*invoicepb.Chunk
+}
+
+func (s *stream) Context() context.Context { return context.Background() }
+
+func (s *stream) Send(c *invoicepb.Chunk) error {
+	s.chunks = append(s.chunks, c)
+	return nil
+}
+
+func (s *stream) data() []byte {
+	var b bytes.Buffer
+	for _, c := range s.chunks {
+		b.Write(c.GetData())
+	}
+	return b.Bytes()
+}
+
+func TestInvoices_GetNamespace(t *testing.T) {
+	i := invoices{}
+	if i.GetNamespace() != ns {
+		t.FailNow()
+	}
+}
+
+func TestInvoice_GetNamespace(t *testing.T) {
+	i := invoice{}
+	if i.GetNamespace() != ns {
+		t.FailNow()
+	}
+}
+
+func TestCounter_GetNamespace(t *testing.T) {
+	c := counter{}
+	if c.GetNamespace() != counterNs {
+		t.FailNow()
+	}
+}
+
+func TestService_GetInvoice(t *testing.T) {
+
+	// bad request
+	if err := service.GetInvoice(&invoicepb.GetInvoiceRequest{}, &stream{}); err == nil {
+		t.FailNow()
+	}
+
+	// not paid
+	unpaid, err := newOrder(orderpb.Order_Created, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := service.GetInvoice(&invoicepb.GetInvoiceRequest{OrderId: unpaid.GetId()}, &stream{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	// only authorized
+	authorized, err := newOrder(orderpb.Order_Paid, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := service.GetInvoice(&invoicepb.GetInvoiceRequest{OrderId: authorized.GetId()}, &stream{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	o, err := newOrder(orderpb.Order_Fulfilled, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &stream{}
+	if err := service.GetInvoice(&invoicepb.GetInvoiceRequest{OrderId: o.GetId()}, s); err != nil {
+		t.Fatal(err)
+	}
+	inv := s.chunks[0].GetInvoice()
+	if inv.GetType() != invoicepb.DocumentType_TaxInvoice || inv.GetStore() != "test" || inv.GetAmount() != 3500 {
+		t.Fatal(inv)
+	}
+	if !bytes.Contains(s.data(), []byte(inv.GetNumber())) {
+		t.Fatal(string(s.data()))
+	}
+
+	// the same number is returned again
+	s = &stream{}
+	if err := service.GetInvoice(&invoicepb.GetInvoiceRequest{OrderId: o.GetId(), Format: invoicepb.Format_PDF}, s); err != nil {
+		t.Fatal(err)
+	}
+	if s.chunks[0].GetInvoice().GetNumber() != inv.GetNumber() || !bytes.HasPrefix(s.data(), []byte("%PDF")) {
+		t.Fatal(s.chunks[0].GetInvoice())
+	}
+
+	// credit note of the return gets the next number
+	s = &stream{}
+	if err := service.GetInvoice(&invoicepb.GetInvoiceRequest{OrderId: o.GetId(), Type: invoicepb.DocumentType_CreditNote}, s); err != nil {
+		t.Fatal(err)
+	}
+	note := s.chunks[0].GetInvoice()
+	if note.GetType() != invoicepb.DocumentType_CreditNote || note.GetAmount() != 1750 || note.GetNumber() <= inv.GetNumber() {
+		t.Fatal(note)
+	}
+
+	// no such return
+	if err := service.GetInvoice(&invoicepb.GetInvoiceRequest{OrderId: o.GetId(), Type: invoicepb.DocumentType_CreditNote, ReturnIndex: 1}, &stream{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	// list and get
+	list, err := service.List(context.Background(), &invoicepb.ListRequest{OrderId: o.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.GetTotal() != 2 || list.GetInvoices()[0].GetNumber() != inv.GetNumber() {
+		t.Fatal(list)
+	}
+	got, err := service.Get(context.Background(), &invoicepb.GetRequest{Id: note.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetNumber() != note.GetNumber() {
+		t.Fatal(got)
+	}
+
+}
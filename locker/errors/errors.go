@@ -0,0 +1,85 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package errors
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// contention usually clears within a lock timeout
+	contentionRetryAfter = 100 * time.Millisecond
+	// backends take longer to come back
+	unavailableRetryAfter = time.Second
+)
+
+// Error is a lock failure shared by all the locker handlers, it carries
+// the grpc code and the delay after which the call may be retried.
+type Error struct {
+	Code       codes.Code
+	Message    string
+	RetryAfter time.Duration
+	// Err is the handler error behind it, if any
+	Err error
+}
+
+var (
+	// ErrTimeout returns when the lock is still held by someone else once
+	// the timeout is reached
+	ErrTimeout = &Error{Code: codes.Aborted, Message: "lock timeout", RetryAfter: contentionRetryAfter}
+	// ErrClosed returns when the locker has been closed
+	ErrClosed = &Error{Code: codes.Unavailable, Message: "locker is closed", RetryAfter: unavailableRetryAfter}
+	// ErrMissingInfo returns when the object has an empty namespace or id
+	ErrMissingInfo = &Error{Code: codes.Internal, Message: "Obj is missing information to make that lock"}
+	// ErrNotHeld returns when releasing a lock that is not held
+	ErrNotHeld = &Error{Code: codes.NotFound, Message: "lock is not held"}
+)
+
+// Unavailable wraps err of an unreachable or failing lock backend
+func Unavailable(err error) error {
+	return &Error{Code: codes.Unavailable, Message: "locker is unavailable", RetryAfter: unavailableRetryAfter, Err: err}
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// GRPCStatus returns the grpc status of e, retryable errors carry their
+// delay as a google.protobuf.Duration detail.
+func (e *Error) GRPCStatus() *status.Status {
+	s := status.New(e.Code, e.Error())
+	if e.RetryAfter <= 0 {
+		return s
+	}
+	if ds, err := s.WithDetails(ptypes.DurationProto(e.RetryAfter)); err == nil {
+		return ds
+	}
+	return s
+}
@@ -0,0 +1,66 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package errors
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestError_GRPCStatus(t *testing.T) {
+	s, ok := status.FromError(ErrTimeout)
+	if !ok || s.Code() != codes.Aborted || s.Message() != ErrTimeout.Error() {
+		t.Fatal(s)
+	}
+	details := s.Details()
+	if len(details) != 1 {
+		t.Fatal(details)
+	}
+	if d, err := ptypes.Duration(details[0].(*duration.Duration)); err != nil || d != ErrTimeout.RetryAfter {
+		t.Fatal(d, err)
+	}
+
+	// no retry
+	s, _ = status.FromError(ErrNotHeld)
+	if s.Code() != codes.NotFound || len(s.Details()) != 0 {
+		t.Fatal(s)
+	}
+}
+
+func TestUnavailable(t *testing.T) {
+	errConn := errors.New("connection failed")
+	err := Unavailable(errConn)
+	e, ok := err.(*Error)
+	if !ok || e.Err != errConn || e.RetryAfter != time.Second {
+		t.Fatal(err)
+	}
+	if status.Code(err) != codes.Unavailable || err.Error() != "locker is unavailable: connection failed" {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,49 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package fence hands out the fencing tokens of locked objects. tokens come
+// from a counter per object kept by the storage handler, so they keep
+// growing no matter which locker handler or node handed out the lock.
+package fence
+
+import (
+	lockerErrors "github.com/digota/digota/locker/errors"
+	"github.com/digota/digota/storage"
+	"github.com/digota/digota/storage/object"
+)
+
+// Set sets the next fencing token on doc if it implements object.Fencer,
+// unlock is called when no token can be issued. without a storage handler
+// no tokens are handed out.
+func Set(doc object.Interface, unlock func() error) error {
+	f, ok := doc.(object.Fencer)
+	if !ok || storage.Handler() == nil {
+		return nil
+	}
+	token, err := storage.Handler().NextFence(doc)
+	if err != nil {
+		unlock()
+		return lockerErrors.Unavailable(err)
+	}
+	f.SetFence(token)
+	return nil
+}
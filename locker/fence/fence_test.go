@@ -0,0 +1,63 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package fence
+
+import (
+	"testing"
+)
+
+type testObj struct {
+	Id    string
+	fence int64
+}
+
+func (o *testObj) GetNamespace() string {
+	return "fence_test"
+}
+
+func (o *testObj) GetId() string {
+	return o.Id
+}
+
+func (o *testObj) SetFence(t int64) {
+	o.fence = t
+}
+
+func (o *testObj) GetFence() int64 {
+	return o.fence
+}
+
+func TestSet(t *testing.T) {
+	obj := &testObj{Id: "1"}
+	released := 0
+	// no storage handler, no token
+	if err := Set(obj, func() error {
+		released++
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if obj.GetFence() != 0 || released != 0 {
+		t.Fatal(obj.GetFence(), released)
+	}
+}
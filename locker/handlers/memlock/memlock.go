@@ -23,63 +23,141 @@
 package memlock
 
 import (
-	"errors"
+	lockerErrors "github.com/digota/digota/locker/errors"
+	"github.com/digota/digota/locker/fence"
+	"github.com/digota/digota/locker/metrics"
 	"github.com/digota/digota/storage/object"
+	"golang.org/x/net/context"
 	"sync"
 	"time"
 )
 
 const separator = "-"
 
-var mtx sync.Mutex
+var (
+	// ErrTimeout returns when the lock could not be acquired in time
+	ErrTimeout = lockerErrors.ErrTimeout
+
+	// ErrClosed returns when the locker has been closed, either before
+	// or while waiting for the lock
+	ErrClosed = lockerErrors.ErrClosed
+)
 
 type locker struct {
-	smap map[string]*semaphore
+	mtx    sync.Mutex
+	smap   map[string]*semaphore
+	closed chan struct{}
+	metrics.Recorder
+}
+
+// Stats describes the live locks of the locker
+type Stats struct {
+	// Keys is the number of semaphores currently in memory
+	Keys int
+	// Held is the number of locks currently held
+	Held int
+	// Waiting is the number of callers waiting for a held lock
+	Waiting int
 }
 
 // NewLocker return new lock
 func NewLocker() *locker {
 	return &locker{
-		smap: make(map[string]*semaphore),
+		smap:   make(map[string]*semaphore),
+		closed: make(chan struct{}),
 	}
 }
 
+// Close fails all pending and future acquisitions with ErrClosed,
+// locks that are already held can still be released.
 func (m *locker) Close() error {
-	m.smap = make(map[string]*semaphore)
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	select {
+	case <-m.closed:
+	default:
+		close(m.closed)
+	}
 	return nil
 }
 
-func (m *locker) getSemaphore(key string) *semaphore {
-	mtx.Lock()
-	defer mtx.Unlock()
+// Locks returns the locks held by this locker, memory locks are never
+// shared with other nodes
+func (m *locker) Locks() ([]metrics.Lock, error) {
+	return m.Recorder.Locks(), nil
+}
+
+// Stats returns a snapshot of the live locks
+func (m *locker) Stats() Stats {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	st := Stats{Keys: len(m.smap)}
+	for _, s := range m.smap {
+		held := len(s.ch)
+		st.Held += held
+		st.Waiting += s.refs - held
+	}
+	return st
+}
+
+// getSemaphore returns the semaphore of key and takes a reference on it,
+// every call must be followed by exactly one putSemaphore call.
+func (m *locker) getSemaphore(key string) (*semaphore, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	select {
+	case <-m.closed:
+		return nil, ErrClosed
+	default:
+	}
 	v, ok := m.smap[key]
 	if !ok {
-		m.smap[key] = newSemaphore()
-		v = m.smap[key]
+		v = newSemaphore()
+		m.smap[key] = v
 	}
-	return v
+	v.refs++
+	return v, nil
 }
 
-func (m *locker) Lock(doc object.Interface) (func() error, error) {
-
-	key, err := getKey(doc)
-
-	if err != nil {
-		return nil, err
+// putSemaphore drops a reference on the semaphore of key and reclaims
+// it once nobody holds or waits for it.
+func (m *locker) putSemaphore(key string, s *semaphore) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	s.refs--
+	if s.refs == 0 {
+		delete(m.smap, key)
 	}
+}
 
-	s := m.getSemaphore(key)
+func (m *locker) Lock(doc object.Interface) (func() error, error) {
+	return m.LockContext(context.Background(), doc)
+}
 
-	s.lock()
+func (m *locker) LockContext(ctx context.Context, doc object.Interface) (func() error, error) {
+	return m.acquire(doc, func(s *semaphore) error {
+		return s.lock(ctx, m.closed)
+	})
+}
 
-	return func() error {
-		s.unlock()
-		return nil
-	}, nil
+func (m *locker) TryLock(doc object.Interface, timeout time.Duration) (func() error, error) {
+	return m.TryLockContext(context.Background(), doc, timeout)
+}
 
+func (m *locker) TryLockContext(ctx context.Context, doc object.Interface, timeout time.Duration) (func() error, error) {
+	return m.acquire(doc, func(s *semaphore) error {
+		return s.tryLock(ctx, m.closed, timeout)
+	})
 }
 
-func (m *locker) TryLock(doc object.Interface, timeout time.Duration) (func() error, error) {
+// acquire locks the semaphore of doc with lockFn and records the acquisition
+func (m *locker) acquire(doc object.Interface, lockFn func(s *semaphore) error) (func() error, error) {
+	start := time.Now()
+	unlock, err := m.lockSemaphore(doc, lockFn)
+	return m.Track(doc, start, unlock, err)
+}
+
+func (m *locker) lockSemaphore(doc object.Interface, lockFn func(s *semaphore) error) (func() error, error) {
 
 	key, err := getKey(doc)
 
@@ -87,48 +165,91 @@ func (m *locker) TryLock(doc object.Interface, timeout time.Duration) (func() er
 		return nil, err
 	}
 
-	s := m.getSemaphore(key)
+	s, err := m.getSemaphore(key)
 
-	if !s.tryLock(timeout) {
-		return nil, errors.New("tryLock timeout")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := lockFn(s); err != nil {
+		m.putSemaphore(key, s)
+		return nil, err
+	}
+
+	unlock := m.unlockFn(key, s)
+
+	if err := fence.Set(doc, unlock); err != nil {
+		return nil, err
 	}
 
+	return unlock, nil
+
+}
+
+// unlockFn returns the release func of an acquired semaphore,
+// calling it more than once is a no-op.
+func (m *locker) unlockFn(key string, s *semaphore) func() error {
+	var once sync.Once
 	return func() error {
-		s.unlock()
+		once.Do(func() {
+			s.unlock()
+			m.putSemaphore(key, s)
+		})
 		return nil
-	}, nil
-
+	}
 }
 
 func getKey(doc object.Interface) (string, error) {
 	if doc.GetId() == "" || doc.GetNamespace() == "" {
-		return "", errors.New("Obj is missing information to make that lock")
+		return "", lockerErrors.ErrMissingInfo
 	}
 	return doc.GetNamespace() + separator + doc.GetId(), nil
 }
 
 func newSemaphore() *semaphore {
-	s := make(semaphore, 1)
-	return &s
+	return &semaphore{ch: make(chan struct{}, 1)}
 }
 
-type semaphore chan struct{}
+// semaphore is a single slot lock, refs counts its holder and
+// waiters and is guarded by the locker mutex.
+type semaphore struct {
+	ch   chan struct{}
+	refs int
+}
 
-func (s semaphore) lock() {
-	s <- struct{}{}
+func (s *semaphore) lock(ctx context.Context, closed <-chan struct{}) error {
+	// do not race a free semaphore against a done context
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case s.ch <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-closed:
+		return ErrClosed
+	}
 }
 
-func (s semaphore) unlock() {
-	<-s
+func (s *semaphore) unlock() {
+	<-s.ch
 }
 
-func (s semaphore) tryLock(timeout time.Duration) bool {
+func (s *semaphore) tryLock(ctx context.Context, closed <-chan struct{}, timeout time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	timer := time.NewTimer(timeout)
 	defer timer.Stop()
 	select {
-	case s <- struct{}{}:
-		return true
-	case <-time.After(timeout):
+	case s.ch <- struct{}{}:
+		return nil
+	case <-timer.C:
+		return ErrTimeout
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-closed:
+		return ErrClosed
 	}
-	return false
 }
@@ -23,7 +23,9 @@
 package memlock
 
 import (
+	lockerErrors "github.com/digota/digota/locker/errors"
 	"github.com/satori/go.uuid"
+	"golang.org/x/net/context"
 	"testing"
 	"time"
 )
@@ -90,3 +92,180 @@ func TestLock_TryLock(t *testing.T) {
 		unlock()
 	}
 }
+
+func TestLock_LockContext(t *testing.T) {
+	l := NewLocker()
+	defer l.Close()
+	id := uuid.NewV4().String()
+	unlock, err := l.LockContext(context.Background(), &testObj{Id: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unlock()
+	// lock is taken, should return once ctx is done
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.LockContext(ctx, &testObj{Id: id}); err != context.DeadlineExceeded {
+		t.Fatal(err)
+	}
+}
+
+func TestLock_TryLockContext(t *testing.T) {
+	l := NewLocker()
+	defer l.Close()
+	id := uuid.NewV4().String()
+	unlock, err := l.Lock(&testObj{Id: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unlock()
+	// canceled ctx should win over the longer timeout
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := l.TryLockContext(ctx, &testObj{Id: id}, time.Second); err != context.Canceled {
+		t.Fatal(err)
+	}
+	// timeout should win over the ctx
+	if _, err := l.TryLockContext(context.Background(), &testObj{Id: id}, 10*time.Millisecond); err != ErrTimeout {
+		t.Fatal(err)
+	}
+	// canceled ctx should not take a free lock
+	if _, err := l.TryLockContext(ctx, &testObj{Id: uuid.NewV4().String()}, time.Second); err != context.Canceled {
+		t.Fatal(err)
+	}
+}
+
+func TestLock_Reclaim(t *testing.T) {
+	l := NewLocker()
+	defer l.Close()
+	id := uuid.NewV4().String()
+	unlock, err := l.Lock(&testObj{Id: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st := l.Stats(); st.Keys != 1 || st.Held != 1 || st.Waiting != 0 {
+		t.Fatal(st)
+	}
+	// failed acquisition should not leave a reference behind
+	if _, err := l.TryLock(&testObj{Id: id}, 10*time.Millisecond); err != ErrTimeout {
+		t.Fatal(err)
+	}
+	unlock()
+	// second call is a no-op
+	unlock()
+	if st := l.Stats(); st.Keys != 0 || st.Held != 0 || st.Waiting != 0 {
+		t.Fatal(st)
+	}
+}
+
+func TestLock_Stats(t *testing.T) {
+	l := NewLocker()
+	defer l.Close()
+	id := uuid.NewV4().String()
+	unlock, err := l.Lock(&testObj{Id: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() {
+		unlock, err := l.TryLock(&testObj{Id: id}, time.Second)
+		if err == nil {
+			unlock()
+		}
+		done <- err
+	}()
+	// wait for the waiter to show up
+	for i := 0; l.Stats().Waiting != 1; i++ {
+		if i > 100 {
+			t.Fatal(l.Stats())
+		}
+		time.Sleep(time.Millisecond)
+	}
+	unlock()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if st := l.Stats(); st.Keys != 0 {
+		t.Fatal(st)
+	}
+}
+
+func TestLock_CloseWaiters(t *testing.T) {
+	l := NewLocker()
+	id := uuid.NewV4().String()
+	unlock, err := l.Lock(&testObj{Id: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	go func() {
+		_, err := l.Lock(&testObj{Id: id})
+		done <- err
+	}()
+	for i := 0; l.Stats().Waiting != 1; i++ {
+		if i > 100 {
+			t.Fatal(l.Stats())
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	// pending waiter fails
+	if err := <-done; err != ErrClosed {
+		t.Fatal(err)
+	}
+	// new acquisitions fail
+	if _, err := l.TryLock(&testObj{Id: uuid.NewV4().String()}, time.Second); err != ErrClosed {
+		t.Fatal(err)
+	}
+	// holders can still release
+	if err := unlock(); err != nil {
+		t.Fatal(err)
+	}
+	if st := l.Stats(); st.Keys != 0 {
+		t.Fatal(st)
+	}
+	// closing twice is fine
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLock_Release(t *testing.T) {
+	l := NewLocker()
+	defer l.Close()
+	obj := &testObj{Id: uuid.NewV4().String()}
+
+	unlock, err := l.TryLock(obj, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if locks, err := l.Locks(); err != nil || len(locks) != 1 || locks[0].Id != obj.GetId() {
+		t.Fatal(locks, err)
+	}
+
+	if err := l.Release(obj.GetNamespace(), obj.GetId()); err != nil {
+		t.Fatal(err)
+	}
+
+	// lock is free again and the stale unlock is a no-op
+	unlock2, err := l.TryLock(obj, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlock()
+	if _, err := l.TryLock(obj, 10*time.Millisecond); err != ErrTimeout {
+		t.Fatal(err)
+	}
+	unlock2()
+
+	if err := l.Release(obj.GetNamespace(), obj.GetId()); err != lockerErrors.ErrNotHeld {
+		t.Fatal(err)
+	}
+
+	if m := l.Metrics(); len(m) != 1 || m[0].Acquired != 2 || m[0].Timeouts != 1 {
+		t.Fatal(m)
+	}
+}
@@ -24,16 +24,24 @@ package redis
 
 import (
 	"errors"
+	"strings"
 	"time"
 
+	lockerErrors "github.com/digota/digota/locker/errors"
+	"github.com/digota/digota/locker/fence"
+	"github.com/digota/digota/locker/metrics"
 	"github.com/digota/digota/storage/object"
 
 	"github.com/digota/digota/config"
 	"github.com/garyburd/redigo/redis"
+	"golang.org/x/net/context"
 )
 
 type locker struct {
 	rp Pool
+	// lease is how long a lock is kept if its holder never releases it
+	lease time.Duration
+	metrics.Recorder
 }
 
 // Pool is an interface over the redis.Pool struct
@@ -43,14 +51,24 @@ type Pool interface {
 	Close() error
 }
 
-const separator = "."
+const (
+	separator = "."
+	// prefix of the lock keys, keeps them apart from other keys
+	keyPrefix = "lock" + separator
+	// DefaultLease is the lock lease if not configured
+	DefaultLease = time.Minute
+	// retryInterval is the time between attempts to set a held key
+	retryInterval = 10 * time.Millisecond
+	// unlockScript deletes the key only if it is still held by the owner
+	unlockScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) else return 0 end`
+)
 
 var (
 	// ErrTimeout returns when you couldn't make a TryLock call
-	ErrTimeout = errors.New("Timeout reached")
+	ErrTimeout = lockerErrors.ErrTimeout
 
 	// ErrMissingInfo returns when you have and empty Namespace or Object ID
-	ErrMissingInfo = errors.New("Obj is missing information to make that lock")
+	ErrMissingInfo = lockerErrors.ErrMissingInfo
 )
 
 // NewLocker return new redis based lock
@@ -59,7 +77,7 @@ func NewLocker(lockerConfig config.Locker) (*locker, error) {
 		return nil, errors.New("No redis address provided")
 	}
 	p := newPool(lockerConfig.Address[0], "")
-	return &locker{p}, nil
+	return &locker{rp: p, lease: lockerConfig.Lease}, nil
 }
 
 func newPool(server, password string) *redis.Pool {
@@ -92,7 +110,7 @@ func getKey(doc object.Interface) (string, error) {
 	if doc.GetNamespace() == "" || doc.GetId() == "" {
 		return "", ErrMissingInfo
 	}
-	return doc.GetNamespace() + separator + doc.GetId(), nil
+	return keyPrefix + doc.GetNamespace() + separator + doc.GetId(), nil
 }
 
 func (l *locker) Close() error {
@@ -100,53 +118,205 @@ func (l *locker) Close() error {
 }
 
 func (l *locker) Lock(doc object.Interface) (func() error, error) {
+	return l.LockContext(context.Background(), doc)
+}
+
+func (l *locker) LockContext(ctx context.Context, doc object.Interface) (func() error, error) {
+	start := time.Now()
+	unlock, err := l.lock(ctx, doc)
+	return l.Track(doc, start, unlock, err)
+}
+
+func (l *locker) lock(ctx context.Context, doc object.Interface) (func() error, error) {
 	key, err := getKey(doc)
 	if err != nil {
 		return nil, err
 	}
-
-	conn := l.rp.Get()
-	_, err = redis.String(conn.Do("SET", key, "NX"))
-	conn.Close()
-	if err != nil {
+	owner := metrics.Owner(time.Now())
+	if err := l.acquire(ctx, key, owner); err != nil {
 		return nil, err
 	}
-
-	return func() error { return l.unlock(key) }, nil
+	return l.fenced(doc, key, owner)
 }
 
 func (l *locker) TryLock(doc object.Interface, t time.Duration) (func() error, error) {
+	return l.TryLockContext(context.Background(), doc, t)
+}
+
+func (l *locker) TryLockContext(ctx context.Context, doc object.Interface, t time.Duration) (func() error, error) {
+	start := time.Now()
+	unlock, err := l.tryLock(ctx, doc, t)
+	return l.Track(doc, start, unlock, err)
+}
+
+func (l *locker) tryLock(ctx context.Context, doc object.Interface, t time.Duration) (func() error, error) {
 	key, err := getKey(doc)
 	if err != nil {
 		return nil, err
 	}
 
-	ch := make(chan error)
-	conn := l.rp.Get()
-	defer conn.Close()
+	tctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
 
-	go func(c redis.Conn) {
-		_, err = redis.String(c.Do("SET", key, "NX"))
+	owner := metrics.Owner(time.Now())
+	if err := l.acquire(tctx, key, owner); err != nil {
+		// the deadline is ours, not the caller's
+		if err == context.DeadlineExceeded && ctx.Err() == nil {
+			return nil, ErrTimeout
+		}
+		return nil, err
+	}
+	return l.fenced(doc, key, owner)
+}
+
+// fenced returns the release func of the key acquired by owner once doc
+// got its fencing token
+func (l *locker) fenced(doc object.Interface, key string, owner []byte) (func() error, error) {
+	unlock := func() error { return l.unlock(key, owner) }
+	if err := fence.Set(doc, unlock); err != nil {
+		return nil, err
+	}
+	return unlock, nil
+}
+
+// acquire sets the key to owner once it is not held, retrying till ctx is
+// done. the key expires after the lease, so the lock of a holder that never
+// releases it is not kept forever.
+func (l *locker) acquire(ctx context.Context, key string, owner []byte) error {
+	for {
+		ok, err := l.set(ctx, key, owner)
+		if err != nil || ok {
+			return err
+		}
 		select {
-		case ch <- err:
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// set sets the key to owner if it is not held and waits for the reply till
+// ctx is done. if ctx is done first, the key will be released as soon as
+// the reply arrives so an abandoned acquisition never leaves the lock
+// behind.
+func (l *locker) set(ctx context.Context, key string, owner []byte) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	lease := l.lease
+	if lease <= 0 {
+		lease = DefaultLease
+	}
+
+	type result struct {
+		ok  bool
+		err error
+	}
+
+	ch := make(chan result, 1)
+	go func() {
+		conn := l.rp.Get()
+		defer conn.Close()
+		_, err := redis.String(conn.Do("SET", key, owner, "NX", "PX", int64(lease/time.Millisecond)))
+		switch {
+		// held by someone else
+		case err == redis.ErrNil:
+			ch <- result{}
+		case err != nil:
+			ch <- result{err: lockerErrors.Unavailable(err)}
 		default:
+			ch <- result{ok: true}
 		}
-	}(conn)
+	}()
 
 	select {
-	case err = <-ch:
+	case r := <-ch:
+		return r.ok, r.err
+	case <-ctx.Done():
+		go func() {
+			if r := <-ch; r.ok {
+				l.unlock(key, owner)
+			}
+		}()
+		return false, ctx.Err()
+	}
+}
+
+// Locks lists the locks of all the nodes from the owners kept in redis,
+// oldest first
+func (l *locker) Locks() ([]metrics.Lock, error) {
+	conn := l.rp.Get()
+	defer conn.Close()
+	var (
+		locks  []metrics.Lock
+		cursor int64
+	)
+	for {
+		values, err := redis.Values(conn.Do("SCAN", cursor, "MATCH", keyPrefix+"*"))
 		if err != nil {
-			return nil, err
+			return nil, lockerErrors.Unavailable(err)
+		}
+		var keys []string
+		if _, err := redis.Scan(values, &cursor, &keys); err != nil {
+			return nil, lockerErrors.Unavailable(err)
+		}
+		for _, key := range keys {
+			data, err := redis.Bytes(conn.Do("GET", key))
+			// released meanwhile
+			if err == redis.ErrNil {
+				continue
+			}
+			if err != nil {
+				return nil, lockerErrors.Unavailable(err)
+			}
+			name := strings.TrimPrefix(key, keyPrefix)
+			i := strings.Index(name, separator)
+			if i < 0 {
+				continue
+			}
+			if v, ok := metrics.ParseLock(name[:i], name[i+1:], data); ok {
+				locks = append(locks, v)
+			}
+		}
+		if cursor == 0 {
+			break
 		}
-		return func() error { return l.unlock(key) }, nil
-	case <-time.After(t):
-		return nil, ErrTimeout
 	}
+	metrics.SortLocks(locks)
+	return locks, nil
 }
 
-func (l *locker) unlock(key string) error {
+// Release force releases the lock of namespace and id, a lock that is
+// not held by this node is deleted from redis.
+func (l *locker) Release(namespace, id string) error {
+	if err := l.Recorder.Release(namespace, id); err != lockerErrors.ErrNotHeld {
+		return err
+	}
 	conn := l.rp.Get()
-	_, err := conn.Do("DEL", key)
-	conn.Close()
-	return err
+	defer conn.Close()
+	n, err := redis.Int(conn.Do("DEL", keyPrefix+namespace+separator+id))
+	if err != nil {
+		return lockerErrors.Unavailable(err)
+	}
+	if n == 0 {
+		return lockerErrors.ErrNotHeld
+	}
+	return nil
+}
+
+// unlock deletes the key if it is still held by owner, returns
+// errors.ErrNotHeld if the lease expired or the lock was force released
+func (l *locker) unlock(key string, owner []byte) error {
+	conn := l.rp.Get()
+	defer conn.Close()
+	n, err := redis.Int(conn.Do("EVAL", unlockScript, 1, key, owner))
+	if err != nil {
+		return lockerErrors.Unavailable(err)
+	}
+	if n == 0 {
+		return lockerErrors.ErrNotHeld
+	}
+	return nil
 }
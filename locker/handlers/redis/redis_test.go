@@ -28,8 +28,12 @@ import (
 	"time"
 
 	"github.com/digota/digota/config"
+	lockerErrors "github.com/digota/digota/locker/errors"
+	"github.com/digota/digota/locker/metrics"
 	"github.com/garyburd/redigo/redis"
 	uuid "github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
 )
 
 type testObj struct {
@@ -66,6 +70,8 @@ type testRedisConn struct {
 	doReply    interface{}
 	doError    error
 	doBlocking time.Duration
+	// replies of single commands, doReply replies the rest
+	replies map[string]interface{}
 }
 
 func (rc *testRedisConn) Close() error {
@@ -77,6 +83,9 @@ func (rc *testRedisConn) Err() error {
 func (rc *testRedisConn) Do(commandName string, args ...interface{}) (reply interface{}, err error) {
 	rc.doCmd = commandName
 	rc.doParams = args
+	if reply, ok := rc.replies[commandName]; ok {
+		return reply, nil
+	}
 	if rc.doBlocking > 0 {
 		time.Sleep(rc.doBlocking)
 	}
@@ -108,6 +117,7 @@ func TestLock_Lock(t *testing.T) {
 	rc := &testRedisConn{
 		doReply: "OK",
 		doError: nil,
+		replies: map[string]interface{}{"EVAL": int64(1)},
 	}
 	l := &locker{rp: &testPool{redisConn: rc}}
 
@@ -126,8 +136,12 @@ func TestLock_Lock(t *testing.T) {
 		t.Errorf("Wrong key! Expected: %s, Got: %s", objKey, rc.doParams[0].(string))
 	}
 
-	if rc.doParams[1].(string) != "NX" {
-		t.Errorf("Wrong param! Expected: NX, Got: %s", rc.doParams[1].(string))
+	if v, ok := metrics.ParseLock(testObj.GetNamespace(), testObj.GetId(), rc.doParams[1].([]byte)); !ok || v.Owner != metrics.Node() {
+		t.Errorf("Wrong owner! Expected: %s, Got: %s", metrics.Node(), rc.doParams[1])
+	}
+
+	if rc.doParams[2].(string) != "NX" || rc.doParams[3].(string) != "PX" || rc.doParams[4].(int64) != int64(DefaultLease/time.Millisecond) {
+		t.Errorf("Wrong params! Expected: NX PX %d, Got: %v", int64(DefaultLease/time.Millisecond), rc.doParams[2:])
 	}
 
 	if err = unlock(); err != nil {
@@ -151,7 +165,7 @@ func TestLock_LockFail(t *testing.T) {
 
 	testObj.Id = uuid.NewV4().String()
 	_, err = l.Lock(testObj)
-	if err != errConnFailed {
+	if e, ok := err.(*lockerErrors.Error); !ok || e.Err != errConnFailed || e.Code != codes.Unavailable {
 		t.Fatal(err)
 	}
 }
@@ -161,6 +175,7 @@ func TestLock_TryLockSuccess(t *testing.T) {
 		doReply:    "OK",
 		doError:    nil,
 		doBlocking: 10 * time.Millisecond,
+		replies:    map[string]interface{}{"EVAL": int64(1)},
 	}
 	l := &locker{rp: &testPool{redisConn: rc}}
 
@@ -179,8 +194,12 @@ func TestLock_TryLockSuccess(t *testing.T) {
 		t.Errorf("Wrong key! Expected: %s, Got: %s", objKey, rc.doParams[0].(string))
 	}
 
-	if rc.doParams[1].(string) != "NX" {
-		t.Errorf("Wrong param! Expected: NX, Got: %s", rc.doParams[1].(string))
+	if v, ok := metrics.ParseLock(testObj.GetNamespace(), testObj.GetId(), rc.doParams[1].([]byte)); !ok || v.Owner != metrics.Node() {
+		t.Errorf("Wrong owner! Expected: %s, Got: %s", metrics.Node(), rc.doParams[1])
+	}
+
+	if rc.doParams[2].(string) != "NX" || rc.doParams[3].(string) != "PX" || rc.doParams[4].(int64) != int64(DefaultLease/time.Millisecond) {
+		t.Errorf("Wrong params! Expected: NX PX %d, Got: %v", int64(DefaultLease/time.Millisecond), rc.doParams[2:])
 	}
 
 	if err = unlock(); err != nil {
@@ -210,6 +229,48 @@ func TestLock_TryLockTimeout(t *testing.T) {
 	}
 }
 
+func TestLock_TryLockHeld(t *testing.T) {
+	// SET NX replies nil while someone else holds the key
+	rc := &testRedisConn{}
+	l := &locker{rp: &testPool{redisConn: rc}}
+
+	testObj := &testObj{Id: uuid.NewV4().String()}
+	if _, err := l.TryLock(testObj, 50*time.Millisecond); err != ErrTimeout {
+		t.Fatal(err)
+	}
+	if rc.doCmd != "SET" {
+		t.Errorf("Wrong redis command! Expected: SET, Got: %s", rc.doCmd)
+	}
+}
+
+func TestLock_TryLockContextCanceled(t *testing.T) {
+	rc := &testRedisConn{
+		doReply:    "OK",
+		doError:    nil,
+		doBlocking: 100 * time.Millisecond,
+	}
+	l := &locker{rp: &testPool{redisConn: rc}}
+
+	testObj := &testObj{Id: uuid.NewV4().String()}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := l.TryLockContext(ctx, testObj, time.Second)
+	if err != context.DeadlineExceeded {
+		t.Fatal(err)
+	}
+
+	// canceled ctx should not reach redis at all
+	rc = &testRedisConn{}
+	l = &locker{rp: &testPool{redisConn: rc}}
+	_, err = l.LockContext(ctx, testObj)
+	if err != context.DeadlineExceeded {
+		t.Fatal(err)
+	}
+	if rc.doCmd != "" {
+		t.Errorf("Unexpected redis command %s", rc.doCmd)
+	}
+}
+
 func TestLock_TryLockFailed(t *testing.T) {
 	errConnFailed := errors.New("connection failed")
 	rc := &testRedisConn{
@@ -221,24 +282,70 @@ func TestLock_TryLockFailed(t *testing.T) {
 
 	testObj := &testObj{Id: uuid.NewV4().String()}
 	_, err := l.TryLock(testObj, 100*time.Millisecond)
-	if err != errConnFailed {
+	if e, ok := err.(*lockerErrors.Error); !ok || e.Err != errConnFailed || e.Code != codes.Unavailable {
 		t.Fatal(err)
 	}
 }
 
 func TestLock_unlock(t *testing.T) {
 	rc := &testRedisConn{
+		doReply: int64(1),
 		doError: nil,
 	}
 	l := &locker{rp: &testPool{redisConn: rc}}
 
-	err := l.unlock("lockKey")
+	owner := metrics.Owner(time.Now())
+	err := l.unlock("lockKey", owner)
 	if err != nil {
 		t.Error(err)
 	}
 
-	if rc.doCmd != "DEL" {
-		t.Errorf("Wrong redis command! Expected: DEL, Got: %s", rc.doCmd)
+	if rc.doCmd != "EVAL" || rc.doParams[2].(string) != "lockKey" || string(rc.doParams[3].([]byte)) != string(owner) {
+		t.Errorf("Wrong redis command! Expected: EVAL of lockKey, Got: %s %v", rc.doCmd, rc.doParams)
+	}
+
+	// held by someone else once the lease expired
+	rc.doReply = int64(0)
+	if err := l.unlock("lockKey", owner); err != lockerErrors.ErrNotHeld {
+		t.Fatal(err)
+	}
+}
+
+func TestLock_Release(t *testing.T) {
+	rc := &testRedisConn{
+		doReply: "OK",
+		doError: nil,
+		replies: map[string]interface{}{"EVAL": int64(1)},
+	}
+	l := &locker{rp: &testPool{redisConn: rc}}
+
+	testObj := &testObj{Id: uuid.NewV4().String()}
+	if _, err := l.Lock(testObj); err != nil {
+		t.Fatal(err)
+	}
+
+	// held by this node, released by its owner
+	if err := l.Release(testObj.GetNamespace(), testObj.GetId()); err != nil {
+		t.Fatal(err)
+	}
+	if rc.doCmd != "EVAL" {
+		t.Errorf("Wrong redis command! Expected: EVAL, Got: %s", rc.doCmd)
+	}
+
+	// held by another node
+	rc.doReply = int64(1)
+	if err := l.Release(testObj.GetNamespace(), testObj.GetId()); err != nil {
+		t.Fatal(err)
+	}
+	objKey, _ := getKey(testObj)
+	if rc.doParams[0].(string) != objKey {
+		t.Errorf("Wrong key! Expected: %s, Got: %s", objKey, rc.doParams[0].(string))
+	}
+
+	// not held at all
+	rc.doReply = int64(0)
+	if err := l.Release(testObj.GetNamespace(), testObj.GetId()); err != lockerErrors.ErrNotHeld {
+		t.Fatal(err)
 	}
 }
 
@@ -248,3 +355,33 @@ func TestLock_getKey(t *testing.T) {
 		t.Fatal("getKey should return an error for missing object id")
 	}
 }
+
+func TestLock_Locks(t *testing.T) {
+	testObj := &testObj{Id: uuid.NewV4().String()}
+	objKey, _ := getKey(testObj)
+	rc := &testRedisConn{
+		replies: map[string]interface{}{
+			"SCAN": []interface{}{[]byte("0"), []interface{}{[]byte(objKey)}},
+			"GET":  metrics.Owner(time.Now()),
+		},
+	}
+	l := &locker{rp: &testPool{redisConn: rc}}
+
+	locks, err := l.Locks()
+	if err != nil || len(locks) != 1 || locks[0].Namespace != testObj.GetNamespace() || locks[0].Id != testObj.GetId() || locks[0].Owner != metrics.Node() {
+		t.Fatal(locks, err)
+	}
+
+	// released meanwhile
+	rc.replies["GET"] = nil
+	if locks, err := l.Locks(); err != nil || len(locks) != 0 {
+		t.Fatal(locks, err)
+	}
+
+	// redis is down
+	delete(rc.replies, "SCAN")
+	rc.doError = errors.New("connection failed")
+	if _, err := l.Locks(); err == nil {
+		t.Fatal(err)
+	}
+}
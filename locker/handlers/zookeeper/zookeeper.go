@@ -26,10 +26,15 @@
 package zookeeper
 
 import (
-	"errors"
 	"github.com/digota/digota/config"
+	lockerErrors "github.com/digota/digota/locker/errors"
+	"github.com/digota/digota/locker/fence"
+	"github.com/digota/digota/locker/metrics"
 	"github.com/digota/digota/storage/object"
 	"github.com/yaronsumel/go-zookeeper/zk"
+	"golang.org/x/net/context"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -37,6 +42,7 @@ const separator = "/"
 
 type lock struct {
 	*zk.Conn
+	metrics.Recorder
 }
 
 // NewLocker return new lock
@@ -45,14 +51,76 @@ func NewLocker(lockerConfig config.Locker) (*lock, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &lock{c}, nil
+	return &lock{Conn: c}, nil
 }
 
-func (l *lock) newLock(obj object.Interface) (*zk.Lock, error) {
+// node is a sequential lock node under the lock path of an object, the
+// lock is held by the lowest node
+type node struct {
+	conn *zk.Conn
+	// lock path of the object and the path of this node under it
+	path     string
+	nodePath string
+}
+
+func (l *lock) newLock(obj object.Interface) (*node, error) {
 	if obj.GetNamespace() == "" || obj.GetId() == "" {
-		return nil, errors.New("Obj is missing information to make that lock")
+		return nil, lockerErrors.ErrMissingInfo
+	}
+	return &node{conn: l.Conn, path: getPath(obj)}, nil
+}
+
+// create creates the sequential node of n, the lock path is created
+// first if missing
+func (n *node) create() error {
+	acl := zk.WorldACL(zk.PermAll)
+	var err error
+	for i := 0; i < 3; i++ {
+		var path string
+		if path, err = n.conn.CreateProtectedEphemeralSequential(n.path+separator+"lock-", []byte{}, acl); err == nil {
+			n.nodePath = path
+			return nil
+		}
+		if err != zk.ErrNoNode {
+			return err
+		}
+		p := ""
+		for _, part := range strings.Split(n.path, separator)[1:] {
+			p += separator + part
+			if _, err := n.conn.Create(p, []byte{}, 0, acl); err != nil && err != zk.ErrNodeExists {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// Unlock deletes the node of n
+func (n *node) Unlock() error {
+	return n.conn.Delete(n.nodePath, -1)
+}
+
+// seq returns the sequence number of the node at path
+func seq(path string) (int, error) {
+	return strconv.Atoi(path[strings.LastIndex(path, "-")+1:])
+}
+
+func getPath(obj object.Interface) string {
+	return separator + obj.GetNamespace() + separator + obj.GetId()
+}
+
+// held returns the release func of the acquired lock z once the owner is
+// written to the lock path and obj got its fencing token
+func (l *lock) held(obj object.Interface, z *node) (func() error, error) {
+	unlock := func() error { return z.Unlock() }
+	if _, err := l.Conn.Set(getPath(obj), metrics.Owner(time.Now()), -1); err != nil {
+		unlock()
+		return nil, lockerErrors.Unavailable(err)
+	}
+	if err := fence.Set(obj, unlock); err != nil {
+		return nil, err
 	}
-	return zk.NewLock(l.Conn, separator+obj.GetNamespace()+separator+obj.GetId(), zk.WorldACL(zk.PermAll)), nil
+	return unlock, nil
 }
 
 func (l *lock) Close() error {
@@ -61,23 +129,176 @@ func (l *lock) Close() error {
 }
 
 func (l *lock) Lock(obj object.Interface) (func() error, error) {
+	return l.LockContext(context.Background(), obj)
+}
+
+func (l *lock) LockContext(ctx context.Context, obj object.Interface) (func() error, error) {
+	start := time.Now()
+	unlock, err := l.lock(ctx, obj)
+	return l.Track(obj, start, unlock, err)
+}
+
+func (l *lock) lock(ctx context.Context, obj object.Interface) (func() error, error) {
 	z, err := l.newLock(obj)
 	if err != nil {
 		return nil, err
 	}
-	if err := z.Lock(); err != nil {
+	if err := acquire(ctx, z); err != nil {
 		return nil, err
 	}
-	return func() error { return z.Unlock() }, nil
+	return l.held(obj, z)
 }
 
 func (l *lock) TryLock(obj object.Interface, t time.Duration) (func() error, error) {
+	return l.TryLockContext(context.Background(), obj, t)
+}
+
+func (l *lock) TryLockContext(ctx context.Context, obj object.Interface, t time.Duration) (func() error, error) {
+	start := time.Now()
+	unlock, err := l.tryLock(ctx, obj, t)
+	return l.Track(obj, start, unlock, err)
+}
+
+func (l *lock) tryLock(ctx context.Context, obj object.Interface, t time.Duration) (func() error, error) {
 	z, err := l.newLock(obj)
 	if err != nil {
 		return nil, err
 	}
-	if err := z.TryLock(t); err != nil {
+	tctx, cancel := context.WithTimeout(ctx, t)
+	defer cancel()
+	if err := acquire(tctx, z); err != nil {
+		// the deadline is ours, not the caller's
+		if err == context.DeadlineExceeded && ctx.Err() == nil {
+			return nil, lockerErrors.ErrTimeout
+		}
 		return nil, err
 	}
-	return func() error { return z.Unlock() }, nil
+	return l.held(obj, z)
+}
+
+// Locks lists the locks of all the nodes from the owners written to the
+// lock nodes, oldest first. lock nodes without children are not held.
+func (l *lock) Locks() ([]metrics.Lock, error) {
+	namespaces, _, err := l.Conn.Children(separator)
+	if err != nil {
+		return nil, lockerErrors.Unavailable(err)
+	}
+	var locks []metrics.Lock
+	for _, namespace := range namespaces {
+		// zookeeper own node
+		if namespace == "zookeeper" {
+			continue
+		}
+		ids, _, err := l.Conn.Children(separator + namespace)
+		if err == zk.ErrNoNode {
+			continue
+		}
+		if err != nil {
+			return nil, lockerErrors.Unavailable(err)
+		}
+		for _, id := range ids {
+			data, stat, err := l.Conn.Get(separator + namespace + separator + id)
+			if err == zk.ErrNoNode {
+				continue
+			}
+			if err != nil {
+				return nil, lockerErrors.Unavailable(err)
+			}
+			if stat.NumChildren == 0 {
+				continue
+			}
+			if v, ok := metrics.ParseLock(namespace, id, data); ok {
+				locks = append(locks, v)
+			}
+		}
+	}
+	metrics.SortLocks(locks)
+	return locks, nil
+}
+
+// Release force releases the lock of namespace and id, a lock that is
+// not held by this node gets its lock nodes deleted.
+func (l *lock) Release(namespace, id string) error {
+	if err := l.Recorder.Release(namespace, id); err != lockerErrors.ErrNotHeld {
+		return err
+	}
+	path := separator + namespace + separator + id
+	children, _, err := l.Conn.Children(path)
+	if err == zk.ErrNoNode || (err == nil && len(children) == 0) {
+		return lockerErrors.ErrNotHeld
+	}
+	if err != nil {
+		return lockerErrors.Unavailable(err)
+	}
+	for _, c := range children {
+		if err := l.Conn.Delete(path+separator+c, -1); err != nil && err != zk.ErrNoNode {
+			return lockerErrors.Unavailable(err)
+		}
+	}
+	return nil
+}
+
+// acquire creates the node of z and waits for it to become the lowest node
+// till ctx is done. the node is deleted if ctx is done first, so an
+// abandoned acquisition doesn't keep its place in line.
+func acquire(ctx context.Context, z *node) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := z.create(); err != nil {
+		return lockerErrors.Unavailable(err)
+	}
+	if err := wait(ctx, z); err != nil {
+		z.Unlock()
+		if err == ctx.Err() {
+			return err
+		}
+		return lockerErrors.Unavailable(err)
+	}
+	return nil
+}
+
+// wait waits for z to become the lowest node, watching the node just
+// before it
+func wait(ctx context.Context, z *node) error {
+	own, err := seq(z.nodePath)
+	if err != nil {
+		return err
+	}
+	for {
+		children, _, err := z.conn.Children(z.path)
+		if err != nil {
+			return err
+		}
+		prev, prevPath := -1, ""
+		for _, c := range children {
+			s, err := seq(c)
+			if err != nil {
+				return err
+			}
+			if s < own && s > prev {
+				prev, prevPath = s, c
+			}
+		}
+		// lowest node
+		if prevPath == "" {
+			return nil
+		}
+		_, _, ch, err := z.conn.GetW(z.path + separator + prevPath)
+		// released meanwhile
+		if err == zk.ErrNoNode {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		select {
+		case ev := <-ch:
+			if ev.Err != nil {
+				return ev.Err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
 }
@@ -25,6 +25,7 @@ package zookeeper
 import (
 	"github.com/digota/digota/config"
 	"github.com/satori/go.uuid"
+	"golang.org/x/net/context"
 	"testing"
 	"time"
 )
@@ -117,3 +118,80 @@ func TestLock_TryLock(t *testing.T) {
 		unlock()
 	}
 }
+
+func TestLock_TryLockContext(t *testing.T) {
+
+	l, err := NewLocker(config.Locker{Address: []string{"localhost"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	id := uuid.NewV4().String()
+	unlock, err := l.LockContext(context.Background(), &testObj{Id: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	if unlock, err := l.TryLockContext(ctx, &testObj{Id: id}, time.Second); err != context.DeadlineExceeded {
+		t.Fatal(err)
+		unlock()
+	}
+
+	// the abandoned node is deleted, only the holder is left
+	children, _, err := l.Conn.Children(getPath(&testObj{Id: id}))
+	if err != nil || len(children) != 1 {
+		t.Fatal(children, err)
+	}
+}
+
+func TestSeq(t *testing.T) {
+	if n, err := seq("/ns/id/_c_0a1b-lock-0000000012"); err != nil || n != 12 {
+		t.Fatal(n, err)
+	}
+	if _, err := seq("lock"); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestLock_Locks(t *testing.T) {
+
+	l, err := NewLocker(config.Locker{Address: []string{"localhost"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	obj := &testObj{Id: uuid.NewV4().String()}
+	unlock, err := l.Lock(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	has := func() bool {
+		locks, err := l.Locks()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, v := range locks {
+			if v.Namespace == obj.GetNamespace() && v.Id == obj.GetId() {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !has() {
+		t.Fatal("held lock is not listed")
+	}
+
+	unlock()
+	if has() {
+		t.Fatal("released lock is listed")
+	}
+
+}
@@ -29,7 +29,9 @@ import (
 	"github.com/digota/digota/locker/handlers/memlock"
 	"github.com/digota/digota/locker/handlers/redis"
 	"github.com/digota/digota/locker/handlers/zookeeper"
+	"github.com/digota/digota/locker/metrics"
 	"github.com/digota/digota/storage/object"
+	"golang.org/x/net/context"
 )
 
 const (
@@ -43,11 +45,23 @@ const (
 type (
 	handlerName string
 	// Interface is the base functionality that any locker handler
-	// should implement in order to become valid handler
+	// should implement in order to become valid handler. the Context
+	// variants stop waiting for the lock once ctx is done. docs that
+	// implement object.Fencer get a fencing token on every acquisition,
+	// issued by the storage handler so tokens grow per object whichever
+	// handler or node hands out the lock.
+	// Metrics reports the lock activity of this node, Locks lists the
+	// locks held by all the nodes sharing the handler backend and Release
+	// force releases a lock no matter who holds it.
 	Interface interface {
 		Close() error
 		Lock(doc object.Interface) (func() error, error)
+		LockContext(ctx context.Context, doc object.Interface) (func() error, error)
 		TryLock(doc object.Interface, t time.Duration) (func() error, error)
+		TryLockContext(ctx context.Context, doc object.Interface, t time.Duration) (func() error, error)
+		Metrics() []metrics.Stats
+		Locks() ([]metrics.Lock, error)
+		Release(namespace, id string) error
 	}
 )
 
@@ -0,0 +1,267 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	lockerErrors "github.com/digota/digota/locker/errors"
+	"github.com/digota/digota/storage/object"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+)
+
+// node identifies this process as the owner of its locks
+var node = getNode()
+
+type (
+	// Stats describes the lock activity of a single namespace
+	Stats struct {
+		Namespace string
+		// Acquired is the number of successful acquisitions
+		Acquired int64
+		// Timeouts is the number of acquisitions that ran out of time
+		Timeouts int64
+		// Failures is the number of acquisitions that failed otherwise
+		Failures int64
+		// AcquireTime is the total time spent waiting for acquired locks
+		AcquireTime    time.Duration
+		MaxAcquireTime time.Duration
+		// HoldTime is the total time released locks were held
+		HoldTime    time.Duration
+		MaxHoldTime time.Duration
+	}
+	// Lock describes a held lock and the node holding it
+	Lock struct {
+		Namespace string
+		Id        string
+		Owner     string
+		Acquired  time.Time
+	}
+	// Recorder records lock metrics and keeps track of the held locks,
+	// the zero value is ready to use.
+	Recorder struct {
+		mtx   sync.Mutex
+		seq   uint64
+		stats map[string]*Stats
+		held  map[uint64]*held
+	}
+	held struct {
+		Lock
+		release func() error
+	}
+	// owner is the lock owner handlers keep along with the lock in
+	// their backend
+	owner struct {
+		Owner    string `json:"owner"`
+		Acquired int64  `json:"acquired"`
+	}
+)
+
+// Node returns the name of this node, the owner of its locks
+func Node() string {
+	return node
+}
+
+// Owner returns the owner of a lock acquired by this node at t, as kept
+// in the handler backend
+func Owner(t time.Time) []byte {
+	b, _ := json.Marshal(&owner{Owner: node, Acquired: t.UnixNano()})
+	return b
+}
+
+// ParseLock returns the lock of namespace and id held by the owner kept
+// in data, false if data holds no owner.
+func ParseLock(namespace, id string, data []byte) (Lock, bool) {
+	o := owner{}
+	if err := json.Unmarshal(data, &o); err != nil || o.Owner == "" {
+		return Lock{}, false
+	}
+	return Lock{
+		Namespace: namespace,
+		Id:        id,
+		Owner:     o.Owner,
+		Acquired:  time.Unix(0, o.Acquired),
+	}, true
+}
+
+// SortLocks sorts locks oldest first
+func SortLocks(locks []Lock) {
+	sort.Slice(locks, func(i, j int) bool {
+		return locks[i].Acquired.Before(locks[j].Acquired)
+	})
+}
+
+func getNode() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
+
+// Track records the outcome of an acquisition of doc started at start and
+// returns its result. the returned unlock func records the hold duration,
+// calling it more than once or after a forced release is a no-op.
+func (r *Recorder) Track(doc object.Interface, start time.Time, unlock func() error, err error) (func() error, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	s := r.namespace(doc.GetNamespace())
+	if err != nil {
+		if err == lockerErrors.ErrTimeout || err == context.DeadlineExceeded {
+			s.Timeouts++
+			r.logTimeout(doc, time.Since(start))
+		} else {
+			s.Failures++
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	s.Acquired++
+	s.AcquireTime += now.Sub(start)
+	if d := now.Sub(start); d > s.MaxAcquireTime {
+		s.MaxAcquireTime = d
+	}
+
+	if r.held == nil {
+		r.held = make(map[uint64]*held)
+	}
+	r.seq++
+	id := r.seq
+	h := &held{
+		Lock: Lock{
+			Namespace: doc.GetNamespace(),
+			Id:        doc.GetId(),
+			Owner:     node,
+			Acquired:  now,
+		},
+	}
+	var once sync.Once
+	h.release = func() error {
+		var err error
+		once.Do(func() {
+			r.released(id)
+			err = unlock()
+		})
+		return err
+	}
+	r.held[id] = h
+	return h.release, nil
+}
+
+// released removes the lock id and records its hold duration
+func (r *Recorder) released(id uint64) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	h, ok := r.held[id]
+	if !ok {
+		return
+	}
+	delete(r.held, id)
+	s := r.namespace(h.Namespace)
+	d := time.Since(h.Acquired)
+	s.HoldTime += d
+	if d > s.MaxHoldTime {
+		s.MaxHoldTime = d
+	}
+}
+
+// namespace returns the stats of namespace, must be called with mtx held
+func (r *Recorder) namespace(namespace string) *Stats {
+	if r.stats == nil {
+		r.stats = make(map[string]*Stats)
+	}
+	s, ok := r.stats[namespace]
+	if !ok {
+		s = &Stats{Namespace: namespace}
+		r.stats[namespace] = s
+	}
+	return s
+}
+
+// logTimeout reports the local holders of doc, must be called with mtx held
+func (r *Recorder) logTimeout(doc object.Interface, waited time.Duration) {
+	for _, h := range r.held {
+		if h.Namespace == doc.GetNamespace() && h.Id == doc.GetId() {
+			log.Warnf("Lock %s/%s timed out after %s => held by %s for %s", h.Namespace, h.Id, waited, h.Owner, time.Since(h.Acquired))
+			return
+		}
+	}
+	log.Warnf("Lock %s/%s timed out after %s => not held by %s", doc.GetNamespace(), doc.GetId(), waited, node)
+}
+
+// Metrics returns a snapshot of the stats of every namespace
+func (r *Recorder) Metrics() []Stats {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	var stats []Stats
+	for _, s := range r.stats {
+		stats = append(stats, *s)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Namespace < stats[j].Namespace
+	})
+	return stats
+}
+
+// Locks returns the locks currently held by this node, oldest first
+func (r *Recorder) Locks() []Lock {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	var locks []Lock
+	for _, h := range r.held {
+		locks = append(locks, h.Lock)
+	}
+	SortLocks(locks)
+	return locks
+}
+
+// Release force releases the locks of namespace and id held by this
+// node, returns errors.ErrNotHeld if there are none.
+func (r *Recorder) Release(namespace, id string) error {
+	r.mtx.Lock()
+	var release []func() error
+	for _, h := range r.held {
+		if h.Namespace == namespace && h.Id == id {
+			release = append(release, h.release)
+		}
+	}
+	r.mtx.Unlock()
+	if len(release) == 0 {
+		return lockerErrors.ErrNotHeld
+	}
+	for _, fn := range release {
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+	log.Warnf("Lock %s/%s was force released", namespace, id)
+	return nil
+}
@@ -0,0 +1,128 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	lockerErrors "github.com/digota/digota/locker/errors"
+	"golang.org/x/net/context"
+)
+
+type testObj struct {
+	Id string
+}
+
+func (o *testObj) GetNamespace() string {
+	return "metrics_test"
+}
+
+func (o *testObj) GetId() string {
+	return o.Id
+}
+
+func TestRecorder_Track(t *testing.T) {
+	r := &Recorder{}
+	obj := &testObj{Id: "1"}
+	released := 0
+
+	unlock, err := r.Track(obj, time.Now().Add(-time.Second), func() error {
+		released++
+		return nil
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if locks := r.Locks(); len(locks) != 1 || locks[0].Id != "1" || locks[0].Owner != node {
+		t.Fatal(locks)
+	}
+
+	// unlock twice
+	unlock()
+	unlock()
+	if released != 1 || len(r.Locks()) != 0 {
+		t.Fatal(released)
+	}
+
+	errFailed := errors.New("failed")
+	if _, err := r.Track(obj, time.Now(), nil, errFailed); err != errFailed {
+		t.Fatal(err)
+	}
+	r.Track(obj, time.Now(), nil, lockerErrors.ErrTimeout)
+	r.Track(obj, time.Now(), nil, context.DeadlineExceeded)
+
+	stats := r.Metrics()
+	if len(stats) != 1 {
+		t.Fatal(stats)
+	}
+	s := stats[0]
+	if s.Namespace != obj.GetNamespace() || s.Acquired != 1 || s.Timeouts != 2 || s.Failures != 1 {
+		t.Fatal(s)
+	}
+	if s.MaxAcquireTime < time.Second || s.AcquireTime != s.MaxAcquireTime || s.HoldTime != s.MaxHoldTime {
+		t.Fatal(s)
+	}
+}
+
+func TestRecorder_Release(t *testing.T) {
+	r := &Recorder{}
+	obj := &testObj{Id: "1"}
+	released := 0
+
+	unlock, _ := r.Track(obj, time.Now(), func() error {
+		released++
+		return nil
+	}, nil)
+
+	if err := r.Release(obj.GetNamespace(), "2"); err != lockerErrors.ErrNotHeld {
+		t.Fatal(err)
+	}
+
+	if err := r.Release(obj.GetNamespace(), obj.GetId()); err != nil {
+		t.Fatal(err)
+	}
+
+	// holder unlock after a forced release
+	unlock()
+	if released != 1 || len(r.Locks()) != 0 {
+		t.Fatal(released)
+	}
+
+	if err := r.Release(obj.GetNamespace(), obj.GetId()); err != lockerErrors.ErrNotHeld {
+		t.Fatal(err)
+	}
+}
+
+func TestParseLock(t *testing.T) {
+	now := time.Now()
+	l, ok := ParseLock("ns", "1", Owner(now))
+	if !ok || l.Namespace != "ns" || l.Id != "1" || l.Owner != Node() || !l.Acquired.Equal(time.Unix(0, now.UnixNano())) {
+		t.Fatal(l)
+	}
+	if _, ok := ParseLock("ns", "1", []byte("NX")); ok {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,171 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/digota/digota/admin/adminpb"
+	"github.com/digota/digota/sdk"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"gopkg.in/urfave/cli.v1"
+)
+
+// adminFlags are the connection flags of the admin commands
+var adminFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "addr",
+		Value: "localhost:3051",
+		Usage: "Address of the digota node",
+	},
+	cli.StringFlag{
+		Name:  "crt",
+		Usage: "Client certificate",
+	},
+	cli.StringFlag{
+		Name:  "key",
+		Usage: "Client key",
+	},
+	cli.StringFlag{
+		Name:  "ca",
+		Usage: "CA certificate",
+	},
+	cli.StringFlag{
+		Name:  "server-name",
+		Usage: "Server name of the node certificate",
+	},
+	cli.BoolFlag{
+		Name:  "insecure",
+		Usage: "Connect without TLS",
+	},
+}
+
+// locksCommand lists, inspects and force releases the locks of the nodes
+var locksCommand = cli.Command{
+	Name:  "locks",
+	Usage: "Inspect the locks of the nodes",
+	Subcommands: []cli.Command{
+		{
+			Name:   "list",
+			Usage:  "List the locks held by all the nodes",
+			Flags:  append(adminFlags, cli.StringFlag{Name: "namespace", Usage: "Only list locks of namespace"}),
+			Action: listLocks,
+		},
+		{
+			Name:   "stats",
+			Usage:  "Show the lock activity of the node per namespace",
+			Flags:  adminFlags,
+			Action: lockStats,
+		},
+		{
+			Name:      "release",
+			Usage:     "Force release a stuck lock",
+			ArgsUsage: "<namespace> <id>",
+			Flags:     adminFlags,
+			Action:    releaseLock,
+		},
+	},
+}
+
+func newAdminClient(c *cli.Context) (adminpb.AdminServiceClient, *grpc.ClientConn, error) {
+	var (
+		conn *grpc.ClientConn
+		err  error
+	)
+	if c.Bool("insecure") {
+		conn, err = grpc.Dial(c.String("addr"), grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(5*time.Second))
+	} else {
+		conn, err = sdk.NewClient(c.String("addr"), &sdk.ClientOpt{
+			Crt:        c.String("crt"),
+			Key:        c.String("key"),
+			CaCrt:      c.String("ca"),
+			ServerName: c.String("server-name"),
+			WithBlock:  true,
+		})
+	}
+	if err != nil {
+		return nil, nil, cli.NewExitError(fmt.Sprintf("Could not connect to %s => %s", c.String("addr"), err.Error()), 1)
+	}
+	return adminpb.NewAdminServiceClient(conn), conn, nil
+}
+
+func listLocks(c *cli.Context) error {
+	client, conn, err := newAdminClient(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	list, err := client.ListLocks(context.Background(), &adminpb.ListLocksRequest{Namespace: c.String("namespace")})
+	if err != nil {
+		return cli.NewExitError(err.Error(), 1)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tID\tOWNER\tAGE")
+	for _, v := range list.GetLocks() {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", v.GetNamespace(), v.GetId(), v.GetOwner(), time.Duration(v.GetAge())*time.Millisecond)
+	}
+	return w.Flush()
+}
+
+func lockStats(c *cli.Context) error {
+	client, conn, err := newAdminClient(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	list, err := client.LockStats(context.Background(), &adminpb.LockStatsRequest{})
+	if err != nil {
+		return cli.NewExitError(err.Error(), 1)
+	}
+	fmt.Printf("NODE %s\n", list.GetNode())
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tACQUIRED\tTIMEOUTS\tFAILURES\tACQUIRE AVG/MAX\tHOLD AVG/MAX")
+	for _, v := range list.GetStats() {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%dms/%dms\t%dms/%dms\n", v.GetNamespace(), v.GetAcquired(), v.GetTimeouts(), v.GetFailures(),
+			v.GetAcquireTimeAvg(), v.GetAcquireTimeMax(), v.GetHoldTimeAvg(), v.GetHoldTimeMax())
+	}
+	return w.Flush()
+}
+
+func releaseLock(c *cli.Context) error {
+	if c.NArg() != 2 {
+		return cli.NewExitError("Usage: locks release <namespace> <id>", 1)
+	}
+	client, conn, err := newAdminClient(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err := client.ReleaseLock(context.Background(), &adminpb.ReleaseLockRequest{
+		Namespace: c.Args().Get(0),
+		Id:        c.Args().Get(1),
+	}); err != nil {
+		return cli.NewExitError(err.Error(), 1)
+	}
+	fmt.Printf("Released %s/%s\n", c.Args().Get(0), c.Args().Get(1))
+	return nil
+}
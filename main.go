@@ -92,6 +92,11 @@ func main() {
 			Usage: "Set log level to debug",
 		},
 	}
+	// admin commands
+	app.Commands = []cli.Command{
+		locksCommand,
+		ratesCommand,
+	}
 	// prepare things up
 	app.Action = func(c *cli.Context) error {
 		// set log to level
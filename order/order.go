@@ -23,18 +23,103 @@
 package order
 
 import (
+	"github.com/digota/digota/config"
 	"github.com/digota/digota/order/orderpb"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"regexp"
+	"time"
 )
 
-const baseMethod = "^(.orderpb.OrderService/)"
+const (
+	baseMethod = "^(.orderpb.OrderService/)"
+	// DefaultTTL is the time an order can be paid if no ttl is configured
+	DefaultTTL = time.Minute * 2
+	// DefaultMaxTTL is the longest ttl an order can ask for if no max ttl
+	// is configured
+	DefaultMaxTTL = time.Hour * 24
+	// DefaultSweepInterval is the time between expiry sweeps if no interval
+	// is configured
+	DefaultSweepInterval = time.Minute
+)
 
 var service Interface
 
+var conf config.Order
+
 // Interface defines the functionality of the order service
 type Interface interface {
 	orderpb.OrderServiceServer
+	NewWithCredit(ctx context.Context, req *NewWithCreditRequest) (*orderpb.Order, error)
+}
+
+// NewWithCreditRequest request for creating an order with a credit issued
+// by another service discounted from its amount, clients can't send credits
+type NewWithCreditRequest struct {
+	Order  *orderpb.NewRequest `validate:"required"`
+	Credit int64               `validate:"gte=0"`
+}
+
+// Expirer is implemented by services which expire stale orders, Expire
+// returns the number of orders it expired
+type Expirer interface {
+	Expire(ctx context.Context) (int, error)
+}
+
+// New saves the order config and starts the expiry sweeper of the
+// registered service in the background
+func New(c config.Order) {
+	conf = c
+	if e, ok := Service().(Expirer); ok {
+		go sweep(e, SweepInterval())
+	}
+}
+
+// TTL returns the configured order ttl
+func TTL() time.Duration {
+	if conf.TTL <= 0 {
+		return DefaultTTL
+	}
+	return conf.TTL
+}
+
+// MaxTTL returns the configured longest order ttl, never shorter than the
+// order ttl
+func MaxTTL() time.Duration {
+	max := conf.MaxTTL
+	if max <= 0 {
+		max = DefaultMaxTTL
+	}
+	if ttl := TTL(); ttl > max {
+		return ttl
+	}
+	return max
+}
+
+// SweepInterval returns the configured time between expiry sweeps
+func SweepInterval() time.Duration {
+	if conf.SweepInterval <= 0 {
+		return DefaultSweepInterval
+	}
+	return conf.SweepInterval
+}
+
+// sweep expires stale orders every interval
+func sweep(e Expirer, interval time.Duration) {
+	for range time.Tick(interval) {
+		n, err := e.Expire(context.Background())
+		switch {
+		// another node is sweeping
+		case status.Code(err) == codes.Aborted:
+		case err != nil:
+			log.Warnf("Order expiry sweep failed => %s", err.Error())
+		case n > 0:
+			log.Infof("Order expiry sweep expired %d orders", n)
+		}
+	}
 }
 
 // RegisterService register p as the service provider
@@ -63,6 +148,7 @@ func ReadMethods() []*regexp.Regexp {
 	return []*regexp.Regexp{
 		regexp.MustCompile(baseMethod + "Get"),
 		regexp.MustCompile(baseMethod + "List"),
+		regexp.MustCompile(baseMethod + "GetShippingRates"),
 	}
 }
 
@@ -72,5 +158,10 @@ func WriteMethods() []*regexp.Regexp {
 		regexp.MustCompile(baseMethod + "New"),
 		regexp.MustCompile(baseMethod + "Pay"),
 		regexp.MustCompile(baseMethod + "Return"),
+		regexp.MustCompile(baseMethod + "Fulfill"),
+		regexp.MustCompile(baseMethod + "Cancel"),
+		regexp.MustCompile(baseMethod + "Update"),
+		regexp.MustCompile(baseMethod + "CreateFulfillment"),
+		regexp.MustCompile(baseMethod + "AddNote"),
 	}
 }
@@ -23,12 +23,14 @@
 package order
 
 import (
+	"github.com/digota/digota/config"
 	"github.com/digota/digota/order/orderpb"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"reflect"
 	"regexp"
 	"testing"
+	"time"
 )
 
 // dummy service
@@ -50,6 +52,73 @@ func (s *dummyService) Return(context.Context, *orderpb.ReturnRequest) (*orderpb
 func (s *dummyService) List(context.Context, *orderpb.ListRequest) (*orderpb.OrderList, error) {
 	return nil, nil
 }
+func (s *dummyService) Fulfill(context.Context, *orderpb.FulfillRequest) (*orderpb.Order, error) {
+	return nil, nil
+}
+func (s *dummyService) Cancel(context.Context, *orderpb.CancelRequest) (*orderpb.Order, error) {
+	return nil, nil
+}
+func (s *dummyService) Update(context.Context, *orderpb.UpdateRequest) (*orderpb.Order, error) {
+	return nil, nil
+}
+func (s *dummyService) GetShippingRates(context.Context, *orderpb.ShippingRatesRequest) (*orderpb.ShippingQuoteList, error) {
+	return nil, nil
+}
+func (s *dummyService) CreateFulfillment(context.Context, *orderpb.FulfillmentRequest) (*orderpb.Order, error) {
+	return nil, nil
+}
+func (s *dummyService) AddNote(context.Context, *orderpb.NoteRequest) (*orderpb.Order, error) {
+	return nil, nil
+}
+func (s *dummyService) NewWithCredit(context.Context, *NewWithCreditRequest) (*orderpb.Order, error) {
+	return nil, nil
+}
+
+// dummy expirer
+type dummyExpirer struct {
+	dummyService
+	swept chan struct{}
+}
+
+func (s *dummyExpirer) Expire(context.Context) (int, error) {
+	select {
+	case s.swept <- struct{}{}:
+	default:
+	}
+	return 0, nil
+}
+
+func TestNew(t *testing.T) {
+	defer func() { conf = config.Order{} }()
+
+	e := &dummyExpirer{swept: make(chan struct{}, 1)}
+	service = e
+
+	New(config.Order{TTL: time.Hour, MaxTTL: 2 * time.Hour, SweepInterval: time.Millisecond})
+
+	if TTL() != time.Hour || MaxTTL() != 2*time.Hour || SweepInterval() != time.Millisecond {
+		t.Fatal(conf)
+	}
+
+	select {
+	case <-e.swept:
+	case <-time.After(time.Second):
+		t.Fatal("sweeper did not run")
+	}
+}
+
+func TestTTL(t *testing.T) {
+	conf = config.Order{}
+	if TTL() != DefaultTTL || MaxTTL() != DefaultMaxTTL || SweepInterval() != DefaultSweepInterval {
+		t.Fatal()
+	}
+	// the max ttl is never shorter than the ttl
+	conf = config.Order{TTL: 48 * time.Hour}
+	if MaxTTL() != 48*time.Hour {
+		t.Fatal(MaxTTL())
+	}
+	conf = config.Order{}
+}
 
 func TestRegisterOrderServer(t *testing.T) {
 	service = &dummyService{}
@@ -84,6 +153,7 @@ func TestReadMethods(t *testing.T) {
 	methods := []*regexp.Regexp{
 		regexp.MustCompile(baseMethod + "Get"),
 		regexp.MustCompile(baseMethod + "List"),
+		regexp.MustCompile(baseMethod + "GetShippingRates"),
 	}
 	// check methods in same order
 	for k, v := range ReadMethods() {
@@ -98,6 +168,11 @@ func TestWriteMethods(t *testing.T) {
 		regexp.MustCompile(baseMethod + "New"),
 		regexp.MustCompile(baseMethod + "Pay"),
 		regexp.MustCompile(baseMethod + "Return"),
+		regexp.MustCompile(baseMethod + "Fulfill"),
+		regexp.MustCompile(baseMethod + "Cancel"),
+		regexp.MustCompile(baseMethod + "Update"),
+		regexp.MustCompile(baseMethod + "CreateFulfillment"),
+		regexp.MustCompile(baseMethod + "AddNote"),
 	}
 	// check methods in same order
 	for k, v := range WriteMethods() {
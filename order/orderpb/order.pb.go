@@ -10,12 +10,27 @@
 	It has these top-level messages:
 		Order
 		OrderItem
+		ExchangeRate
+		TaxLine
 		Shipping
+		ReturnItem
+		OrderReturn
+		FulfillmentItem
+		Fulfillment
+		OrderEvent
 		OrderList
 		NewRequest
+		ShippingRatesRequest
+		ShippingQuote
+		ShippingQuoteList
 		GetRequest
 		PayRequest
 		ReturnRequest
+		FulfillRequest
+		FulfillmentRequest
+		NoteRequest
+		CancelRequest
+		UpdateRequest
 		ListRequest
 */
 package orderpb
@@ -44,6 +59,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type CancelReason int32
+
+const (
+	CancelReason_Abandoned           CancelReason = 0
+	CancelReason_RequestedByCustomer CancelReason = 1
+	CancelReason_Fraud               CancelReason = 2
+	CancelReason_Duplicate           CancelReason = 3
+	CancelReason_Expired             CancelReason = 4
+)
+
+var CancelReason_name = map[int32]string{
+	0: "Abandoned",
+	1: "RequestedByCustomer",
+	2: "Fraud",
+	3: "Duplicate",
+	4: "Expired",
+}
+var CancelReason_value = map[string]int32{
+	"Abandoned":           0,
+	"RequestedByCustomer": 1,
+	"Fraud":               2,
+	"Duplicate":           3,
+	"Expired":             4,
+}
+
+func (x CancelReason) String() string {
+	return proto.EnumName(CancelReason_name, int32(x))
+}
+func (CancelReason) EnumDescriptor() ([]byte, []int) { return fileDescriptorOrder, []int{0} }
+
 type OrderStatus int32
 
 const (
@@ -52,6 +97,7 @@ const (
 	Order_Canceled  OrderStatus = 2
 	Order_Fulfilled OrderStatus = 3
 	Order_Returned  OrderStatus = 4
+	Order_Expired   OrderStatus = 5
 )
 
 var OrderStatus_name = map[int32]string{
@@ -60,6 +106,7 @@ var OrderStatus_name = map[int32]string{
 	2: "Canceled",
 	3: "Fulfilled",
 	4: "Returned",
+	5: "Expired",
 }
 var OrderStatus_value = map[string]int32{
 	"Created":   0,
@@ -67,6 +114,7 @@ var OrderStatus_value = map[string]int32{
 	"Canceled":  2,
 	"Fulfilled": 3,
 	"Returned":  4,
+	"Expired":   5,
 }
 
 func (x OrderStatus) String() string {
@@ -104,6 +152,58 @@ func (x OrderItem_Type) String() string {
 }
 func (OrderItem_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptorOrder, []int{1, 0} }
 
+type OrderEvent_Type int32
+
+const (
+	OrderEvent_Reserved      OrderEvent_Type = 0
+	OrderEvent_Note          OrderEvent_Type = 1
+	OrderEvent_Created       OrderEvent_Type = 2
+	OrderEvent_Updated       OrderEvent_Type = 3
+	OrderEvent_Paid          OrderEvent_Type = 4
+	OrderEvent_Fulfilled     OrderEvent_Type = 5
+	OrderEvent_Returned      OrderEvent_Type = 6
+	OrderEvent_RefundFailed  OrderEvent_Type = 7
+	OrderEvent_CaptureFailed OrderEvent_Type = 8
+	OrderEvent_Canceled      OrderEvent_Type = 9
+	OrderEvent_Expired       OrderEvent_Type = 10
+	// the order could not be saved after its charge changed
+	OrderEvent_DataLoss OrderEvent_Type = 11
+)
+
+var OrderEvent_Type_name = map[int32]string{
+	0:  "Reserved",
+	1:  "Note",
+	2:  "Created",
+	3:  "Updated",
+	4:  "Paid",
+	5:  "Fulfilled",
+	6:  "Returned",
+	7:  "RefundFailed",
+	8:  "CaptureFailed",
+	9:  "Canceled",
+	10: "Expired",
+	11: "DataLoss",
+}
+var OrderEvent_Type_value = map[string]int32{
+	"Reserved":      0,
+	"Note":          1,
+	"Created":       2,
+	"Updated":       3,
+	"Paid":          4,
+	"Fulfilled":     5,
+	"Returned":      6,
+	"RefundFailed":  7,
+	"CaptureFailed": 8,
+	"Canceled":      9,
+	"Expired":       10,
+	"DataLoss":      11,
+}
+
+func (x OrderEvent_Type) String() string {
+	return proto.EnumName(OrderEvent_Type_name, int32(x))
+}
+func (OrderEvent_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptorOrder, []int{9, 0} }
+
 type ListRequest_Sort int32
 
 const (
@@ -132,20 +232,41 @@ var ListRequest_Sort_value = map[string]int32{
 func (x ListRequest_Sort) String() string {
 	return proto.EnumName(ListRequest_Sort_name, int32(x))
 }
-func (ListRequest_Sort) EnumDescriptor() ([]byte, []int) { return fileDescriptorOrder, []int{8, 0} }
+func (ListRequest_Sort) EnumDescriptor() ([]byte, []int) { return fileDescriptorOrder, []int{23, 0} }
 
 type Order struct {
-	Id       string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
-	Amount   int64              `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency paymentpb.Currency `protobuf:"varint,3,opt,name=currency,proto3,enum=paymentpb.Currency" json:"currency,omitempty"`
-	Items    []*OrderItem       `protobuf:"bytes,4,rep,name=items" json:"items,omitempty"`
-	Metadata map[string]string  `protobuf:"bytes,5,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Email    string             `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
-	ChargeId string             `protobuf:"bytes,7,opt,name=chargeId,proto3" json:"chargeId,omitempty"`
-	Status   OrderStatus        `protobuf:"varint,8,opt,name=Status,proto3,enum=orderpb.OrderStatus" json:"Status,omitempty"`
-	Shipping *Shipping          `protobuf:"bytes,9,opt,name=shipping" json:"shipping,omitempty"`
-	Created  int64              `protobuf:"varint,998,opt,name=created,proto3" json:"created,omitempty"`
-	Updated  int64              `protobuf:"varint,999,opt,name=updated,proto3" json:"updated,omitempty"`
+	Id           string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
+	Amount       int64              `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency     paymentpb.Currency `protobuf:"varint,3,opt,name=currency,proto3,enum=paymentpb.Currency" json:"currency,omitempty"`
+	Items        []*OrderItem       `protobuf:"bytes,4,rep,name=items" json:"items,omitempty"`
+	Metadata     map[string]string  `protobuf:"bytes,5,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Email        string             `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	ChargeId     string             `protobuf:"bytes,7,opt,name=chargeId,proto3" json:"chargeId,omitempty"`
+	Status       OrderStatus        `protobuf:"varint,8,opt,name=Status,proto3,enum=orderpb.OrderStatus" json:"Status,omitempty"`
+	Shipping     *Shipping          `protobuf:"bytes,9,opt,name=shipping" json:"shipping,omitempty"`
+	CancelReason CancelReason       `protobuf:"varint,10,opt,name=cancelReason,proto3,enum=orderpb.CancelReason" json:"cancelReason,omitempty"`
+	Returns      []*OrderReturn     `protobuf:"bytes,11,rep,name=returns" json:"returns,omitempty"`
+	// unix time the order can't be paid after
+	Expires int64 `protobuf:"varint,12,opt,name=expires,proto3" json:"expires,omitempty"`
+	// coupon codes the order discount items are calculated from
+	Coupons []string `protobuf:"bytes,13,rep,name=coupons" json:"coupons,omitempty"`
+	// tax rates breakdown of the order
+	Taxes []*TaxLine `protobuf:"bytes,14,rep,name=taxes" json:"taxes,omitempty"`
+	// chosen shipping rate id, its shipping item is added to the order
+	ShippingRate string `protobuf:"bytes,15,opt,name=shippingRate,proto3" json:"shippingRate,omitempty"`
+	// rates the items amounts were converted into the order currency by
+	ExchangeRates []*ExchangeRate `protobuf:"bytes,16,rep,name=exchangeRates" json:"exchangeRates,omitempty"`
+	// shipments of the order items, the order is fulfilled once all the
+	// items that were not returned are shipped
+	Fulfillments []*Fulfillment `protobuf:"bytes,17,rep,name=fulfillments" json:"fulfillments,omitempty"`
+	// append only timeline of what happened to the order
+	Events     []*OrderEvent `protobuf:"bytes,18,rep,name=events" json:"events,omitempty"`
+	CustomerId string        `protobuf:"bytes,19,opt,name=customerId,proto3" json:"customerId,omitempty"`
+	// credit issued by other services, like the unused part of a
+	// subscription period, discounted from the order amount
+	Credit  int64 `protobuf:"varint,20,opt,name=credit,proto3" json:"credit,omitempty"`
+	Created int64 `protobuf:"varint,998,opt,name=created,proto3" json:"created,omitempty"`
+	Updated int64 `protobuf:"varint,999,opt,name=updated,proto3" json:"updated,omitempty"`
 }
 
 func (m *Order) Reset()                    { *m = Order{} }
@@ -216,6 +337,83 @@ func (m *Order) GetShipping() *Shipping {
 	return nil
 }
 
+func (m *Order) GetCancelReason() CancelReason {
+	if m != nil {
+		return m.CancelReason
+	}
+	return CancelReason_Abandoned
+}
+
+func (m *Order) GetReturns() []*OrderReturn {
+	if m != nil {
+		return m.Returns
+	}
+	return nil
+}
+
+func (m *Order) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
+func (m *Order) GetCoupons() []string {
+	if m != nil {
+		return m.Coupons
+	}
+	return nil
+}
+
+func (m *Order) GetTaxes() []*TaxLine {
+	if m != nil {
+		return m.Taxes
+	}
+	return nil
+}
+
+func (m *Order) GetShippingRate() string {
+	if m != nil {
+		return m.ShippingRate
+	}
+	return ""
+}
+
+func (m *Order) GetExchangeRates() []*ExchangeRate {
+	if m != nil {
+		return m.ExchangeRates
+	}
+	return nil
+}
+
+func (m *Order) GetFulfillments() []*Fulfillment {
+	if m != nil {
+		return m.Fulfillments
+	}
+	return nil
+}
+
+func (m *Order) GetEvents() []*OrderEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *Order) GetCustomerId() string {
+	if m != nil {
+		return m.CustomerId
+	}
+	return ""
+}
+
+func (m *Order) GetCredit() int64 {
+	if m != nil {
+		return m.Credit
+	}
+	return 0
+}
+
 func (m *Order) GetCreated() int64 {
 	if m != nil {
 		return m.Created
@@ -286,6 +484,92 @@ func (m *OrderItem) GetDescription() string {
 	return ""
 }
 
+type ExchangeRate struct {
+	// currency the items were priced in
+	Currency paymentpb.Currency `protobuf:"varint,1,opt,name=currency,proto3,enum=paymentpb.Currency" json:"currency,omitempty"`
+	// order currency units of one currency unit
+	Rate float64 `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (m *ExchangeRate) Reset()                    { *m = ExchangeRate{} }
+func (m *ExchangeRate) String() string            { return proto.CompactTextString(m) }
+func (*ExchangeRate) ProtoMessage()               {}
+func (*ExchangeRate) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{2} }
+
+func (m *ExchangeRate) GetCurrency() paymentpb.Currency {
+	if m != nil {
+		return m.Currency
+	}
+	return paymentpb.Currency_CUR_RESERVED
+}
+
+func (m *ExchangeRate) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+type TaxLine struct {
+	// tax rate id
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// hundredths of a percent
+	Rate int64 `protobuf:"varint,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	// included in the items price, no tax item is added for it
+	Inclusive bool `protobuf:"varint,4,opt,name=inclusive,proto3" json:"inclusive,omitempty"`
+	// amount of the items the rate applies to
+	Taxable int64 `protobuf:"varint,5,opt,name=taxable,proto3" json:"taxable,omitempty"`
+	Amount  int64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *TaxLine) Reset()                    { *m = TaxLine{} }
+func (m *TaxLine) String() string            { return proto.CompactTextString(m) }
+func (*TaxLine) ProtoMessage()               {}
+func (*TaxLine) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{3} }
+
+func (m *TaxLine) GetParent() string {
+	if m != nil {
+		return m.Parent
+	}
+	return ""
+}
+
+func (m *TaxLine) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TaxLine) GetRate() int64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *TaxLine) GetInclusive() bool {
+	if m != nil {
+		return m.Inclusive
+	}
+	return false
+}
+
+func (m *TaxLine) GetTaxable() int64 {
+	if m != nil {
+		return m.Taxable
+	}
+	return 0
+}
+
+func (m *TaxLine) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
 type Shipping struct {
 	Name           string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Phone          string            `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
@@ -297,7 +581,7 @@ type Shipping struct {
 func (m *Shipping) Reset()                    { *m = Shipping{} }
 func (m *Shipping) String() string            { return proto.CompactTextString(m) }
 func (*Shipping) ProtoMessage()               {}
-func (*Shipping) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{2} }
+func (*Shipping) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{4} }
 
 func (m *Shipping) GetName() string {
 	if m != nil {
@@ -346,7 +630,7 @@ type Shipping_Address struct {
 func (m *Shipping_Address) Reset()                    { *m = Shipping_Address{} }
 func (m *Shipping_Address) String() string            { return proto.CompactTextString(m) }
 func (*Shipping_Address) ProtoMessage()               {}
-func (*Shipping_Address) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{2, 0} }
+func (*Shipping_Address) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{4, 0} }
 
 func (m *Shipping_Address) GetLine1() string {
 	if m != nil {
@@ -390,1147 +674,3204 @@ func (m *Shipping_Address) GetState() string {
 	return ""
 }
 
-type OrderList struct {
-	Orders []*Order `protobuf:"bytes,1,rep,name=orders" json:"orders,omitempty"`
-	Total  int32    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+type ReturnItem struct {
+	Parent   string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty" validate:"required,uuid4"`
+	Quantity int64  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty" validate:"required,gte=1"`
+	// put the items back in stock, items of orders that were never
+	// fulfilled are always restocked
+	Restock bool `protobuf:"varint,3,opt,name=restock,proto3" json:"restock,omitempty"`
 }
 
-func (m *OrderList) Reset()                    { *m = OrderList{} }
-func (m *OrderList) String() string            { return proto.CompactTextString(m) }
-func (*OrderList) ProtoMessage()               {}
-func (*OrderList) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{3} }
+func (m *ReturnItem) Reset()                    { *m = ReturnItem{} }
+func (m *ReturnItem) String() string            { return proto.CompactTextString(m) }
+func (*ReturnItem) ProtoMessage()               {}
+func (*ReturnItem) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{5} }
 
-func (m *OrderList) GetOrders() []*Order {
+func (m *ReturnItem) GetParent() string {
 	if m != nil {
-		return m.Orders
+		return m.Parent
 	}
-	return nil
+	return ""
 }
 
-func (m *OrderList) GetTotal() int32 {
+func (m *ReturnItem) GetQuantity() int64 {
 	if m != nil {
-		return m.Total
+		return m.Quantity
 	}
 	return 0
 }
 
-type NewRequest struct {
-	Currency paymentpb.Currency `protobuf:"varint,1,opt,name=currency,proto3,enum=paymentpb.Currency" json:"currency,omitempty" validate:"required,gte=1,lte=128"`
-	Items    []*OrderItem       `protobuf:"bytes,2,rep,name=items" json:"items,omitempty" bson:"items" validate:"dive,required"`
-	Metadata map[string]string  `protobuf:"bytes,3,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Email    string             `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty" validate:"omitempty,email"`
-	Shipping *Shipping          `protobuf:"bytes,5,opt,name=shipping" json:"shipping,omitempty" validate:"omitempty,dive"`
+func (m *ReturnItem) GetRestock() bool {
+	if m != nil {
+		return m.Restock
+	}
+	return false
 }
 
-func (m *NewRequest) Reset()                    { *m = NewRequest{} }
-func (m *NewRequest) String() string            { return proto.CompactTextString(m) }
-func (*NewRequest) ProtoMessage()               {}
-func (*NewRequest) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{4} }
+type OrderReturn struct {
+	Items    []*ReturnItem          `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	Amount   int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason   paymentpb.RefundReason `protobuf:"varint,3,opt,name=reason,proto3,enum=paymentpb.RefundReason" json:"reason,omitempty"`
+	RefundId string                 `protobuf:"bytes,4,opt,name=refundId,proto3" json:"refundId,omitempty"`
+	Created  int64                  `protobuf:"varint,5,opt,name=created,proto3" json:"created,omitempty"`
+}
 
-func (m *NewRequest) GetCurrency() paymentpb.Currency {
+func (m *OrderReturn) Reset()                    { *m = OrderReturn{} }
+func (m *OrderReturn) String() string            { return proto.CompactTextString(m) }
+func (*OrderReturn) ProtoMessage()               {}
+func (*OrderReturn) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{6} }
+
+func (m *OrderReturn) GetItems() []*ReturnItem {
 	if m != nil {
-		return m.Currency
+		return m.Items
 	}
-	return paymentpb.Currency_CUR_RESERVED
+	return nil
 }
 
-func (m *NewRequest) GetItems() []*OrderItem {
+func (m *OrderReturn) GetAmount() int64 {
 	if m != nil {
-		return m.Items
+		return m.Amount
 	}
-	return nil
+	return 0
 }
 
-func (m *NewRequest) GetMetadata() map[string]string {
+func (m *OrderReturn) GetReason() paymentpb.RefundReason {
 	if m != nil {
-		return m.Metadata
+		return m.Reason
 	}
-	return nil
+	return paymentpb.RefundReason_GeneralError
 }
 
-func (m *NewRequest) GetEmail() string {
+func (m *OrderReturn) GetRefundId() string {
 	if m != nil {
-		return m.Email
+		return m.RefundId
 	}
 	return ""
 }
 
-func (m *NewRequest) GetShipping() *Shipping {
+func (m *OrderReturn) GetCreated() int64 {
 	if m != nil {
-		return m.Shipping
+		return m.Created
 	}
-	return nil
+	return 0
 }
 
-type GetRequest struct {
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+type FulfillmentItem struct {
+	Parent   string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty" validate:"required,uuid4"`
+	Quantity int64  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty" validate:"required,gte=1"`
 }
 
-func (m *GetRequest) Reset()                    { *m = GetRequest{} }
-func (m *GetRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetRequest) ProtoMessage()               {}
-func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{5} }
+func (m *FulfillmentItem) Reset()                    { *m = FulfillmentItem{} }
+func (m *FulfillmentItem) String() string            { return proto.CompactTextString(m) }
+func (*FulfillmentItem) ProtoMessage()               {}
+func (*FulfillmentItem) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{7} }
 
-func (m *GetRequest) GetId() string {
+func (m *FulfillmentItem) GetParent() string {
 	if m != nil {
-		return m.Id
+		return m.Parent
 	}
 	return ""
 }
 
-type PayRequest struct {
-	Id                string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
-	Card              *paymentpb.Card             `protobuf:"bytes,2,opt,name=card" json:"card,omitempty" validate:"dive,required"`
-	PaymentProviderId paymentpb.PaymentProviderId `protobuf:"varint,3,opt,name=paymentProviderId,proto3,enum=paymentpb.PaymentProviderId" json:"paymentProviderId,omitempty" validate:"required,gte=1,lte=1"`
+func (m *FulfillmentItem) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
 }
 
-func (m *PayRequest) Reset()                    { *m = PayRequest{} }
-func (m *PayRequest) String() string            { return proto.CompactTextString(m) }
-func (*PayRequest) ProtoMessage()               {}
-func (*PayRequest) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{6} }
+type Fulfillment struct {
+	Id             string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Items          []*FulfillmentItem `protobuf:"bytes,2,rep,name=items" json:"items,omitempty"`
+	Carrier        string             `protobuf:"bytes,3,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	TrackingNumber string             `protobuf:"bytes,4,opt,name=trackingNumber,proto3" json:"trackingNumber,omitempty"`
+	Created        int64              `protobuf:"varint,5,opt,name=created,proto3" json:"created,omitempty"`
+}
 
-func (m *PayRequest) GetId() string {
+func (m *Fulfillment) Reset()                    { *m = Fulfillment{} }
+func (m *Fulfillment) String() string            { return proto.CompactTextString(m) }
+func (*Fulfillment) ProtoMessage()               {}
+func (*Fulfillment) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{8} }
+
+func (m *Fulfillment) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *PayRequest) GetCard() *paymentpb.Card {
+func (m *Fulfillment) GetItems() []*FulfillmentItem {
 	if m != nil {
-		return m.Card
+		return m.Items
 	}
 	return nil
 }
 
-func (m *PayRequest) GetPaymentProviderId() paymentpb.PaymentProviderId {
+func (m *Fulfillment) GetCarrier() string {
 	if m != nil {
-		return m.PaymentProviderId
+		return m.Carrier
 	}
-	return paymentpb.PaymentProviderId_PROVIDER_Reserved
+	return ""
 }
 
-type ReturnRequest struct {
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+func (m *Fulfillment) GetTrackingNumber() string {
+	if m != nil {
+		return m.TrackingNumber
+	}
+	return ""
 }
 
-func (m *ReturnRequest) Reset()                    { *m = ReturnRequest{} }
-func (m *ReturnRequest) String() string            { return proto.CompactTextString(m) }
-func (*ReturnRequest) ProtoMessage()               {}
-func (*ReturnRequest) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{7} }
-
-func (m *ReturnRequest) GetId() string {
+func (m *Fulfillment) GetCreated() int64 {
 	if m != nil {
-		return m.Id
+		return m.Created
 	}
-	return ""
+	return 0
 }
 
-type ListRequest struct {
-	Page  int64            `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty" validate:"omitempty,required,gte=0"`
-	Limit int64            `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" validate:"omitempty,required,gt=0"`
-	Sort  ListRequest_Sort `protobuf:"varint,3,opt,name=sort,proto3,enum=orderpb.ListRequest_Sort" json:"sort,omitempty" validate:"omitempty,required,gte=0,lte=4"`
+type OrderEvent struct {
+	Type    OrderEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=orderpb.OrderEvent_Type" json:"type,omitempty"`
+	Message string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// serial of the client that made the request, empty for system events
+	Actor   string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Created int64  `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`
 }
 
-func (m *ListRequest) Reset()                    { *m = ListRequest{} }
-func (m *ListRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListRequest) ProtoMessage()               {}
-func (*ListRequest) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{8} }
+func (m *OrderEvent) Reset()                    { *m = OrderEvent{} }
+func (m *OrderEvent) String() string            { return proto.CompactTextString(m) }
+func (*OrderEvent) ProtoMessage()               {}
+func (*OrderEvent) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{9} }
 
-func (m *ListRequest) GetPage() int64 {
+func (m *OrderEvent) GetType() OrderEvent_Type {
 	if m != nil {
-		return m.Page
+		return m.Type
 	}
-	return 0
+	return OrderEvent_Reserved
 }
 
-func (m *ListRequest) GetLimit() int64 {
+func (m *OrderEvent) GetMessage() string {
 	if m != nil {
-		return m.Limit
+		return m.Message
 	}
-	return 0
+	return ""
 }
 
-func (m *ListRequest) GetSort() ListRequest_Sort {
+func (m *OrderEvent) GetActor() string {
 	if m != nil {
-		return m.Sort
+		return m.Actor
 	}
-	return ListRequest_Natural
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*Order)(nil), "orderpb.Order")
-	proto.RegisterType((*OrderItem)(nil), "orderpb.OrderItem")
-	proto.RegisterType((*Shipping)(nil), "orderpb.Shipping")
-	proto.RegisterType((*Shipping_Address)(nil), "orderpb.Shipping.Address")
-	proto.RegisterType((*OrderList)(nil), "orderpb.OrderList")
-	proto.RegisterType((*NewRequest)(nil), "orderpb.NewRequest")
-	proto.RegisterType((*GetRequest)(nil), "orderpb.GetRequest")
-	proto.RegisterType((*PayRequest)(nil), "orderpb.PayRequest")
-	proto.RegisterType((*ReturnRequest)(nil), "orderpb.ReturnRequest")
-	proto.RegisterType((*ListRequest)(nil), "orderpb.ListRequest")
-	proto.RegisterEnum("orderpb.OrderStatus", OrderStatus_name, OrderStatus_value)
-	proto.RegisterEnum("orderpb.OrderItem_Type", OrderItem_Type_name, OrderItem_Type_value)
-	proto.RegisterEnum("orderpb.ListRequest_Sort", ListRequest_Sort_name, ListRequest_Sort_value)
+func (m *OrderEvent) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+type OrderList struct {
+	Orders []*Order `protobuf:"bytes,1,rep,name=orders" json:"orders,omitempty"`
+	Total  int32    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *OrderList) Reset()                    { *m = OrderList{} }
+func (m *OrderList) String() string            { return proto.CompactTextString(m) }
+func (*OrderList) ProtoMessage()               {}
+func (*OrderList) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{10} }
 
-// Client API for OrderService service
+func (m *OrderList) GetOrders() []*Order {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
 
-type OrderServiceClient interface {
-	New(ctx context.Context, in *NewRequest, opts ...grpc.CallOption) (*Order, error)
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Order, error)
-	Pay(ctx context.Context, in *PayRequest, opts ...grpc.CallOption) (*Order, error)
-	Return(ctx context.Context, in *ReturnRequest, opts ...grpc.CallOption) (*Order, error)
-	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*OrderList, error)
+func (m *OrderList) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
 }
 
-type orderServiceClient struct {
-	cc *grpc.ClientConn
+type NewRequest struct {
+	Currency paymentpb.Currency `protobuf:"varint,1,opt,name=currency,proto3,enum=paymentpb.Currency" json:"currency,omitempty" validate:"required,gte=1,lte=128"`
+	Items    []*OrderItem       `protobuf:"bytes,2,rep,name=items" json:"items,omitempty" bson:"items" validate:"dive,required"`
+	Metadata map[string]string  `protobuf:"bytes,3,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Email    string             `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty" validate:"omitempty,email"`
+	Shipping *Shipping          `protobuf:"bytes,5,opt,name=shipping" json:"shipping,omitempty" validate:"omitempty,dive"`
+	// seconds till the order expires, the configured order ttl if empty and
+	// up to the configured max ttl
+	Ttl int64 `protobuf:"varint,6,opt,name=ttl,proto3" json:"ttl,omitempty" validate:"omitempty,gte=0"`
+	// coupon codes, their discount items are added to the order
+	Coupons []string `protobuf:"bytes,7,rep,name=coupons" json:"coupons,omitempty" validate:"dive,required"`
+	// shipping rate id from GetShippingRates
+	ShippingRate string `protobuf:"bytes,8,opt,name=shippingRate,proto3" json:"shippingRate,omitempty" validate:"omitempty,uuid4"`
+	// retries with the same key get the first response, the idempotency-key
+	// grpc metadata is used if empty
+	IdempotencyKey string `protobuf:"bytes,9,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty" validate:"omitempty,max=255"`
+	// the customer email and default address are used if empty
+	CustomerId string `protobuf:"bytes,10,opt,name=customerId,proto3" json:"customerId,omitempty" validate:"omitempty,uuid4"`
 }
 
-func NewOrderServiceClient(cc *grpc.ClientConn) OrderServiceClient {
-	return &orderServiceClient{cc}
+func (m *NewRequest) Reset()                    { *m = NewRequest{} }
+func (m *NewRequest) String() string            { return proto.CompactTextString(m) }
+func (*NewRequest) ProtoMessage()               {}
+func (*NewRequest) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{11} }
+
+func (m *NewRequest) GetCurrency() paymentpb.Currency {
+	if m != nil {
+		return m.Currency
+	}
+	return paymentpb.Currency_CUR_RESERVED
 }
 
-func (c *orderServiceClient) New(ctx context.Context, in *NewRequest, opts ...grpc.CallOption) (*Order, error) {
-	out := new(Order)
-	err := grpc.Invoke(ctx, "/orderpb.OrderService/New", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *NewRequest) GetItems() []*OrderItem {
+	if m != nil {
+		return m.Items
 	}
-	return out, nil
+	return nil
 }
 
-func (c *orderServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Order, error) {
-	out := new(Order)
-	err := grpc.Invoke(ctx, "/orderpb.OrderService/Get", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *NewRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
 	}
-	return out, nil
+	return nil
 }
 
-func (c *orderServiceClient) Pay(ctx context.Context, in *PayRequest, opts ...grpc.CallOption) (*Order, error) {
-	out := new(Order)
-	err := grpc.Invoke(ctx, "/orderpb.OrderService/Pay", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *NewRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
 	}
-	return out, nil
+	return ""
 }
 
-func (c *orderServiceClient) Return(ctx context.Context, in *ReturnRequest, opts ...grpc.CallOption) (*Order, error) {
-	out := new(Order)
-	err := grpc.Invoke(ctx, "/orderpb.OrderService/Return", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *NewRequest) GetShipping() *Shipping {
+	if m != nil {
+		return m.Shipping
 	}
-	return out, nil
+	return nil
 }
 
-func (c *orderServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*OrderList, error) {
-	out := new(OrderList)
-	err := grpc.Invoke(ctx, "/orderpb.OrderService/List", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *NewRequest) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
 	}
-	return out, nil
+	return 0
 }
 
-// Server API for OrderService service
+func (m *NewRequest) GetCoupons() []string {
+	if m != nil {
+		return m.Coupons
+	}
+	return nil
+}
 
-type OrderServiceServer interface {
-	New(context.Context, *NewRequest) (*Order, error)
-	Get(context.Context, *GetRequest) (*Order, error)
-	Pay(context.Context, *PayRequest) (*Order, error)
-	Return(context.Context, *ReturnRequest) (*Order, error)
-	List(context.Context, *ListRequest) (*OrderList, error)
+func (m *NewRequest) GetShippingRate() string {
+	if m != nil {
+		return m.ShippingRate
+	}
+	return ""
 }
 
-func RegisterOrderServiceServer(s *grpc.Server, srv OrderServiceServer) {
-	s.RegisterService(&_OrderService_serviceDesc, srv)
+func (m *NewRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
 }
 
-func _OrderService_New_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *NewRequest) GetCustomerId() string {
+	if m != nil {
+		return m.CustomerId
 	}
-	if interceptor == nil {
-		return srv.(OrderServiceServer).New(ctx, in)
+	return ""
+}
+
+type ShippingRatesRequest struct {
+	Currency paymentpb.Currency `protobuf:"varint,1,opt,name=currency,proto3,enum=paymentpb.Currency" json:"currency,omitempty" validate:"required,gte=1,lte=128"`
+	Items    []*OrderItem       `protobuf:"bytes,2,rep,name=items" json:"items,omitempty" validate:"dive,required"`
+	Shipping *Shipping          `protobuf:"bytes,3,opt,name=shipping" json:"shipping,omitempty" validate:"omitempty,dive"`
+}
+
+func (m *ShippingRatesRequest) Reset()                    { *m = ShippingRatesRequest{} }
+func (m *ShippingRatesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ShippingRatesRequest) ProtoMessage()               {}
+func (*ShippingRatesRequest) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{12} }
+
+func (m *ShippingRatesRequest) GetCurrency() paymentpb.Currency {
+	if m != nil {
+		return m.Currency
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/orderpb.OrderService/New",
+	return paymentpb.Currency_CUR_RESERVED
+}
+
+func (m *ShippingRatesRequest) GetItems() []*OrderItem {
+	if m != nil {
+		return m.Items
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).New(ctx, req.(*NewRequest))
+	return nil
+}
+
+func (m *ShippingRatesRequest) GetShipping() *Shipping {
+	if m != nil {
+		return m.Shipping
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _OrderService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type ShippingQuote struct {
+	// shipping rate id
+	Parent   string             `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Name     string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Carrier  string             `protobuf:"bytes,3,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	Amount   int64              `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency paymentpb.Currency `protobuf:"varint,5,opt,name=currency,proto3,enum=paymentpb.Currency" json:"currency,omitempty"`
+}
+
+func (m *ShippingQuote) Reset()                    { *m = ShippingQuote{} }
+func (m *ShippingQuote) String() string            { return proto.CompactTextString(m) }
+func (*ShippingQuote) ProtoMessage()               {}
+func (*ShippingQuote) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{13} }
+
+func (m *ShippingQuote) GetParent() string {
+	if m != nil {
+		return m.Parent
 	}
-	if interceptor == nil {
-		return srv.(OrderServiceServer).Get(ctx, in)
+	return ""
+}
+
+func (m *ShippingQuote) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/orderpb.OrderService/Get",
+	return ""
+}
+
+func (m *ShippingQuote) GetCarrier() string {
+	if m != nil {
+		return m.Carrier
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).Get(ctx, req.(*GetRequest))
+	return ""
+}
+
+func (m *ShippingQuote) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _OrderService_Pay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PayRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ShippingQuote) GetCurrency() paymentpb.Currency {
+	if m != nil {
+		return m.Currency
 	}
-	if interceptor == nil {
-		return srv.(OrderServiceServer).Pay(ctx, in)
+	return paymentpb.Currency_CUR_RESERVED
+}
+
+type ShippingQuoteList struct {
+	Quotes []*ShippingQuote `protobuf:"bytes,1,rep,name=quotes" json:"quotes,omitempty"`
+}
+
+func (m *ShippingQuoteList) Reset()                    { *m = ShippingQuoteList{} }
+func (m *ShippingQuoteList) String() string            { return proto.CompactTextString(m) }
+func (*ShippingQuoteList) ProtoMessage()               {}
+func (*ShippingQuoteList) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{14} }
+
+func (m *ShippingQuoteList) GetQuotes() []*ShippingQuote {
+	if m != nil {
+		return m.Quotes
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/orderpb.OrderService/Pay",
+	return nil
+}
+
+type GetRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+}
+
+func (m *GetRequest) Reset()                    { *m = GetRequest{} }
+func (m *GetRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()               {}
+func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{15} }
+
+func (m *GetRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).Pay(ctx, req.(*PayRequest))
+	return ""
+}
+
+type PayRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+	// either the card or a stored payment method is required
+	Card              *paymentpb.Card             `protobuf:"bytes,2,opt,name=card" json:"card,omitempty" validate:"omitempty,dive"`
+	PaymentProviderId paymentpb.PaymentProviderId `protobuf:"varint,3,opt,name=paymentProviderId,proto3,enum=paymentpb.PaymentProviderId" json:"paymentProviderId,omitempty" validate:"required,gte=1,lte=1"`
+	// retries with the same key get the first response, the idempotency-key
+	// grpc metadata is used if empty
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty" validate:"omitempty,max=255"`
+	// provider reference of a payment method saved by SavePaymentMethod
+	PaymentMethod string `protobuf:"bytes,5,opt,name=paymentMethod,proto3" json:"paymentMethod,omitempty" validate:"omitempty,max=255"`
+}
+
+func (m *PayRequest) Reset()                    { *m = PayRequest{} }
+func (m *PayRequest) String() string            { return proto.CompactTextString(m) }
+func (*PayRequest) ProtoMessage()               {}
+func (*PayRequest) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{16} }
+
+func (m *PayRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _OrderService_Return_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReturnRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PayRequest) GetCard() *paymentpb.Card {
+	if m != nil {
+		return m.Card
 	}
-	if interceptor == nil {
-		return srv.(OrderServiceServer).Return(ctx, in)
+	return nil
+}
+
+func (m *PayRequest) GetPaymentProviderId() paymentpb.PaymentProviderId {
+	if m != nil {
+		return m.PaymentProviderId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/orderpb.OrderService/Return",
+	return paymentpb.PaymentProviderId_PROVIDER_Reserved
+}
+
+func (m *PayRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).Return(ctx, req.(*ReturnRequest))
+	return ""
+}
+
+func (m *PayRequest) GetPaymentMethod() string {
+	if m != nil {
+		return m.PaymentMethod
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _OrderService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(OrderServiceServer).List(ctx, in)
+type ReturnRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+	// items to return, all the items that were not returned yet if empty
+	Items  []*ReturnItem          `protobuf:"bytes,2,rep,name=items" json:"items,omitempty" validate:"dive,required"`
+	Reason paymentpb.RefundReason `protobuf:"varint,3,opt,name=reason,proto3,enum=paymentpb.RefundReason" json:"reason,omitempty" validate:"omitempty,gte=0,lte=3"`
+}
+
+func (m *ReturnRequest) Reset()                    { *m = ReturnRequest{} }
+func (m *ReturnRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReturnRequest) ProtoMessage()               {}
+func (*ReturnRequest) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{17} }
+
+func (m *ReturnRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/orderpb.OrderService/List",
+	return ""
+}
+
+func (m *ReturnRequest) GetItems() []*ReturnItem {
+	if m != nil {
+		return m.Items
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).List(ctx, req.(*ListRequest))
+	return nil
+}
+
+func (m *ReturnRequest) GetReason() paymentpb.RefundReason {
+	if m != nil {
+		return m.Reason
 	}
-	return interceptor(ctx, in, info, handler)
+	return paymentpb.RefundReason_GeneralError
 }
 
-var _OrderService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "orderpb.OrderService",
-	HandlerType: (*OrderServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "New",
-			Handler:    _OrderService_New_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _OrderService_Get_Handler,
-		},
-		{
-			MethodName: "Pay",
-			Handler:    _OrderService_Pay_Handler,
-		},
-		{
-			MethodName: "Return",
-			Handler:    _OrderService_Return_Handler,
-		},
-		{
-			MethodName: "List",
-			Handler:    _OrderService_List_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "order/orderpb/order.proto",
+type FulfillRequest struct {
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+	Carrier        string `protobuf:"bytes,2,opt,name=carrier,proto3" json:"carrier,omitempty" validate:"omitempty,gt=0"`
+	TrackingNumber string `protobuf:"bytes,3,opt,name=trackingNumber,proto3" json:"trackingNumber,omitempty" validate:"omitempty,gt=0"`
 }
 
-func (m *Order) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *FulfillRequest) Reset()                    { *m = FulfillRequest{} }
+func (m *FulfillRequest) String() string            { return proto.CompactTextString(m) }
+func (*FulfillRequest) ProtoMessage()               {}
+func (*FulfillRequest) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{18} }
+
+func (m *FulfillRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Order) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func (m *FulfillRequest) GetCarrier() string {
+	if m != nil {
+		return m.Carrier
 	}
-	if m.Amount != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Amount))
+	return ""
+}
+
+func (m *FulfillRequest) GetTrackingNumber() string {
+	if m != nil {
+		return m.TrackingNumber
 	}
-	if m.Currency != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Currency))
+	return ""
+}
+
+type FulfillmentRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+	// items to ship, all the items that were not shipped or returned yet if empty
+	Items          []*FulfillmentItem `protobuf:"bytes,2,rep,name=items" json:"items,omitempty" validate:"dive,required"`
+	Carrier        string             `protobuf:"bytes,3,opt,name=carrier,proto3" json:"carrier,omitempty" validate:"omitempty,gt=0"`
+	TrackingNumber string             `protobuf:"bytes,4,opt,name=trackingNumber,proto3" json:"trackingNumber,omitempty" validate:"omitempty,gt=0"`
+}
+
+func (m *FulfillmentRequest) Reset()                    { *m = FulfillmentRequest{} }
+func (m *FulfillmentRequest) String() string            { return proto.CompactTextString(m) }
+func (*FulfillmentRequest) ProtoMessage()               {}
+func (*FulfillmentRequest) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{19} }
+
+func (m *FulfillmentRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Items) > 0 {
-		for _, msg := range m.Items {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return ""
+}
+
+func (m *FulfillmentRequest) GetItems() []*FulfillmentItem {
+	if m != nil {
+		return m.Items
 	}
-	if len(m.Metadata) > 0 {
-		for k, _ := range m.Metadata {
-			dAtA[i] = 0x2a
-			i++
-			v := m.Metadata[k]
-			mapSize := 1 + len(k) + sovOrder(uint64(len(k))) + 1 + len(v) + sovOrder(uint64(len(v)))
-			i = encodeVarintOrder(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintOrder(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintOrder(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
+	return nil
+}
+
+func (m *FulfillmentRequest) GetCarrier() string {
+	if m != nil {
+		return m.Carrier
 	}
-	if len(m.Email) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.Email)))
-		i += copy(dAtA[i:], m.Email)
+	return ""
+}
+
+func (m *FulfillmentRequest) GetTrackingNumber() string {
+	if m != nil {
+		return m.TrackingNumber
 	}
-	if len(m.ChargeId) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.ChargeId)))
-		i += copy(dAtA[i:], m.ChargeId)
+	return ""
+}
+
+type NoteRequest struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+	Note string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty" validate:"required,max=4096"`
+}
+
+func (m *NoteRequest) Reset()                    { *m = NoteRequest{} }
+func (m *NoteRequest) String() string            { return proto.CompactTextString(m) }
+func (*NoteRequest) ProtoMessage()               {}
+func (*NoteRequest) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{20} }
+
+func (m *NoteRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if m.Status != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Status))
+	return ""
+}
+
+func (m *NoteRequest) GetNote() string {
+	if m != nil {
+		return m.Note
 	}
-	if m.Shipping != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Shipping.Size()))
-		n1, err := m.Shipping.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
+	return ""
+}
+
+type CancelRequest struct {
+	Id     string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+	Reason CancelReason `protobuf:"varint,2,opt,name=reason,proto3,enum=orderpb.CancelReason" json:"reason,omitempty" validate:"omitempty,gte=0,lte=4"`
+}
+
+func (m *CancelRequest) Reset()                    { *m = CancelRequest{} }
+func (m *CancelRequest) String() string            { return proto.CompactTextString(m) }
+func (*CancelRequest) ProtoMessage()               {}
+func (*CancelRequest) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{21} }
+
+func (m *CancelRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if m.Created != 0 {
-		dAtA[i] = 0xb0
-		i++
-		dAtA[i] = 0x3e
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Created))
+	return ""
+}
+
+func (m *CancelRequest) GetReason() CancelReason {
+	if m != nil {
+		return m.Reason
 	}
-	if m.Updated != 0 {
-		dAtA[i] = 0xb8
-		i++
-		dAtA[i] = 0x3e
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Updated))
+	return CancelReason_Abandoned
+}
+
+type UpdateRequest struct {
+	Id       string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+	Items    []*OrderItem `protobuf:"bytes,2,rep,name=items" json:"items,omitempty" bson:"items" validate:"dive,required"`
+	Email    string       `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty" validate:"omitempty,email"`
+	Shipping *Shipping    `protobuf:"bytes,4,opt,name=shipping" json:"shipping,omitempty" validate:"omitempty,dive"`
+	// replaces the order coupon codes
+	Coupons []string `protobuf:"bytes,5,rep,name=coupons" json:"coupons,omitempty" validate:"dive,required"`
+	// replaces the order shipping rate
+	ShippingRate string `protobuf:"bytes,6,opt,name=shippingRate,proto3" json:"shippingRate,omitempty" validate:"omitempty,uuid4"`
+	// merged into the order metadata, empty values remove their keys.
+	// metadata can be updated in any order status
+	Metadata map[string]string `protobuf:"bytes,7,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *UpdateRequest) Reset()                    { *m = UpdateRequest{} }
+func (m *UpdateRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateRequest) ProtoMessage()               {}
+func (*UpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{22} }
+
+func (m *UpdateRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return i, nil
+	return ""
 }
 
-func (m *OrderItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *UpdateRequest) GetItems() []*OrderItem {
+	if m != nil {
+		return m.Items
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *OrderItem) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Type != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Type))
+func (m *UpdateRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
 	}
-	if m.Quantity != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Quantity))
+	return ""
+}
+
+func (m *UpdateRequest) GetShipping() *Shipping {
+	if m != nil {
+		return m.Shipping
 	}
-	if m.Amount != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Amount))
+	return nil
+}
+
+func (m *UpdateRequest) GetCoupons() []string {
+	if m != nil {
+		return m.Coupons
 	}
-	if m.Currency != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Currency))
-	}
-	if len(m.Parent) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.Parent)))
-		i += copy(dAtA[i:], m.Parent)
-	}
-	if len(m.Description) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
+	return nil
+}
+
+func (m *UpdateRequest) GetShippingRate() string {
+	if m != nil {
+		return m.ShippingRate
 	}
-	return i, nil
+	return ""
 }
 
-func (m *Shipping) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *UpdateRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Shipping) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+type ListRequest struct {
+	Page  int64            `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty" validate:"omitempty,required,gte=0"`
+	Limit int64            `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" validate:"omitempty,required,gt=0"`
+	Sort  ListRequest_Sort `protobuf:"varint,3,opt,name=sort,proto3,enum=orderpb.ListRequest_Sort" json:"sort,omitempty" validate:"omitempty,required,gte=0,lte=4"`
+	// only orders with all of the metadata key values
+	Metadata map[string]string `protobuf:"bytes,4,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// only orders of the customer
+	CustomerId string `protobuf:"bytes,5,opt,name=customerId,proto3" json:"customerId,omitempty" validate:"omitempty,uuid4"`
+}
+
+func (m *ListRequest) Reset()                    { *m = ListRequest{} }
+func (m *ListRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListRequest) ProtoMessage()               {}
+func (*ListRequest) Descriptor() ([]byte, []int) { return fileDescriptorOrder, []int{23} }
+
+func (m *ListRequest) GetPage() int64 {
+	if m != nil {
+		return m.Page
 	}
-	if len(m.Phone) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.Phone)))
-		i += copy(dAtA[i:], m.Phone)
+	return 0
+}
+
+func (m *ListRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
 	}
-	if m.Address != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Address.Size()))
-		n2, err := m.Address.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+	return 0
+}
+
+func (m *ListRequest) GetSort() ListRequest_Sort {
+	if m != nil {
+		return m.Sort
 	}
-	if len(m.Carrier) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.Carrier)))
-		i += copy(dAtA[i:], m.Carrier)
+	return ListRequest_Natural
+}
+
+func (m *ListRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
 	}
-	if len(m.TrackingNumber) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.TrackingNumber)))
-		i += copy(dAtA[i:], m.TrackingNumber)
+	return nil
+}
+
+func (m *ListRequest) GetCustomerId() string {
+	if m != nil {
+		return m.CustomerId
 	}
-	return i, nil
+	return ""
 }
 
-func (m *Shipping_Address) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func init() {
+	proto.RegisterType((*Order)(nil), "orderpb.Order")
+	proto.RegisterType((*OrderItem)(nil), "orderpb.OrderItem")
+	proto.RegisterType((*ExchangeRate)(nil), "orderpb.ExchangeRate")
+	proto.RegisterType((*TaxLine)(nil), "orderpb.TaxLine")
+	proto.RegisterType((*Shipping)(nil), "orderpb.Shipping")
+	proto.RegisterType((*Shipping_Address)(nil), "orderpb.Shipping.Address")
+	proto.RegisterType((*ReturnItem)(nil), "orderpb.ReturnItem")
+	proto.RegisterType((*OrderReturn)(nil), "orderpb.OrderReturn")
+	proto.RegisterType((*FulfillmentItem)(nil), "orderpb.FulfillmentItem")
+	proto.RegisterType((*Fulfillment)(nil), "orderpb.Fulfillment")
+	proto.RegisterType((*OrderEvent)(nil), "orderpb.OrderEvent")
+	proto.RegisterType((*OrderList)(nil), "orderpb.OrderList")
+	proto.RegisterType((*NewRequest)(nil), "orderpb.NewRequest")
+	proto.RegisterType((*ShippingRatesRequest)(nil), "orderpb.ShippingRatesRequest")
+	proto.RegisterType((*ShippingQuote)(nil), "orderpb.ShippingQuote")
+	proto.RegisterType((*ShippingQuoteList)(nil), "orderpb.ShippingQuoteList")
+	proto.RegisterType((*GetRequest)(nil), "orderpb.GetRequest")
+	proto.RegisterType((*PayRequest)(nil), "orderpb.PayRequest")
+	proto.RegisterType((*ReturnRequest)(nil), "orderpb.ReturnRequest")
+	proto.RegisterType((*FulfillRequest)(nil), "orderpb.FulfillRequest")
+	proto.RegisterType((*FulfillmentRequest)(nil), "orderpb.FulfillmentRequest")
+	proto.RegisterType((*NoteRequest)(nil), "orderpb.NoteRequest")
+	proto.RegisterType((*CancelRequest)(nil), "orderpb.CancelRequest")
+	proto.RegisterType((*UpdateRequest)(nil), "orderpb.UpdateRequest")
+	proto.RegisterType((*ListRequest)(nil), "orderpb.ListRequest")
+	proto.RegisterEnum("orderpb.CancelReason", CancelReason_name, CancelReason_value)
+	proto.RegisterEnum("orderpb.OrderStatus", OrderStatus_name, OrderStatus_value)
+	proto.RegisterEnum("orderpb.OrderItem_Type", OrderItem_Type_name, OrderItem_Type_value)
+	proto.RegisterEnum("orderpb.OrderEvent_Type", OrderEvent_Type_name, OrderEvent_Type_value)
+	proto.RegisterEnum("orderpb.ListRequest_Sort", ListRequest_Sort_name, ListRequest_Sort_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for OrderService service
+
+type OrderServiceClient interface {
+	New(ctx context.Context, in *NewRequest, opts ...grpc.CallOption) (*Order, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Order, error)
+	Pay(ctx context.Context, in *PayRequest, opts ...grpc.CallOption) (*Order, error)
+	Return(ctx context.Context, in *ReturnRequest, opts ...grpc.CallOption) (*Order, error)
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*OrderList, error)
+	Fulfill(ctx context.Context, in *FulfillRequest, opts ...grpc.CallOption) (*Order, error)
+	Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*Order, error)
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*Order, error)
+	GetShippingRates(ctx context.Context, in *ShippingRatesRequest, opts ...grpc.CallOption) (*ShippingQuoteList, error)
+	CreateFulfillment(ctx context.Context, in *FulfillmentRequest, opts ...grpc.CallOption) (*Order, error)
+	AddNote(ctx context.Context, in *NoteRequest, opts ...grpc.CallOption) (*Order, error)
+}
+
+type orderServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewOrderServiceClient(cc *grpc.ClientConn) OrderServiceClient {
+	return &orderServiceClient{cc}
+}
+
+func (c *orderServiceClient) New(ctx context.Context, in *NewRequest, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := grpc.Invoke(ctx, "/orderpb.OrderService/New", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Shipping_Address) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Line1) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.Line1)))
-		i += copy(dAtA[i:], m.Line1)
-	}
-	if len(m.City) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.City)))
-		i += copy(dAtA[i:], m.City)
-	}
-	if len(m.Country) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.Country)))
-		i += copy(dAtA[i:], m.Country)
-	}
-	if len(m.Line2) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.Line2)))
-		i += copy(dAtA[i:], m.Line2)
-	}
-	if len(m.PostalCode) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.PostalCode)))
-		i += copy(dAtA[i:], m.PostalCode)
+func (c *orderServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := grpc.Invoke(ctx, "/orderpb.OrderService/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.State) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.State)))
-		i += copy(dAtA[i:], m.State)
+	return out, nil
+}
+
+func (c *orderServiceClient) Pay(ctx context.Context, in *PayRequest, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := grpc.Invoke(ctx, "/orderpb.OrderService/Pay", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *OrderList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *orderServiceClient) Return(ctx context.Context, in *ReturnRequest, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := grpc.Invoke(ctx, "/orderpb.OrderService/Return", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *OrderList) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Orders) > 0 {
-		for _, msg := range m.Orders {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (c *orderServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*OrderList, error) {
+	out := new(OrderList)
+	err := grpc.Invoke(ctx, "/orderpb.OrderService/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Total != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Total))
+	return out, nil
+}
+
+func (c *orderServiceClient) Fulfill(ctx context.Context, in *FulfillRequest, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := grpc.Invoke(ctx, "/orderpb.OrderService/Fulfill", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *NewRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *orderServiceClient) Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := grpc.Invoke(ctx, "/orderpb.OrderService/Cancel", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *NewRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Currency != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Currency))
-	}
-	if len(m.Items) > 0 {
-		for _, msg := range m.Items {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.Metadata) > 0 {
-		for k, _ := range m.Metadata {
-			dAtA[i] = 0x1a
-			i++
-			v := m.Metadata[k]
-			mapSize := 1 + len(k) + sovOrder(uint64(len(k))) + 1 + len(v) + sovOrder(uint64(len(v)))
-			i = encodeVarintOrder(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintOrder(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintOrder(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
-	}
-	if len(m.Email) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.Email)))
-		i += copy(dAtA[i:], m.Email)
-	}
-	if m.Shipping != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Shipping.Size()))
-		n3, err := m.Shipping.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+func (c *orderServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := grpc.Invoke(ctx, "/orderpb.OrderService/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *GetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *orderServiceClient) GetShippingRates(ctx context.Context, in *ShippingRatesRequest, opts ...grpc.CallOption) (*ShippingQuoteList, error) {
+	out := new(ShippingQuoteList)
+	err := grpc.Invoke(ctx, "/orderpb.OrderService/GetShippingRates", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func (c *orderServiceClient) CreateFulfillment(ctx context.Context, in *FulfillmentRequest, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := grpc.Invoke(ctx, "/orderpb.OrderService/CreateFulfillment", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *PayRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *orderServiceClient) AddNote(ctx context.Context, in *NoteRequest, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := grpc.Invoke(ctx, "/orderpb.OrderService/AddNote", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PayRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+// Server API for OrderService service
+
+type OrderServiceServer interface {
+	New(context.Context, *NewRequest) (*Order, error)
+	Get(context.Context, *GetRequest) (*Order, error)
+	Pay(context.Context, *PayRequest) (*Order, error)
+	Return(context.Context, *ReturnRequest) (*Order, error)
+	List(context.Context, *ListRequest) (*OrderList, error)
+	Fulfill(context.Context, *FulfillRequest) (*Order, error)
+	Cancel(context.Context, *CancelRequest) (*Order, error)
+	Update(context.Context, *UpdateRequest) (*Order, error)
+	GetShippingRates(context.Context, *ShippingRatesRequest) (*ShippingQuoteList, error)
+	CreateFulfillment(context.Context, *FulfillmentRequest) (*Order, error)
+	AddNote(context.Context, *NoteRequest) (*Order, error)
+}
+
+func RegisterOrderServiceServer(s *grpc.Server, srv OrderServiceServer) {
+	s.RegisterService(&_OrderService_serviceDesc, srv)
+}
+
+func _OrderService_New_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Card != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Card.Size()))
-		n4, err := m.Card.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+	if interceptor == nil {
+		return srv.(OrderServiceServer).New(ctx, in)
 	}
-	if m.PaymentProviderId != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.PaymentProviderId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orderpb.OrderService/New",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).New(ctx, req.(*NewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ReturnRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _OrderService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(OrderServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orderpb.OrderService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ReturnRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+func _OrderService_Pay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return i, nil
+	if interceptor == nil {
+		return srv.(OrderServiceServer).Pay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orderpb.OrderService/Pay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).Pay(ctx, req.(*PayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _OrderService_Return_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(OrderServiceServer).Return(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orderpb.OrderService/Return",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).Return(ctx, req.(*ReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Page != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Page))
+func _OrderService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Limit != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Limit))
+	if interceptor == nil {
+		return srv.(OrderServiceServer).List(ctx, in)
 	}
-	if m.Sort != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintOrder(dAtA, i, uint64(m.Sort))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orderpb.OrderService/List",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeFixed64Order(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Order(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintOrder(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _OrderService_Fulfill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FulfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *Order) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	if interceptor == nil {
+		return srv.(OrderServiceServer).Fulfill(ctx, in)
 	}
-	if m.Amount != 0 {
-		n += 1 + sovOrder(uint64(m.Amount))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orderpb.OrderService/Fulfill",
 	}
-	if m.Currency != 0 {
-		n += 1 + sovOrder(uint64(m.Currency))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).Fulfill(ctx, req.(*FulfillRequest))
 	}
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovOrder(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Metadata) > 0 {
-		for k, v := range m.Metadata {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovOrder(uint64(len(k))) + 1 + len(v) + sovOrder(uint64(len(v)))
-			n += mapEntrySize + 1 + sovOrder(uint64(mapEntrySize))
-		}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).Cancel(ctx, in)
 	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orderpb.OrderService/Cancel",
 	}
-	l = len(m.ChargeId)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).Cancel(ctx, req.(*CancelRequest))
 	}
-	if m.Status != 0 {
-		n += 1 + sovOrder(uint64(m.Status))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Shipping != nil {
-		l = m.Shipping.Size()
-		n += 1 + l + sovOrder(uint64(l))
+	if interceptor == nil {
+		return srv.(OrderServiceServer).Update(ctx, in)
 	}
-	if m.Created != 0 {
-		n += 2 + sovOrder(uint64(m.Created))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orderpb.OrderService/Update",
 	}
-	if m.Updated != 0 {
-		n += 2 + sovOrder(uint64(m.Updated))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).Update(ctx, req.(*UpdateRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OrderItem) Size() (n int) {
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovOrder(uint64(m.Type))
-	}
-	if m.Quantity != 0 {
-		n += 1 + sovOrder(uint64(m.Quantity))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovOrder(uint64(m.Amount))
+func _OrderService_GetShippingRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShippingRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Currency != 0 {
-		n += 1 + sovOrder(uint64(m.Currency))
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetShippingRates(ctx, in)
 	}
-	l = len(m.Parent)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orderpb.OrderService/GetShippingRates",
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetShippingRates(ctx, req.(*ShippingRatesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Shipping) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
-	}
-	l = len(m.Phone)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+func _OrderService_CreateFulfillment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FulfillmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Address != nil {
-		l = m.Address.Size()
-		n += 1 + l + sovOrder(uint64(l))
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreateFulfillment(ctx, in)
 	}
-	l = len(m.Carrier)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orderpb.OrderService/CreateFulfillment",
 	}
-	l = len(m.TrackingNumber)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreateFulfillment(ctx, req.(*FulfillmentRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Shipping_Address) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Line1)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
-	}
-	l = len(m.City)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
-	}
-	l = len(m.Country)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+func _OrderService_AddNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Line2)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	if interceptor == nil {
+		return srv.(OrderServiceServer).AddNote(ctx, in)
 	}
-	l = len(m.PostalCode)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/orderpb.OrderService/AddNote",
 	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).AddNote(ctx, req.(*NoteRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *OrderList) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovOrder(uint64(l))
-		}
-	}
-	if m.Total != 0 {
-		n += 1 + sovOrder(uint64(m.Total))
+var _OrderService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "orderpb.OrderService",
+	HandlerType: (*OrderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "New",
+			Handler:    _OrderService_New_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _OrderService_Get_Handler,
+		},
+		{
+			MethodName: "Pay",
+			Handler:    _OrderService_Pay_Handler,
+		},
+		{
+			MethodName: "Return",
+			Handler:    _OrderService_Return_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _OrderService_List_Handler,
+		},
+		{
+			MethodName: "Fulfill",
+			Handler:    _OrderService_Fulfill_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _OrderService_Cancel_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _OrderService_Update_Handler,
+		},
+		{
+			MethodName: "GetShippingRates",
+			Handler:    _OrderService_GetShippingRates_Handler,
+		},
+		{
+			MethodName: "CreateFulfillment",
+			Handler:    _OrderService_CreateFulfillment_Handler,
+		},
+		{
+			MethodName: "AddNote",
+			Handler:    _OrderService_AddNote_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "order/orderpb/order.proto",
+}
+
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NewRequest) Size() (n int) {
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Amount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Amount))
+	}
 	if m.Currency != 0 {
-		n += 1 + sovOrder(uint64(m.Currency))
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Currency))
 	}
 	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovOrder(uint64(l))
+		for _, msg := range m.Items {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if len(m.Metadata) > 0 {
-		for k, v := range m.Metadata {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovOrder(uint64(len(k))) + 1 + len(v) + sovOrder(uint64(len(v)))
-			n += mapEntrySize + 1 + sovOrder(uint64(mapEntrySize))
-		}
-	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
-	}
-	if m.Shipping != nil {
-		l = m.Shipping.Size()
-		n += 1 + l + sovOrder(uint64(l))
-	}
-	return n
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x2a
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovOrder(uint64(len(k))) + 1 + len(v) + sovOrder(uint64(len(v)))
+			i = encodeVarintOrder(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Email) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Email)))
+		i += copy(dAtA[i:], m.Email)
+	}
+	if len(m.ChargeId) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.ChargeId)))
+		i += copy(dAtA[i:], m.ChargeId)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Status))
+	}
+	if m.Shipping != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Shipping.Size()))
+		n1, err := m.Shipping.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.CancelReason != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.CancelReason))
+	}
+	if len(m.Returns) > 0 {
+		for _, msg := range m.Returns {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Expires != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Expires))
+	}
+	if len(m.Coupons) > 0 {
+		for _, s := range m.Coupons {
+			dAtA[i] = 0x6a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Taxes) > 0 {
+		for _, msg := range m.Taxes {
+			dAtA[i] = 0x72
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ShippingRate) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.ShippingRate)))
+		i += copy(dAtA[i:], m.ShippingRate)
+	}
+	if len(m.ExchangeRates) > 0 {
+		for _, msg := range m.ExchangeRates {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Fulfillments) > 0 {
+		for _, msg := range m.Fulfillments {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Events) > 0 {
+		for _, msg := range m.Events {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.CustomerId) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.CustomerId)))
+		i += copy(dAtA[i:], m.CustomerId)
+	}
+	if m.Credit != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Credit))
+	}
+	if m.Created != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Created))
+	}
+	if m.Updated != 0 {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Updated))
+	}
+	return i, nil
 }
 
-func (m *GetRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+func (m *OrderItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PayRequest) Size() (n int) {
+func (m *OrderItem) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	if m.Type != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Type))
 	}
-	if m.Card != nil {
-		l = m.Card.Size()
-		n += 1 + l + sovOrder(uint64(l))
+	if m.Quantity != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Quantity))
 	}
-	if m.PaymentProviderId != 0 {
-		n += 1 + sovOrder(uint64(m.PaymentProviderId))
+	if m.Amount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Amount))
 	}
-	return n
+	if m.Currency != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Currency))
+	}
+	if len(m.Parent) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Parent)))
+		i += copy(dAtA[i:], m.Parent)
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	return i, nil
 }
 
-func (m *ReturnRequest) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+func (m *ExchangeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListRequest) Size() (n int) {
+func (m *ExchangeRate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Page != 0 {
-		n += 1 + sovOrder(uint64(m.Page))
+	if m.Currency != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Currency))
 	}
-	if m.Limit != 0 {
-		n += 1 + sovOrder(uint64(m.Limit))
+	if m.Rate != 0 {
+		dAtA[i] = 0x11
+		i++
+		i = encodeFixed64Order(dAtA, i, uint64(math.Float64bits(float64(m.Rate))))
 	}
-	if m.Sort != 0 {
-		n += 1 + sovOrder(uint64(m.Sort))
+	return i, nil
+}
+
+func (m *TaxLine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func sovOrder(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *TaxLine) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parent) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Parent)))
+		i += copy(dAtA[i:], m.Parent)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Rate != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Rate))
+	}
+	if m.Inclusive {
+		dAtA[i] = 0x20
+		i++
+		if m.Inclusive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	return n
-}
-func sozOrder(x uint64) (n int) {
-	return sovOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if m.Taxable != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Taxable))
+	}
+	if m.Amount != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Amount))
+	}
+	return i, nil
+}
+
+func (m *Shipping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Shipping) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Phone) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Phone)))
+		i += copy(dAtA[i:], m.Phone)
+	}
+	if m.Address != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Address.Size()))
+		n2, err := m.Address.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.Carrier) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Carrier)))
+		i += copy(dAtA[i:], m.Carrier)
+	}
+	if len(m.TrackingNumber) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.TrackingNumber)))
+		i += copy(dAtA[i:], m.TrackingNumber)
+	}
+	return i, nil
+}
+
+func (m *Shipping_Address) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Shipping_Address) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Line1) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Line1)))
+		i += copy(dAtA[i:], m.Line1)
+	}
+	if len(m.City) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.City)))
+		i += copy(dAtA[i:], m.City)
+	}
+	if len(m.Country) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Country)))
+		i += copy(dAtA[i:], m.Country)
+	}
+	if len(m.Line2) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Line2)))
+		i += copy(dAtA[i:], m.Line2)
+	}
+	if len(m.PostalCode) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.PostalCode)))
+		i += copy(dAtA[i:], m.PostalCode)
+	}
+	if len(m.State) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
+	}
+	return i, nil
+}
+
+func (m *ReturnItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReturnItem) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parent) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Parent)))
+		i += copy(dAtA[i:], m.Parent)
+	}
+	if m.Quantity != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Quantity))
+	}
+	if m.Restock {
+		dAtA[i] = 0x18
+		i++
+		if m.Restock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *OrderReturn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderReturn) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Amount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Amount))
+	}
+	if m.Reason != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Reason))
+	}
+	if len(m.RefundId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.RefundId)))
+		i += copy(dAtA[i:], m.RefundId)
+	}
+	if m.Created != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Created))
+	}
+	return i, nil
+}
+
+func (m *FulfillmentItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FulfillmentItem) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parent) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Parent)))
+		i += copy(dAtA[i:], m.Parent)
+	}
+	if m.Quantity != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Quantity))
+	}
+	return i, nil
+}
+
+func (m *Fulfillment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fulfillment) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Carrier) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Carrier)))
+		i += copy(dAtA[i:], m.Carrier)
+	}
+	if len(m.TrackingNumber) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.TrackingNumber)))
+		i += copy(dAtA[i:], m.TrackingNumber)
+	}
+	if m.Created != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Created))
+	}
+	return i, nil
+}
+
+func (m *OrderEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Type != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Type))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Actor) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Actor)))
+		i += copy(dAtA[i:], m.Actor)
+	}
+	if m.Created != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Created))
+	}
+	return i, nil
+}
+
+func (m *OrderList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, msg := range m.Orders {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Total != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Total))
+	}
+	return i, nil
+}
+
+func (m *NewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NewRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Currency != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Currency))
+	}
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovOrder(uint64(len(k))) + 1 + len(v) + sovOrder(uint64(len(v)))
+			i = encodeVarintOrder(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Email) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Email)))
+		i += copy(dAtA[i:], m.Email)
+	}
+	if m.Shipping != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Shipping.Size()))
+		n3, err := m.Shipping.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.Ttl != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Ttl))
+	}
+	if len(m.Coupons) > 0 {
+		for _, s := range m.Coupons {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ShippingRate) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.ShippingRate)))
+		i += copy(dAtA[i:], m.ShippingRate)
+	}
+	if len(m.IdempotencyKey) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i += copy(dAtA[i:], m.IdempotencyKey)
+	}
+	if len(m.CustomerId) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.CustomerId)))
+		i += copy(dAtA[i:], m.CustomerId)
+	}
+	return i, nil
+}
+
+func (m *ShippingRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShippingRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Currency != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Currency))
+	}
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Shipping != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Shipping.Size()))
+		n4, err := m.Shipping.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+
+func (m *ShippingQuote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShippingQuote) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parent) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Parent)))
+		i += copy(dAtA[i:], m.Parent)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Carrier) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Carrier)))
+		i += copy(dAtA[i:], m.Carrier)
+	}
+	if m.Amount != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Amount))
+	}
+	if m.Currency != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Currency))
+	}
+	return i, nil
+}
+
+func (m *ShippingQuoteList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShippingQuoteList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quotes) > 0 {
+		for _, msg := range m.Quotes {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *PayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Card != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Card.Size()))
+		n5, err := m.Card.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.PaymentProviderId != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.PaymentProviderId))
+	}
+	if len(m.IdempotencyKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i += copy(dAtA[i:], m.IdempotencyKey)
+	}
+	if len(m.PaymentMethod) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.PaymentMethod)))
+		i += copy(dAtA[i:], m.PaymentMethod)
+	}
+	return i, nil
+}
+
+func (m *ReturnRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReturnRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Reason != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Reason))
+	}
+	return i, nil
+}
+
+func (m *FulfillRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FulfillRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Carrier) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Carrier)))
+		i += copy(dAtA[i:], m.Carrier)
+	}
+	if len(m.TrackingNumber) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.TrackingNumber)))
+		i += copy(dAtA[i:], m.TrackingNumber)
+	}
+	return i, nil
+}
+
+func (m *FulfillmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FulfillmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Carrier) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Carrier)))
+		i += copy(dAtA[i:], m.Carrier)
+	}
+	if len(m.TrackingNumber) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.TrackingNumber)))
+		i += copy(dAtA[i:], m.TrackingNumber)
+	}
+	return i, nil
+}
+
+func (m *NoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Note) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Note)))
+		i += copy(dAtA[i:], m.Note)
+	}
+	return i, nil
+}
+
+func (m *CancelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Reason != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Reason))
+	}
+	return i, nil
+}
+
+func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Email) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Email)))
+		i += copy(dAtA[i:], m.Email)
+	}
+	if m.Shipping != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Shipping.Size()))
+		n6, err := m.Shipping.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.Coupons) > 0 {
+		for _, s := range m.Coupons {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ShippingRate) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.ShippingRate)))
+		i += copy(dAtA[i:], m.ShippingRate)
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x3a
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovOrder(uint64(len(k))) + 1 + len(v) + sovOrder(uint64(len(v)))
+			i = encodeVarintOrder(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *ListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Page != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Limit))
+	}
+	if m.Sort != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(m.Sort))
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x22
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovOrder(uint64(len(k))) + 1 + len(v) + sovOrder(uint64(len(v)))
+			i = encodeVarintOrder(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintOrder(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.CustomerId) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.CustomerId)))
+		i += copy(dAtA[i:], m.CustomerId)
+	}
+	return i, nil
+}
+
+func encodeFixed64Order(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Order(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintOrder(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Order) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovOrder(uint64(m.Amount))
+	}
+	if m.Currency != 0 {
+		n += 1 + sovOrder(uint64(m.Currency))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOrder(uint64(len(k))) + 1 + len(v) + sovOrder(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOrder(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.ChargeId)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovOrder(uint64(m.Status))
+	}
+	if m.Shipping != nil {
+		l = m.Shipping.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.CancelReason != 0 {
+		n += 1 + sovOrder(uint64(m.CancelReason))
+	}
+	if len(m.Returns) > 0 {
+		for _, e := range m.Returns {
+			l = e.Size()
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	if m.Expires != 0 {
+		n += 1 + sovOrder(uint64(m.Expires))
+	}
+	if len(m.Coupons) > 0 {
+		for _, s := range m.Coupons {
+			l = len(s)
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	if len(m.Taxes) > 0 {
+		for _, e := range m.Taxes {
+			l = e.Size()
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	l = len(m.ShippingRate)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 2 + l + sovOrder(uint64(l))
+		}
+	}
+	if len(m.Fulfillments) > 0 {
+		for _, e := range m.Fulfillments {
+			l = e.Size()
+			n += 2 + l + sovOrder(uint64(l))
+		}
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 2 + l + sovOrder(uint64(l))
+		}
+	}
+	l = len(m.CustomerId)
+	if l > 0 {
+		n += 2 + l + sovOrder(uint64(l))
+	}
+	if m.Credit != 0 {
+		n += 2 + sovOrder(uint64(m.Credit))
+	}
+	if m.Created != 0 {
+		n += 2 + sovOrder(uint64(m.Created))
+	}
+	if m.Updated != 0 {
+		n += 2 + sovOrder(uint64(m.Updated))
+	}
+	return n
+}
+
+func (m *OrderItem) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovOrder(uint64(m.Type))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovOrder(uint64(m.Quantity))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovOrder(uint64(m.Amount))
+	}
+	if m.Currency != 0 {
+		n += 1 + sovOrder(uint64(m.Currency))
+	}
+	l = len(m.Parent)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeRate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Currency != 0 {
+		n += 1 + sovOrder(uint64(m.Currency))
+	}
+	if m.Rate != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *TaxLine) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Parent)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Rate != 0 {
+		n += 1 + sovOrder(uint64(m.Rate))
+	}
+	if m.Inclusive {
+		n += 2
+	}
+	if m.Taxable != 0 {
+		n += 1 + sovOrder(uint64(m.Taxable))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovOrder(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *Shipping) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.Phone)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Address != nil {
+		l = m.Address.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.Carrier)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.TrackingNumber)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *Shipping_Address) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Line1)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.City)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.Country)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.Line2)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.PostalCode)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *ReturnItem) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Parent)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovOrder(uint64(m.Quantity))
+	}
+	if m.Restock {
+		n += 2
+	}
+	return n
+}
+
+func (m *OrderReturn) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	if m.Amount != 0 {
+		n += 1 + sovOrder(uint64(m.Amount))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovOrder(uint64(m.Reason))
+	}
+	l = len(m.RefundId)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Created != 0 {
+		n += 1 + sovOrder(uint64(m.Created))
+	}
+	return n
+}
+
+func (m *FulfillmentItem) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Parent)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovOrder(uint64(m.Quantity))
+	}
+	return n
+}
+
+func (m *Fulfillment) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	l = len(m.Carrier)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.TrackingNumber)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Created != 0 {
+		n += 1 + sovOrder(uint64(m.Created))
+	}
+	return n
+}
+
+func (m *OrderEvent) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovOrder(uint64(m.Type))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Created != 0 {
+		n += 1 + sovOrder(uint64(m.Created))
+	}
+	return n
+}
+
+func (m *OrderList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 1 + sovOrder(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *NewRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Currency != 0 {
+		n += 1 + sovOrder(uint64(m.Currency))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOrder(uint64(len(k))) + 1 + len(v) + sovOrder(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOrder(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Shipping != nil {
+		l = m.Shipping.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovOrder(uint64(m.Ttl))
+	}
+	if len(m.Coupons) > 0 {
+		for _, s := range m.Coupons {
+			l = len(s)
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	l = len(m.ShippingRate)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.CustomerId)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *ShippingRatesRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Currency != 0 {
+		n += 1 + sovOrder(uint64(m.Currency))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	if m.Shipping != nil {
+		l = m.Shipping.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *ShippingQuote) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Parent)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.Carrier)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovOrder(uint64(m.Amount))
+	}
+	if m.Currency != 0 {
+		n += 1 + sovOrder(uint64(m.Currency))
+	}
+	return n
+}
+
+func (m *ShippingQuoteList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Quotes) > 0 {
+		for _, e := range m.Quotes {
+			l = e.Size()
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *PayRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Card != nil {
+		l = m.Card.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.PaymentProviderId != 0 {
+		n += 1 + sovOrder(uint64(m.PaymentProviderId))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.PaymentMethod)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *ReturnRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	if m.Reason != 0 {
+		n += 1 + sovOrder(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *FulfillRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.Carrier)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.TrackingNumber)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *FulfillmentRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	l = len(m.Carrier)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.TrackingNumber)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *NoteRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovOrder(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *UpdateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Shipping != nil {
+		l = m.Shipping.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if len(m.Coupons) > 0 {
+		for _, s := range m.Coupons {
+			l = len(s)
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	l = len(m.ShippingRate)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOrder(uint64(len(k))) + 1 + len(v) + sovOrder(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOrder(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ListRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovOrder(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovOrder(uint64(m.Limit))
+	}
+	if m.Sort != 0 {
+		n += 1 + sovOrder(uint64(m.Sort))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOrder(uint64(len(k))) + 1 + len(v) + sovOrder(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOrder(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.CustomerId)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func sovOrder(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozOrder(x uint64) (n int) {
+	return sovOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Order) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -1555,17 +3896,2323 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			m.Currency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Currency |= (paymentpb.Currency(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &OrderItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOrder
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOrder
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthOrder
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Metadata[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Metadata[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChargeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (OrderStatus(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shipping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Shipping == nil {
+				m.Shipping = &Shipping{}
+			}
+			if err := m.Shipping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelReason", wireType)
+			}
+			m.CancelReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancelReason |= (CancelReason(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Returns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Returns = append(m.Returns, &OrderReturn{})
+			if err := m.Returns[len(m.Returns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			m.Expires = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expires |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coupons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coupons = append(m.Coupons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taxes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Taxes = append(m.Taxes, &TaxLine{})
+			if err := m.Taxes[len(m.Taxes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShippingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShippingRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, &ExchangeRate{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfillments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfillments = append(m.Fulfillments, &Fulfillment{})
+			if err := m.Fulfillments[len(m.Fulfillments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &OrderEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credit", wireType)
+			}
+			m.Credit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Credit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 998:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 999:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			m.Updated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updated |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (OrderItem_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			m.Currency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Currency |= (paymentpb.Currency(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			m.Currency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Currency |= (paymentpb.Currency(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Rate = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaxLine) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaxLine: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaxLine: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			m.Rate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rate |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inclusive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inclusive = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taxable", wireType)
+			}
+			m.Taxable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Taxable |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Shipping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Shipping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Shipping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Address == nil {
+				m.Address = &Shipping_Address{}
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Carrier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Carrier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackingNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Shipping_Address) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Address: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Address: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Line1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Line1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.City = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Country = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Line2", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Line2 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostalCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostalCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReturnItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReturnItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReturnItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restock = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderReturn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderReturn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderReturn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &ReturnItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= (paymentpb.RefundReason(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FulfillmentItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FulfillmentItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FulfillmentItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fulfillment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fulfillment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fulfillment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &FulfillmentItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Carrier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Carrier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackingNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (OrderEvent_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1575,26 +6222,28 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			m.Amount = 0
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1604,12 +6253,62 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= (int64(b) & 0x7F) << shift
+				m.Total |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
 			}
@@ -1628,7 +6327,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
@@ -1659,7 +6358,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
@@ -1775,7 +6474,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				m.Metadata[mapkey] = mapvalue
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
@@ -1804,11 +6503,11 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			}
 			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChargeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shipping", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1818,26 +6517,30 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChargeId = string(dAtA[iNdEx:postIndex])
+			if m.Shipping == nil {
+				m.Shipping = &Shipping{}
+			}
+			if err := m.Shipping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
 			}
-			m.Status = 0
+			m.Ttl = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1847,16 +6550,16 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= (OrderStatus(b) & 0x7F) << shift
+				m.Ttl |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shipping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coupons", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1866,30 +6569,55 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Shipping == nil {
-				m.Shipping = &Shipping{}
+			m.Coupons = append(m.Coupons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShippingRate", wireType)
 			}
-			if err := m.Shipping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ShippingRate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 998:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
 			}
-			m.Created = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1899,16 +6627,26 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Created |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 999:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
 			}
-			m.Updated = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomerId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1918,11 +6656,21 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Updated |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
@@ -1944,7 +6692,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrderItem) Unmarshal(dAtA []byte) error {
+func (m *ShippingRatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1967,70 +6715,13 @@ func (m *OrderItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrderItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShippingRatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrderItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShippingRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOrder
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= (OrderItem_Type(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
-			}
-			m.Quantity = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOrder
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Quantity |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOrder
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
 			}
@@ -2049,11 +6740,11 @@ func (m *OrderItem) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -2063,26 +6754,28 @@ func (m *OrderItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parent = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, &OrderItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shipping", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -2092,20 +6785,24 @@ func (m *OrderItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.Shipping == nil {
+				m.Shipping = &Shipping{}
+			}
+			if err := m.Shipping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2128,7 +6825,7 @@ func (m *OrderItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Shipping) Unmarshal(dAtA []byte) error {
+func (m *ShippingQuote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2151,15 +6848,15 @@ func (m *Shipping) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Shipping: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShippingQuote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Shipping: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShippingQuote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2184,11 +6881,11 @@ func (m *Shipping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Parent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2213,13 +6910,13 @@ func (m *Shipping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phone = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Carrier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -2229,30 +6926,26 @@ func (m *Shipping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Address == nil {
-				m.Address = &Shipping_Address{}
-			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Carrier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Carrier", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -2262,26 +6955,85 @@ func (m *Shipping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Amount |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			m.Currency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Currency |= (paymentpb.Currency(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Carrier = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShippingQuoteList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShippingQuoteList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShippingQuoteList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrackingNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -2291,20 +7043,22 @@ func (m *Shipping) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrackingNumber = string(dAtA[iNdEx:postIndex])
+			m.Quotes = append(m.Quotes, &ShippingQuote{})
+			if err := m.Quotes[len(m.Quotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2327,7 +7081,7 @@ func (m *Shipping) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Shipping_Address) Unmarshal(dAtA []byte) error {
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2350,15 +7104,15 @@ func (m *Shipping_Address) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Address: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Address: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Line1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2383,11 +7137,61 @@ func (m *Shipping_Address) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Line1 = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field City", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2412,13 +7216,13 @@ func (m *Shipping_Address) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.City = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Country", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Card", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -2428,26 +7232,30 @@ func (m *Shipping_Address) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Country = string(dAtA[iNdEx:postIndex])
+			if m.Card == nil {
+				m.Card = &paymentpb.Card{}
+			}
+			if err := m.Card.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Line2", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentProviderId", wireType)
 			}
-			var stringLen uint64
+			m.PaymentProviderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -2457,24 +7265,14 @@ func (m *Shipping_Address) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.PaymentProviderId |= (paymentpb.PaymentProviderId(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOrder
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Line2 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostalCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2499,11 +7297,11 @@ func (m *Shipping_Address) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PostalCode = string(dAtA[iNdEx:postIndex])
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentMethod", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2528,7 +7326,7 @@ func (m *Shipping_Address) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.PaymentMethod = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2551,7 +7349,7 @@ func (m *Shipping_Address) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrderList) Unmarshal(dAtA []byte) error {
+func (m *ReturnRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2574,15 +7372,44 @@ func (m *OrderList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrderList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReturnRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrderList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReturnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2606,16 +7433,16 @@ func (m *OrderList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, &Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, &ReturnItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.Total = 0
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -2625,7 +7452,7 @@ func (m *OrderList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Total |= (int32(b) & 0x7F) << shift
+				m.Reason |= (paymentpb.RefundReason(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2651,7 +7478,7 @@ func (m *OrderList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewRequest) Unmarshal(dAtA []byte) error {
+func (m *FulfillRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2674,17 +7501,17 @@ func (m *NewRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FulfillRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FulfillRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Currency = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -2694,16 +7521,26 @@ func (m *NewRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Currency |= (paymentpb.Currency(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Carrier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -2713,28 +7550,26 @@ func (m *NewRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &OrderItem{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Carrier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingNumber", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -2744,19 +7579,76 @@ func (m *NewRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			m.TrackingNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FulfillmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FulfillmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FulfillmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -2766,12 +7658,26 @@ func (m *NewRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapkey uint64
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -2781,74 +7687,55 @@ func (m *NewRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
+			m.Items = append(m.Items, &FulfillmentItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOrder
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var stringLenmapvalue uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOrder
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLenmapvalue := int(stringLenmapvalue)
-				if intStringLenmapvalue < 0 {
-					return ErrInvalidLengthOrder
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Carrier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
 				}
-				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-				if postStringIndexmapvalue > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
-				iNdEx = postStringIndexmapvalue
-				m.Metadata[mapkey] = mapvalue
-			} else {
-				var mapvalue string
-				m.Metadata[mapkey] = mapvalue
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Carrier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingNumber", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2873,13 +7760,63 @@ func (m *NewRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.TrackingNumber = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shipping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -2889,24 +7826,49 @@ func (m *NewRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Shipping == nil {
-				m.Shipping = &Shipping{}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
 			}
-			if err := m.Shipping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Note = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2929,7 +7891,7 @@ func (m *NewRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
+func (m *CancelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2952,10 +7914,10 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2987,6 +7949,25 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= (CancelReason(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
@@ -3008,7 +7989,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PayRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3031,10 +8012,10 @@ func (m *PayRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PayRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3068,7 +8049,7 @@ func (m *PayRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Card", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3092,18 +8073,16 @@ func (m *PayRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Card == nil {
-				m.Card = &paymentpb.Card{}
-			}
-			if err := m.Card.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, &OrderItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentProviderId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
-			m.PaymentProviderId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -3113,66 +8092,154 @@ func (m *PayRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PaymentProviderId |= (paymentpb.PaymentProviderId(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOrder(dAtA[iNdEx:])
-			if err != nil {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shipping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Shipping == nil {
+				m.Shipping = &Shipping{}
+			}
+			if err := m.Shipping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coupons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReturnRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOrder
+			m.Coupons = append(m.Coupons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShippingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShippingRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReturnRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReturnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -3182,20 +8249,70 @@ func (m *ReturnRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOrder
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOrder
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthOrder
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Metadata[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Metadata[mapkey] = mapvalue
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3304,6 +8421,151 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOrder
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOrder
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthOrder
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Metadata[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Metadata[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
@@ -3433,84 +8695,150 @@ var (
 func init() { proto.RegisterFile("order/orderpb/order.proto", fileDescriptorOrder) }
 
 var fileDescriptorOrder = []byte{
-	// 1249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdf, 0x6e, 0x1b, 0xc5,
-	0x17, 0xce, 0xda, 0xeb, 0x7f, 0xc7, 0x6d, 0xea, 0x4e, 0xfb, 0xeb, 0x6f, 0x63, 0x15, 0xdb, 0x9d,
-	0xaa, 0x21, 0x95, 0x12, 0xa7, 0x71, 0x2b, 0x14, 0xa5, 0x14, 0xa9, 0x0e, 0x50, 0x15, 0x95, 0x10,
-	0x6d, 0x28, 0x48, 0x08, 0x09, 0x8d, 0x77, 0xa7, 0xce, 0xa8, 0xf6, 0xee, 0x76, 0x76, 0x36, 0xc5,
-	0x77, 0x3c, 0x06, 0x5c, 0x73, 0xc3, 0x2b, 0x70, 0xc1, 0x35, 0x5c, 0xf2, 0x04, 0x16, 0x2a, 0x12,
-	0x5c, 0x22, 0xf9, 0x09, 0xd0, 0xfc, 0x59, 0x7b, 0xed, 0xd8, 0x05, 0x95, 0x1b, 0x7b, 0xcf, 0x9c,
-	0xef, 0xcc, 0x9c, 0x39, 0xe7, 0x9b, 0x6f, 0x06, 0x36, 0x42, 0xee, 0x53, 0xbe, 0xab, 0x7e, 0xa3,
-	0x9e, 0xfe, 0x6f, 0x47, 0x3c, 0x14, 0x21, 0x2a, 0x99, 0xc1, 0xfa, 0x4e, 0x9f, 0x89, 0xd3, 0xa4,
-	0xd7, 0xf6, 0xc2, 0xe1, 0x6e, 0x3f, 0xec, 0x87, 0xbb, 0xca, 0xdf, 0x4b, 0x9e, 0x29, 0x4b, 0x19,
-	0xea, 0x4b, 0xc7, 0xd5, 0xf7, 0x33, 0x70, 0x9f, 0xf5, 0x43, 0x41, 0xd2, 0xbf, 0x88, 0x8c, 0x86,
-	0x34, 0x10, 0xe9, 0x7f, 0xd4, 0x4b, 0xbf, 0x74, 0x24, 0xfe, 0xde, 0x86, 0xc2, 0x27, 0x72, 0x51,
-	0xd4, 0x80, 0x1c, 0xf3, 0x1d, 0xab, 0x65, 0x6d, 0x55, 0xba, 0xeb, 0x93, 0x71, 0x13, 0x7a, 0x71,
-	0x18, 0x1c, 0xe0, 0xaf, 0x98, 0x8f, 0xdd, 0x1c, 0xf3, 0xd1, 0x35, 0x28, 0x92, 0x61, 0x98, 0x04,
-	0xc2, 0xc9, 0xb5, 0xac, 0xad, 0xbc, 0x6b, 0x2c, 0xb4, 0x0b, 0x65, 0x2f, 0xe1, 0x9c, 0x06, 0xde,
-	0xc8, 0xc9, 0xb7, 0xac, 0xad, 0xf5, 0xce, 0x95, 0xf6, 0x74, 0xb5, 0xf6, 0xa1, 0x71, 0xb9, 0x53,
-	0x10, 0xda, 0x82, 0x02, 0x13, 0x74, 0x18, 0x3b, 0x76, 0x2b, 0xbf, 0x55, 0xed, 0xa0, 0xb6, 0xd9,
-	0x74, 0x5b, 0xe5, 0xf1, 0x58, 0xd0, 0xa1, 0xab, 0x01, 0x68, 0x1f, 0xca, 0x43, 0x2a, 0x88, 0x4f,
-	0x04, 0x71, 0x0a, 0x0a, 0x7c, 0x7d, 0x1e, 0xdc, 0xfe, 0xd8, 0xb8, 0x3f, 0x08, 0x04, 0x1f, 0xb9,
-	0x53, 0x34, 0xba, 0x0a, 0x05, 0x3a, 0x24, 0x6c, 0xe0, 0x14, 0xe5, 0x7e, 0x5c, 0x6d, 0xa0, 0x3a,
-	0x94, 0xbd, 0x53, 0xc2, 0xfb, 0xf4, 0xb1, 0xef, 0x94, 0x94, 0x63, 0x6a, 0xa3, 0x1d, 0x28, 0x9e,
-	0x08, 0x22, 0x92, 0xd8, 0x29, 0xab, 0x4d, 0xfc, 0x6f, 0x61, 0xa5, 0x58, 0x39, 0x5d, 0x03, 0x42,
-	0x3b, 0x50, 0x8e, 0x4f, 0x59, 0x14, 0xb1, 0xa0, 0xef, 0x54, 0x5a, 0xd6, 0x56, 0xb5, 0x73, 0x79,
-	0x1a, 0x70, 0x62, 0x1c, 0xee, 0x14, 0x82, 0x36, 0xa0, 0xe4, 0x71, 0x4a, 0x04, 0xf5, 0x9d, 0x3f,
-	0x4a, 0xaa, 0x7c, 0xa9, 0x2d, 0x5d, 0x49, 0xe4, 0x2b, 0xd7, 0x9f, 0xc6, 0x65, 0xec, 0xfa, 0x7d,
-	0xb8, 0x38, 0xb7, 0x41, 0x54, 0x83, 0xfc, 0x73, 0x3a, 0xd2, 0x4d, 0x72, 0xe5, 0xa7, 0xdc, 0xe8,
-	0x19, 0x19, 0x24, 0x54, 0x35, 0xa5, 0xe2, 0x6a, 0xe3, 0x20, 0xb7, 0x6f, 0xe1, 0x8f, 0xa0, 0xa8,
-	0x73, 0x46, 0x55, 0x28, 0x1d, 0xea, 0xc5, 0x6a, 0x6b, 0xa8, 0x0c, 0xf6, 0x31, 0x61, 0x7e, 0xcd,
-	0x42, 0x17, 0xa0, 0x7c, 0x48, 0x02, 0x8f, 0x0e, 0xa8, 0x5f, 0xcb, 0xa1, 0x8b, 0x50, 0xf9, 0x30,
-	0x19, 0x3c, 0x63, 0x03, 0x69, 0xe6, 0xa5, 0xd3, 0xa5, 0x22, 0xe1, 0x01, 0xf5, 0x6b, 0x36, 0xfe,
-	0x21, 0x0f, 0x95, 0x69, 0x77, 0xd0, 0x31, 0xd8, 0x62, 0x14, 0x51, 0x95, 0xc6, 0x7a, 0xe7, 0xff,
-	0xe7, 0xfb, 0xd7, 0xfe, 0x74, 0x14, 0xd1, 0xee, 0xcd, 0xc9, 0xb8, 0xd9, 0x3c, 0x23, 0x03, 0x26,
-	0x37, 0x73, 0x80, 0x39, 0x7d, 0x91, 0x30, 0x4e, 0xfd, 0xed, 0xbe, 0xa0, 0x0f, 0xf6, 0xb6, 0x07,
-	0x82, 0x3e, 0xb8, 0x87, 0x5d, 0x35, 0x13, 0x3a, 0x80, 0xf2, 0x8b, 0x84, 0x04, 0x82, 0x89, 0x91,
-	0x66, 0x57, 0xb7, 0x31, 0x19, 0x37, 0xeb, 0xb3, 0xe0, 0x70, 0x28, 0x19, 0x11, 0x89, 0x91, 0x8a,
-	0xbe, 0x83, 0xdd, 0x29, 0x3e, 0xc3, 0xcb, 0xfc, 0x1c, 0x2f, 0x3f, 0xcf, 0xf0, 0xd2, 0x5e, 0xc9,
-	0xcb, 0xee, 0xe6, 0x64, 0xdc, 0xc4, 0xab, 0x16, 0xd2, 0x69, 0xee, 0x75, 0xf6, 0x71, 0x86, 0xbf,
-	0xef, 0x40, 0x31, 0x22, 0x9c, 0x06, 0xc2, 0x29, 0xa8, 0xc3, 0xb2, 0x32, 0xd5, 0x24, 0x61, 0xfe,
-	0x3d, 0xec, 0x1a, 0x34, 0x6a, 0x41, 0xd5, 0xa7, 0xb1, 0xc7, 0x59, 0x24, 0x58, 0x18, 0x18, 0x66,
-	0x66, 0x87, 0x70, 0x17, 0x6c, 0x59, 0x39, 0x59, 0x7c, 0x4e, 0x63, 0xca, 0xcf, 0x54, 0xc7, 0x4a,
-	0x90, 0x8f, 0x9f, 0x27, 0xba, 0x61, 0x3e, 0x8b, 0x3d, 0xb9, 0xbb, 0x5a, 0x4e, 0x0e, 0x0b, 0xf2,
-	0xb5, 0x6e, 0x55, 0xca, 0xb3, 0x9a, 0x8d, 0x7f, 0xce, 0x41, 0x39, 0x25, 0x20, 0x42, 0x60, 0x07,
-	0x64, 0x48, 0x0d, 0x61, 0xd4, 0xb7, 0x64, 0x4c, 0x74, 0x1a, 0x06, 0x53, 0xc6, 0x28, 0x03, 0xdd,
-	0x85, 0x12, 0xf1, 0x7d, 0x4e, 0xe3, 0x58, 0x95, 0xb1, 0xda, 0xd9, 0x38, 0x47, 0xe7, 0xf6, 0x43,
-	0x0d, 0x70, 0x53, 0x24, 0x72, 0xa0, 0xe4, 0x11, 0xce, 0x19, 0xe5, 0xaa, 0xc2, 0x15, 0x37, 0x35,
-	0xd1, 0x26, 0xac, 0x0b, 0x4e, 0xbc, 0xe7, 0x2c, 0xe8, 0x1f, 0x25, 0xc3, 0x1e, 0xe5, 0xba, 0x56,
-	0xee, 0xc2, 0x68, 0xfd, 0x3b, 0x0b, 0x4a, 0x66, 0x5a, 0x99, 0xd8, 0x80, 0x05, 0x74, 0xcf, 0x64,
-	0xab, 0x0d, 0xb9, 0x05, 0x2f, 0xa5, 0x45, 0xc5, 0x55, 0xdf, 0x6a, 0x5d, 0x59, 0x05, 0xae, 0x15,
-	0x47, 0xae, 0xab, 0xcd, 0x74, 0x8e, 0x8e, 0xc9, 0x47, 0x1b, 0xa8, 0x01, 0x10, 0x85, 0xb1, 0x20,
-	0x83, 0xc3, 0xd0, 0xa7, 0x26, 0x93, 0xcc, 0x88, 0x8c, 0x92, 0x47, 0x85, 0xa6, 0x6a, 0xa1, 0x0c,
-	0xfc, 0xd8, 0x70, 0xfe, 0x09, 0x8b, 0x05, 0xda, 0x84, 0xa2, 0xaa, 0x47, 0xec, 0x58, 0x4a, 0x88,
-	0xd6, 0xe7, 0x59, 0xef, 0x1a, 0xaf, 0x9c, 0x4a, 0x84, 0x82, 0x0c, 0x54, 0xbe, 0x05, 0x57, 0x1b,
-	0xf8, 0xc7, 0x3c, 0xc0, 0x11, 0x7d, 0xe9, 0xd2, 0x17, 0x09, 0x8d, 0x05, 0xfa, 0x2c, 0x43, 0x4d,
-	0x6b, 0x35, 0x35, 0x6f, 0x4d, 0xc6, 0xcd, 0x1b, 0xaf, 0x3d, 0x40, 0x0b, 0xcc, 0x3c, 0x49, 0x95,
-	0x35, 0xb7, 0x4a, 0x59, 0xbb, 0xb7, 0x27, 0xe3, 0xe6, 0x2d, 0xad, 0xec, 0x0a, 0x8a, 0x5b, 0xb3,
-	0x05, 0x7c, 0x76, 0x46, 0xb7, 0xd3, 0x55, 0x70, 0x2a, 0xc2, 0x0f, 0x32, 0x22, 0x9c, 0x57, 0xf3,
-	0xde, 0x98, 0xce, 0x3b, 0xdb, 0xd3, 0x4a, 0x25, 0xbe, 0x97, 0x2a, 0xb1, 0xfd, 0xfa, 0xc3, 0xa2,
-	0x40, 0x38, 0x55, 0xea, 0x27, 0x19, 0x79, 0x2d, 0xac, 0x90, 0xd7, 0xee, 0x5b, 0x93, 0x71, 0x73,
-	0x63, 0xd9, 0x5c, 0x72, 0x23, 0x78, 0xa6, 0xbe, 0xff, 0x4d, 0x47, 0xef, 0x03, 0x3c, 0xa2, 0x22,
-	0x6d, 0xdd, 0x4e, 0xe6, 0x96, 0x5c, 0x58, 0x5f, 0x1d, 0xf7, 0x4c, 0xfd, 0x72, 0xcc, 0xc7, 0x7f,
-	0x59, 0x00, 0xc7, 0x64, 0xf4, 0x66, 0xd1, 0xe8, 0x21, 0xd8, 0x1e, 0xe1, 0xbe, 0xca, 0xa9, 0xda,
-	0xb9, 0x94, 0xe5, 0x08, 0xe1, 0x7e, 0xf7, 0xfa, 0x64, 0xdc, 0x74, 0x56, 0xb6, 0x4f, 0x85, 0xa2,
-	0x10, 0x2e, 0x9b, 0xa8, 0x63, 0x1e, 0x9e, 0x31, 0x49, 0x03, 0xdf, 0x5c, 0xd3, 0xd7, 0x33, 0xf3,
-	0x1d, 0x2f, 0x62, 0xfe, 0x85, 0x7a, 0xef, 0x61, 0xf7, 0xfc, 0xdc, 0xf8, 0x3d, 0xb8, 0xa8, 0x2f,
-	0x8e, 0x37, 0xac, 0xd8, 0x4f, 0x39, 0xa8, 0xca, 0x13, 0x97, 0x86, 0xdf, 0x07, 0x3b, 0x22, 0x7d,
-	0x2d, 0x61, 0xf9, 0xee, 0xdb, 0x93, 0x71, 0xf3, 0xe6, 0xb2, 0x96, 0xcf, 0xe5, 0x77, 0x07, 0xbb,
-	0x2a, 0x08, 0xbd, 0x2b, 0xe5, 0x60, 0xc8, 0xcc, 0x93, 0x65, 0xb5, 0xd6, 0x67, 0xa2, 0x65, 0xb0,
-	0x0e, 0x42, 0x5f, 0x82, 0x1d, 0x87, 0x5c, 0x98, 0x72, 0xcd, 0x04, 0x31, 0x93, 0x5e, 0xfb, 0x24,
-	0xe4, 0xa2, 0xbb, 0x33, 0x19, 0x37, 0x6f, 0xff, 0x73, 0x56, 0xd3, 0x3b, 0x4f, 0xce, 0x8a, 0x9f,
-	0x82, 0x2d, 0x83, 0xe5, 0xed, 0x7c, 0x44, 0x44, 0xc2, 0xc9, 0xa0, 0xb6, 0x86, 0x2e, 0x41, 0xd5,
-	0x5c, 0xd5, 0xef, 0xd3, 0xd8, 0xab, 0x59, 0x68, 0x1d, 0xc0, 0x0c, 0x3c, 0x8c, 0xbd, 0x5a, 0x4e,
-	0x02, 0x9e, 0xea, 0xd7, 0x81, 0x02, 0xe4, 0x25, 0xc0, 0x0c, 0x48, 0x80, 0xdd, 0xf9, 0x26, 0x07,
-	0x17, 0xd4, 0x71, 0x3f, 0xa1, 0xfc, 0x8c, 0x79, 0x14, 0x6d, 0x43, 0xfe, 0x88, 0xbe, 0x44, 0x57,
-	0x96, 0x1c, 0xda, 0xfa, 0x82, 0x8a, 0xe1, 0x35, 0x89, 0x7e, 0x44, 0x45, 0x06, 0x3d, 0xe3, 0xfe,
-	0x72, 0xf4, 0x31, 0x19, 0x65, 0xd0, 0x33, 0xae, 0x2f, 0x41, 0x77, 0xa0, 0xa8, 0xa9, 0x81, 0xae,
-	0x4d, 0x7d, 0x73, 0x5c, 0x59, 0x1a, 0x63, 0x2b, 0xfd, 0xbd, 0xba, 0xac, 0xfa, 0xf5, 0x05, 0x85,
-	0x93, 0x2e, 0xbc, 0xd6, 0xdd, 0xff, 0xe5, 0x55, 0xc3, 0xfa, 0xf5, 0x55, 0xc3, 0xfa, 0xed, 0x55,
-	0xc3, 0xfa, 0xf6, 0xf7, 0xc6, 0xda, 0x17, 0x9b, 0x2b, 0xdf, 0xc7, 0x73, 0x6f, 0xf1, 0x5e, 0x51,
-	0x3d, 0x8a, 0xef, 0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x02, 0xb5, 0xa9, 0xa3, 0x0b, 0x00,
-	0x00,
+	// 2310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x73, 0x1b, 0x49,
+	0x15, 0xcf, 0x68, 0x46, 0x5f, 0x4f, 0xb6, 0x23, 0x77, 0xcc, 0xee, 0xc4, 0x64, 0x2d, 0x6f, 0xef,
+	0x6e, 0x70, 0x20, 0x51, 0x12, 0xc5, 0xc9, 0x3a, 0x09, 0xd9, 0x22, 0x72, 0x3e, 0x48, 0x91, 0xcd,
+	0x9a, 0xf1, 0x86, 0xad, 0xa2, 0xa0, 0xa8, 0xf6, 0x4c, 0xc7, 0x9e, 0x8a, 0x34, 0xa3, 0xcc, 0xf4,
+	0x78, 0xad, 0x2b, 0xf0, 0x0f, 0x00, 0x97, 0xe5, 0xc2, 0x19, 0x0e, 0xdc, 0xa8, 0xe2, 0xc8, 0x11,
+	0x8e, 0xfc, 0x05, 0x2a, 0x2a, 0x54, 0x2d, 0x67, 0x74, 0xa1, 0x8a, 0x13, 0xd5, 0x1f, 0x33, 0xea,
+	0x91, 0x25, 0x47, 0xc8, 0xb5, 0x70, 0xb1, 0xe7, 0x75, 0xbf, 0xd7, 0xfd, 0xde, 0xeb, 0xf7, 0xf1,
+	0xeb, 0x16, 0x9c, 0x0f, 0x23, 0x8f, 0x46, 0x57, 0xc5, 0xdf, 0xde, 0x9e, 0xfc, 0xdf, 0xec, 0x45,
+	0x21, 0x0b, 0x51, 0x59, 0x0d, 0xae, 0x5e, 0xd9, 0xf7, 0xd9, 0x41, 0xb2, 0xd7, 0x74, 0xc3, 0xee,
+	0xd5, 0xfd, 0x70, 0x3f, 0xbc, 0x2a, 0xe6, 0xf7, 0x92, 0x17, 0x82, 0x12, 0x84, 0xf8, 0x92, 0x72,
+	0xab, 0x5b, 0x1a, 0xbb, 0xe7, 0xef, 0x87, 0x8c, 0xa4, 0xff, 0x7a, 0xa4, 0xdf, 0xa5, 0x01, 0x4b,
+	0xff, 0xf7, 0xf6, 0xd2, 0x2f, 0x29, 0x89, 0xff, 0x55, 0x86, 0xe2, 0x27, 0x7c, 0x53, 0xb4, 0x06,
+	0x05, 0xdf, 0xb3, 0x8d, 0x75, 0x63, 0xa3, 0xda, 0x5e, 0x1a, 0x0e, 0x1a, 0xb0, 0x17, 0x87, 0xc1,
+	0x1d, 0xfc, 0x13, 0xdf, 0xc3, 0x4e, 0xc1, 0xf7, 0xd0, 0x5b, 0x50, 0x22, 0xdd, 0x30, 0x09, 0x98,
+	0x5d, 0x58, 0x37, 0x36, 0x4c, 0x47, 0x51, 0xe8, 0x2a, 0x54, 0xdc, 0x24, 0x8a, 0x68, 0xe0, 0xf6,
+	0x6d, 0x73, 0xdd, 0xd8, 0x58, 0x6a, 0x9d, 0x6b, 0x66, 0xbb, 0x35, 0xb7, 0xd5, 0x94, 0x93, 0x31,
+	0xa1, 0x0d, 0x28, 0xfa, 0x8c, 0x76, 0x63, 0xdb, 0x5a, 0x37, 0x37, 0x6a, 0x2d, 0xd4, 0x54, 0x46,
+	0x37, 0x85, 0x1e, 0x4f, 0x18, 0xed, 0x3a, 0x92, 0x01, 0x6d, 0x41, 0xa5, 0x4b, 0x19, 0xf1, 0x08,
+	0x23, 0x76, 0x51, 0x30, 0x5f, 0xc8, 0x33, 0x37, 0x3f, 0x56, 0xd3, 0x0f, 0x03, 0x16, 0xf5, 0x9d,
+	0x8c, 0x1b, 0xad, 0x40, 0x91, 0x76, 0x89, 0xdf, 0xb1, 0x4b, 0xdc, 0x1e, 0x47, 0x12, 0x68, 0x15,
+	0x2a, 0xee, 0x01, 0x89, 0xf6, 0xe9, 0x13, 0xcf, 0x2e, 0x8b, 0x89, 0x8c, 0x46, 0x57, 0xa0, 0xb4,
+	0xcb, 0x08, 0x4b, 0x62, 0xbb, 0x22, 0x8c, 0xf8, 0xda, 0xd8, 0x4e, 0xb1, 0x98, 0x74, 0x14, 0x13,
+	0xba, 0x02, 0x95, 0xf8, 0xc0, 0xef, 0xf5, 0xfc, 0x60, 0xdf, 0xae, 0xae, 0x1b, 0x1b, 0xb5, 0xd6,
+	0x72, 0x26, 0xb0, 0xab, 0x26, 0x9c, 0x8c, 0x05, 0xdd, 0x86, 0x05, 0x97, 0x04, 0x2e, 0xed, 0x38,
+	0x94, 0xc4, 0x61, 0x60, 0xc3, 0xd8, 0x1e, 0xdb, 0xda, 0xa4, 0x93, 0x63, 0x45, 0x4d, 0x28, 0x47,
+	0x94, 0x25, 0x51, 0x10, 0xdb, 0x35, 0xe1, 0x83, 0x95, 0xbc, 0x66, 0x8e, 0x98, 0x74, 0x52, 0x26,
+	0x64, 0x43, 0x99, 0x1e, 0xf5, 0xfc, 0x88, 0xc6, 0xf6, 0x82, 0x38, 0xa8, 0x94, 0xe4, 0x33, 0x6e,
+	0x98, 0xf4, 0xc2, 0x20, 0xb6, 0x17, 0xd7, 0xcd, 0x8d, 0xaa, 0x93, 0x92, 0xe8, 0x22, 0x14, 0x19,
+	0x39, 0xa2, 0xb1, 0xbd, 0x24, 0x76, 0xa8, 0x67, 0x3b, 0x7c, 0x4a, 0x8e, 0x9e, 0xfa, 0x01, 0x75,
+	0xe4, 0x34, 0xc2, 0xb0, 0x90, 0x9a, 0xe4, 0x10, 0x46, 0xed, 0xb3, 0xc2, 0x89, 0xb9, 0x31, 0x74,
+	0x17, 0x16, 0xe9, 0x91, 0x7b, 0x40, 0x82, 0x7d, 0xca, 0xe9, 0xd8, 0xae, 0x8b, 0x35, 0x47, 0xb6,
+	0x3e, 0xd4, 0x66, 0x9d, 0x3c, 0x2f, 0xda, 0x82, 0x85, 0x17, 0x49, 0xe7, 0x85, 0xdf, 0xe9, 0xf0,
+	0xf8, 0x89, 0xed, 0xe5, 0x31, 0x8b, 0x1f, 0x8d, 0x26, 0x9d, 0x1c, 0x27, 0xfa, 0x16, 0x94, 0xe8,
+	0xa1, 0x90, 0x41, 0x42, 0xe6, 0x5c, 0xde, 0x4b, 0x0f, 0xf9, 0x9c, 0xa3, 0x58, 0xd0, 0x1a, 0x80,
+	0x9b, 0xc4, 0x2c, 0xec, 0xd2, 0xe8, 0x89, 0x67, 0x9f, 0x13, 0x56, 0x68, 0x23, 0x3c, 0xd6, 0xdd,
+	0x88, 0x7a, 0x3e, 0xb3, 0x57, 0x64, 0xac, 0x4b, 0x0a, 0x9d, 0x87, 0xb2, 0x1b, 0x51, 0xc2, 0xa8,
+	0x67, 0x7f, 0x59, 0x96, 0xce, 0x55, 0x34, 0x9f, 0x4a, 0x7a, 0x9e, 0x98, 0xfa, 0x87, 0x9a, 0x52,
+	0xf4, 0xea, 0x5d, 0x58, 0xcc, 0xc5, 0x29, 0xaa, 0x83, 0xf9, 0x92, 0xf6, 0x65, 0xae, 0x39, 0xfc,
+	0x93, 0xc7, 0xeb, 0x21, 0xe9, 0x24, 0x54, 0xe4, 0x56, 0xd5, 0x91, 0xc4, 0x9d, 0xc2, 0x96, 0x81,
+	0x3f, 0x83, 0x92, 0x0c, 0x3d, 0x54, 0x83, 0xf2, 0xb6, 0xdc, 0xac, 0x7e, 0x06, 0x55, 0xc0, 0xda,
+	0x21, 0xbe, 0x57, 0x37, 0xd0, 0x02, 0x54, 0x64, 0xf4, 0x50, 0xaf, 0x5e, 0x40, 0x8b, 0x50, 0x55,
+	0x3e, 0xa2, 0x5e, 0xdd, 0xe4, 0x93, 0x32, 0x3e, 0xa8, 0x57, 0xb7, 0xf8, 0x0a, 0x0f, 0x45, 0x2c,
+	0x78, 0xf5, 0x22, 0xfe, 0xad, 0x09, 0xd5, 0x2c, 0xe3, 0xd0, 0x0e, 0x58, 0xac, 0xdf, 0xa3, 0x42,
+	0xa7, 0xa5, 0xd6, 0xdb, 0xc7, 0x73, 0xb2, 0xf9, 0x69, 0xbf, 0x47, 0xdb, 0xef, 0x0d, 0x07, 0x8d,
+	0xc6, 0x21, 0xe9, 0xf8, 0xdc, 0xb2, 0x3b, 0x38, 0xa2, 0xaf, 0x12, 0xbe, 0xdc, 0xe5, 0x7d, 0x46,
+	0xef, 0x5d, 0xbf, 0xdc, 0x61, 0xf4, 0xde, 0x26, 0x76, 0xc4, 0x4a, 0xe8, 0x0e, 0x54, 0x5e, 0x25,
+	0x24, 0x60, 0x3e, 0xeb, 0xcb, 0x8a, 0xd1, 0x5e, 0x1b, 0x0e, 0x1a, 0xab, 0x23, 0xe1, 0xb0, 0xcb,
+	0xb3, 0xbc, 0xc7, 0xfa, 0x42, 0xfa, 0x1a, 0x76, 0x32, 0x7e, 0xad, 0xd6, 0x98, 0xb9, 0x5a, 0xf3,
+	0x99, 0x56, 0x6b, 0xac, 0xa9, 0xb5, 0xa6, 0x7d, 0x71, 0x38, 0x68, 0xe0, 0x69, 0x1b, 0x49, 0x35,
+	0xaf, 0xb7, 0xb6, 0xb0, 0x56, 0x93, 0x6e, 0x41, 0xa9, 0x47, 0x22, 0x1a, 0x30, 0xbb, 0x28, 0x0a,
+	0xe0, 0x54, 0x55, 0x93, 0xc4, 0xf7, 0x36, 0xb1, 0xa3, 0xb8, 0xd1, 0x3a, 0xd4, 0x3c, 0x1a, 0xbb,
+	0x91, 0xdf, 0x63, 0x7e, 0x18, 0xa8, 0x6a, 0xa3, 0x0f, 0xe1, 0x36, 0x58, 0xdc, 0x73, 0xfc, 0x24,
+	0x22, 0x1a, 0xd3, 0xe8, 0x50, 0x1c, 0x5f, 0x19, 0xcc, 0xf8, 0x65, 0x22, 0x4f, 0xcf, 0xf3, 0x63,
+	0x97, 0x5b, 0x57, 0x2f, 0xf0, 0x61, 0x46, 0x8e, 0xe4, 0xb9, 0xa5, 0x49, 0x55, 0xb7, 0xf0, 0x2e,
+	0x2c, 0xe8, 0x49, 0x93, 0x2b, 0xb9, 0xc6, 0x2c, 0x25, 0x17, 0x81, 0x15, 0xf1, 0x7c, 0xe5, 0xe7,
+	0x60, 0x38, 0xe2, 0x1b, 0xff, 0xda, 0x80, 0xb2, 0x4a, 0x6f, 0xee, 0x6f, 0x65, 0xbe, 0x8c, 0xc9,
+	0xd4, 0x3c, 0x04, 0x56, 0x40, 0xba, 0x69, 0x54, 0x8a, 0xef, 0x6c, 0x2d, 0x79, 0x32, 0xe2, 0x1b,
+	0x5d, 0x80, 0xaa, 0x1f, 0xb8, 0x9d, 0x24, 0xf6, 0x0f, 0xa9, 0x38, 0x98, 0x8a, 0x33, 0x1a, 0xe0,
+	0x75, 0x87, 0x91, 0x23, 0xb2, 0xd7, 0xa1, 0xc2, 0xbb, 0xa6, 0x93, 0x92, 0xda, 0x39, 0x97, 0xf4,
+	0x73, 0xc6, 0x7f, 0x2e, 0x40, 0x25, 0xad, 0xa2, 0x99, 0x12, 0x86, 0xa6, 0xc4, 0x0a, 0x14, 0x7b,
+	0x07, 0x61, 0x90, 0xe5, 0x8b, 0x20, 0xd0, 0x0d, 0x28, 0x13, 0xcf, 0x8b, 0x68, 0x1c, 0x0b, 0xed,
+	0x6a, 0xad, 0xf3, 0xc7, 0x6a, 0x72, 0xf3, 0xbe, 0x64, 0x70, 0x52, 0x4e, 0x51, 0x15, 0x49, 0x14,
+	0xf9, 0x34, 0x12, 0x9a, 0x57, 0x9d, 0x94, 0x44, 0x17, 0x61, 0x89, 0x45, 0xc4, 0x7d, 0xe9, 0x07,
+	0xfb, 0xcf, 0x92, 0xee, 0x1e, 0x8d, 0x64, 0x70, 0x38, 0x63, 0xa3, 0xab, 0xdc, 0x93, 0x6a, 0x59,
+	0xae, 0x58, 0xc7, 0x0f, 0xe8, 0x75, 0xa5, 0xad, 0x24, 0xb8, 0x09, 0x6e, 0x9a, 0x07, 0x55, 0x47,
+	0x7c, 0xab, 0x6a, 0xcc, 0xeb, 0x81, 0x6d, 0xaa, 0x7d, 0x25, 0x99, 0xae, 0xd1, 0x52, 0xfa, 0x48,
+	0x82, 0xd7, 0xac, 0x5e, 0x18, 0x33, 0xd2, 0xd9, 0x0e, 0x3d, 0xaa, 0x34, 0xd1, 0x46, 0xb8, 0x14,
+	0x2f, 0x14, 0x34, 0x6d, 0x79, 0x82, 0xc0, 0x5f, 0x18, 0x00, 0xb2, 0x02, 0x88, 0x34, 0xbf, 0x99,
+	0x3f, 0xe8, 0xf6, 0x3b, 0xc3, 0x41, 0xe3, 0xfc, 0x84, 0x7c, 0x1e, 0x0b, 0xf3, 0xdb, 0xc7, 0x72,
+	0x79, 0x9a, 0xa0, 0xc8, 0x30, 0x3d, 0x95, 0x6d, 0xde, 0xbe, 0x62, 0x16, 0xba, 0x2f, 0x85, 0x99,
+	0x15, 0x27, 0x25, 0xf1, 0x1f, 0x0d, 0xa8, 0x69, 0x1d, 0x0c, 0x5d, 0x4a, 0x71, 0x81, 0x31, 0x56,
+	0xc0, 0x47, 0xfa, 0xa7, 0xc0, 0x60, 0x3a, 0x16, 0x29, 0x45, 0xb2, 0xc1, 0x9a, 0xaa, 0x8e, 0x8d,
+	0xd2, 0xc2, 0xa1, 0x2f, 0x92, 0xc0, 0x53, 0x2d, 0x56, 0xb1, 0x71, 0x44, 0x10, 0x89, 0xf1, 0x27,
+	0x9e, 0xf2, 0x76, 0x46, 0x8b, 0x03, 0x52, 0xc5, 0xbe, 0x98, 0xab, 0xf5, 0xf8, 0x67, 0x06, 0x9c,
+	0xd5, 0x3a, 0xd1, 0xff, 0xc7, 0xb3, 0xf8, 0x77, 0x06, 0xd4, 0x34, 0x2d, 0xd0, 0xd2, 0x08, 0xc0,
+	0x09, 0xc0, 0xd6, 0x4c, 0xfd, 0x59, 0x10, 0xfe, 0xb4, 0x27, 0x35, 0x51, 0xdd, 0xa9, 0x5a, 0x22,
+	0x98, 0x6f, 0x4a, 0x04, 0x6b, 0x52, 0x22, 0x9c, 0xe0, 0xb1, 0x3f, 0x14, 0x00, 0x46, 0x7d, 0x18,
+	0x5d, 0xce, 0x75, 0x1b, 0x7b, 0x42, 0xab, 0x16, 0xed, 0x46, 0x75, 0x12, 0x1b, 0xca, 0x5d, 0x1a,
+	0xc7, 0x64, 0x3f, 0x4d, 0xf7, 0x94, 0xe4, 0x31, 0x4f, 0x5c, 0x16, 0xa6, 0x0a, 0x4b, 0x42, 0x57,
+	0xc3, 0xca, 0xab, 0xf1, 0x7b, 0x63, 0x54, 0x8d, 0x9d, 0x51, 0x35, 0xae, 0x80, 0xf5, 0x2c, 0x64,
+	0xb4, 0x6e, 0xe8, 0x3d, 0xb6, 0xc0, 0x89, 0xe7, 0xb2, 0x85, 0xd7, 0xcd, 0xac, 0xe1, 0x5a, 0xf9,
+	0x16, 0x5b, 0xcc, 0xb5, 0xd8, 0x12, 0xaa, 0xc3, 0x82, 0x0c, 0xb4, 0x47, 0xc4, 0xe7, 0xf3, 0x65,
+	0xb4, 0x0c, 0x8b, 0xdb, 0xa4, 0xc7, 0x92, 0x88, 0xaa, 0xa1, 0x4a, 0xae, 0x65, 0x57, 0xf5, 0xae,
+	0x0c, 0x7c, 0xea, 0x01, 0x61, 0xe4, 0x69, 0x18, 0xc7, 0xf5, 0x1a, 0x7e, 0xa2, 0x5a, 0xf4, 0x53,
+	0x3f, 0x66, 0xe8, 0x22, 0x94, 0x84, 0x9f, 0xd2, 0x04, 0x59, 0x1a, 0xc3, 0x81, 0x6a, 0x96, 0x3b,
+	0x85, 0x85, 0x8c, 0x74, 0x84, 0xb3, 0x8a, 0x8e, 0x24, 0xf0, 0x3f, 0x8b, 0x00, 0xcf, 0xe8, 0xe7,
+	0x0e, 0x7d, 0x95, 0xd0, 0x98, 0xa1, 0x1f, 0xcc, 0xd4, 0x42, 0xda, 0x1f, 0x0c, 0x07, 0x8d, 0x77,
+	0x4f, 0xec, 0xf7, 0x63, 0x8d, 0x74, 0x37, 0x1f, 0x74, 0x13, 0xc0, 0x7d, 0xfb, 0xd2, 0x70, 0xd0,
+	0xf8, 0x40, 0x5e, 0x2e, 0x04, 0x2b, 0x5e, 0x1f, 0x6d, 0xe0, 0xf9, 0x87, 0xf4, 0x72, 0xba, 0x0b,
+	0x4e, 0x23, 0xf3, 0x9e, 0x76, 0x0f, 0x30, 0xc5, 0xba, 0xef, 0x66, 0xeb, 0x8e, 0x6c, 0x9a, 0x7a,
+	0x19, 0xd8, 0x4c, 0x2f, 0x03, 0xd6, 0xc9, 0xbd, 0x5d, 0x30, 0xe1, 0xf4, 0xb2, 0xf0, 0x54, 0x43,
+	0xf8, 0xc5, 0x29, 0x08, 0x7f, 0x3c, 0x59, 0x47, 0x6b, 0x71, 0x43, 0xb0, 0x76, 0x01, 0xb8, 0x06,
+	0x26, 0x63, 0xf2, 0x3a, 0xf2, 0x66, 0x20, 0xc4, 0x59, 0xd1, 0xad, 0x11, 0x5a, 0x2f, 0x73, 0xb4,
+	0xde, 0xbe, 0x30, 0x1c, 0x34, 0xec, 0xa9, 0xae, 0x4a, 0x99, 0x51, 0x7b, 0x0c, 0xa3, 0x57, 0x66,
+	0x02, 0x34, 0x39, 0x19, 0xf4, 0x5d, 0x58, 0xf2, 0x3d, 0xda, 0xed, 0x85, 0x8c, 0x1f, 0xea, 0xf7,
+	0x68, 0x5f, 0xdc, 0x71, 0xaa, 0xed, 0xf5, 0xe1, 0xa0, 0x71, 0x61, 0xd2, 0x2a, 0x5d, 0x72, 0x74,
+	0xaf, 0x75, 0xf3, 0x26, 0x76, 0xc6, 0xe4, 0xd0, 0x47, 0x39, 0xa4, 0x0d, 0x33, 0xe9, 0xa2, 0x49,
+	0x9c, 0x0e, 0x3b, 0xff, 0xbc, 0x00, 0x2b, 0xbb, 0x9a, 0x5d, 0xf1, 0x57, 0x1d, 0xfd, 0x8f, 0xde,
+	0x1c, 0xfd, 0x27, 0x9f, 0xa2, 0x0a, 0x78, 0x3d, 0xf6, 0xcc, 0xd3, 0xc6, 0x1e, 0xfe, 0x8d, 0x01,
+	0x8b, 0xa9, 0xd4, 0xf7, 0x93, 0x90, 0xfd, 0x77, 0x78, 0x6f, 0x7a, 0x5b, 0x18, 0x75, 0x61, 0x6b,
+	0xea, 0x8b, 0x40, 0x71, 0x06, 0x78, 0x8a, 0xb7, 0x61, 0x39, 0xa7, 0x9f, 0x28, 0x77, 0x4d, 0x28,
+	0xbd, 0xe2, 0x44, 0x5a, 0xee, 0xde, 0x3a, 0xe6, 0x01, 0xc1, 0xeb, 0x28, 0x2e, 0x7c, 0x17, 0xe0,
+	0x31, 0x65, 0xe9, 0x09, 0x5f, 0xd1, 0x5e, 0x33, 0xc6, 0x1c, 0x25, 0xa2, 0x4c, 0xf3, 0x79, 0xc1,
+	0xf7, 0xf0, 0x4f, 0x4d, 0x80, 0x1d, 0xd2, 0x9f, 0x4f, 0x1a, 0xb5, 0xc1, 0x72, 0x49, 0xe4, 0x09,
+	0xb7, 0xd5, 0x5a, 0x67, 0x75, 0x63, 0x49, 0xe4, 0xbd, 0xe9, 0xa0, 0x84, 0x2c, 0x0a, 0x61, 0x59,
+	0x89, 0xed, 0x44, 0xe1, 0xa1, 0xef, 0x89, 0x7c, 0x91, 0x28, 0xe6, 0x82, 0xb6, 0xe0, 0xce, 0x38,
+	0xcf, 0x0c, 0x57, 0xb2, 0xeb, 0xd8, 0x39, 0xbe, 0xf6, 0x84, 0x1c, 0xb7, 0xe6, 0xcc, 0xf1, 0x47,
+	0xb0, 0xa8, 0x96, 0xff, 0x98, 0xb2, 0x83, 0xd0, 0xb3, 0x8b, 0x33, 0x2e, 0x94, 0x17, 0xc3, 0x03,
+	0x03, 0x16, 0xd5, 0x6b, 0xc6, 0x7c, 0xe7, 0xf0, 0x38, 0x9f, 0x7e, 0x93, 0x10, 0xe4, 0x6c, 0xf9,
+	0xf7, 0x7c, 0x46, 0x1c, 0xd9, 0x7e, 0x7f, 0x38, 0x68, 0xac, 0x4f, 0xad, 0xe4, 0xc2, 0xfb, 0x37,
+	0x70, 0x8a, 0x36, 0xf1, 0x9f, 0x0c, 0x58, 0x52, 0xd0, 0x61, 0x4e, 0x0b, 0x3f, 0x1c, 0x25, 0x63,
+	0x61, 0x92, 0x8c, 0xae, 0x00, 0xef, 0x24, 0x59, 0xae, 0x3e, 0x3c, 0x06, 0xe1, 0xcc, 0x59, 0xe4,
+	0xc7, 0x84, 0xf0, 0x2f, 0x0a, 0x80, 0xf4, 0x37, 0x98, 0xf9, 0xac, 0x78, 0x3a, 0x23, 0x32, 0x9d,
+	0xed, 0xb0, 0x3e, 0x1c, 0x2b, 0x50, 0xa7, 0xf0, 0x89, 0x35, 0x8f, 0x4f, 0x22, 0xa8, 0x71, 0xf4,
+	0x38, 0xa7, 0x2f, 0x36, 0xc1, 0x0a, 0x42, 0x75, 0x35, 0x3f, 0x96, 0x33, 0x59, 0x32, 0xf3, 0x94,
+	0xd9, 0xbc, 0x76, 0xfb, 0x16, 0x76, 0x04, 0x37, 0xfe, 0x95, 0x01, 0x8b, 0x12, 0x42, 0xce, 0xb9,
+	0xed, 0x6e, 0x16, 0xe1, 0x85, 0x13, 0x9e, 0x22, 0x67, 0x89, 0xef, 0xcd, 0x51, 0x7c, 0xff, 0xd2,
+	0x82, 0x45, 0x89, 0x98, 0xe7, 0xd6, 0xea, 0x2b, 0x40, 0x8f, 0x19, 0xfc, 0x33, 0xe7, 0x85, 0x7f,
+	0xd6, 0xa9, 0xe1, 0x9f, 0x06, 0xe6, 0x8a, 0xa7, 0x01, 0x73, 0xa5, 0x39, 0xc0, 0xdc, 0x77, 0x34,
+	0xf4, 0x5c, 0x16, 0x7e, 0x7d, 0x3f, 0xb3, 0x24, 0x77, 0x5a, 0xd3, 0x00, 0xf4, 0xe9, 0x40, 0xd8,
+	0xbf, 0x4d, 0xa8, 0xf1, 0x86, 0x9e, 0x86, 0xc4, 0x5d, 0xb0, 0x7a, 0xfc, 0x2a, 0x67, 0x08, 0x28,
+	0xfc, 0x8d, 0xe1, 0xa0, 0xf1, 0xde, 0x24, 0x53, 0x72, 0x7d, 0xec, 0x1a, 0x76, 0x84, 0x10, 0xfa,
+	0x36, 0x7f, 0x1a, 0xe9, 0xfa, 0xea, 0xde, 0x3f, 0xfd, 0xa1, 0x4f, 0x93, 0xe6, 0xc2, 0x52, 0x08,
+	0xfd, 0x08, 0xac, 0x38, 0x8c, 0x98, 0x2a, 0xea, 0xa3, 0xc7, 0x21, 0x4d, 0xbd, 0xe6, 0x6e, 0x18,
+	0xb1, 0xf6, 0x95, 0xe1, 0xa0, 0x71, 0xe9, 0xcd, 0x5a, 0x65, 0x0f, 0x9e, 0x7c, 0x55, 0xf4, 0x91,
+	0xe6, 0x67, 0xf9, 0xd3, 0x06, 0x9e, 0xb8, 0xc3, 0xb4, 0x6b, 0x4a, 0x1e, 0x2a, 0x17, 0xff, 0xb7,
+	0x50, 0xf9, 0x39, 0x58, 0xdc, 0x72, 0x7e, 0x19, 0x7d, 0x46, 0x58, 0x12, 0x91, 0x4e, 0xfd, 0x0c,
+	0x3a, 0x0b, 0x35, 0x75, 0x1b, 0x7e, 0x40, 0x63, 0xb7, 0x6e, 0xa0, 0x25, 0x00, 0x35, 0x70, 0x3f,
+	0x76, 0xeb, 0x05, 0xce, 0xa0, 0x6e, 0xc8, 0x82, 0xc1, 0xe4, 0x0c, 0x6a, 0x80, 0x33, 0x58, 0xdf,
+	0xfc, 0x31, 0x2c, 0xe8, 0xf5, 0x84, 0xdf, 0x9d, 0xef, 0xef, 0x91, 0xc0, 0x0b, 0x03, 0x71, 0xf1,
+	0x7e, 0x1b, 0xce, 0x29, 0xaf, 0x50, 0xaf, 0xdd, 0xdf, 0x56, 0xb6, 0xd4, 0x0d, 0x54, 0x85, 0xe2,
+	0xa3, 0x88, 0x24, 0xea, 0x45, 0xfb, 0x41, 0xd2, 0xeb, 0xf8, 0x2e, 0x61, 0xb4, 0x6e, 0xea, 0xb7,
+	0x65, 0xab, 0xf5, 0xa5, 0x05, 0x0b, 0xa2, 0x34, 0xec, 0xd2, 0xe8, 0xd0, 0x77, 0x29, 0xba, 0x0c,
+	0xe6, 0x33, 0xfa, 0x39, 0x3a, 0x37, 0xe1, 0x7a, 0xb8, 0x3a, 0x76, 0x5f, 0xc6, 0x67, 0x38, 0xf7,
+	0x63, 0xca, 0x34, 0xee, 0x11, 0x80, 0x9c, 0xcc, 0xbd, 0x43, 0xfa, 0x1a, 0xf7, 0x08, 0x30, 0x4e,
+	0xe0, 0x6e, 0x41, 0x49, 0xbd, 0x6b, 0xbd, 0x35, 0x06, 0x43, 0x4e, 0x92, 0xb1, 0x04, 0xf4, 0x5d,
+	0x99, 0x14, 0x37, 0xab, 0x63, 0xd5, 0x90, 0x4f, 0xe1, 0x33, 0x68, 0x13, 0xca, 0xaa, 0x6b, 0xa2,
+	0xb7, 0xc7, 0xfb, 0xe8, 0x89, 0xda, 0xc9, 0x73, 0xd1, 0xb4, 0xcb, 0xf5, 0x93, 0xc9, 0x32, 0xf2,
+	0x6c, 0x35, 0x99, 0x5c, 0xfd, 0x98, 0x20, 0xf3, 0x09, 0xd4, 0x1f, 0x53, 0x96, 0xbb, 0x83, 0xa1,
+	0x77, 0x8e, 0xff, 0x50, 0xa6, 0xdd, 0xcd, 0x56, 0x57, 0x27, 0xe3, 0x7c, 0x65, 0x6e, 0x1b, 0x96,
+	0x65, 0x04, 0xea, 0x2f, 0x5f, 0x5f, 0x9f, 0xf8, 0xfb, 0xd0, 0x54, 0xa5, 0xae, 0x8b, 0xe7, 0x5a,
+	0xde, 0xb3, 0x35, 0x4f, 0x6b, 0x2d, 0xfc, 0xb8, 0x48, 0x7b, 0xeb, 0x2f, 0xaf, 0xd7, 0x8c, 0xbf,
+	0xbe, 0x5e, 0x33, 0xfe, 0xf6, 0x7a, 0xcd, 0xf8, 0xe2, 0xef, 0x6b, 0x67, 0x7e, 0x78, 0x71, 0xea,
+	0x4f, 0xae, 0xb9, 0x9f, 0x77, 0xf7, 0x4a, 0xe2, 0x77, 0xd6, 0x1b, 0xff, 0x19, 0x00, 0xb8, 0x02,
+	0x65, 0x50, 0xf6, 0x1d, 0x00, 0x00,
 }
@@ -0,0 +1,67 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"fmt"
+	"github.com/digota/digota/client"
+	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/storage"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"time"
+)
+
+// addEvent appends an event to the order timeline, the client of ctx is
+// recorded as its actor. the order is updated but not saved.
+func (o *order) addEvent(ctx context.Context, t orderpb.OrderEvent_Type, format string, a ...interface{}) {
+	e := &orderpb.OrderEvent{
+		Type:    t,
+		Message: fmt.Sprintf(format, a...),
+		Created: time.Now().Unix(),
+	}
+	if c, ok := client.FromContext(ctx); ok {
+		e.Actor = c.Serial
+	}
+	o.Events = append(o.Events, e)
+}
+
+// saveEvent records an event of a change of the locked order that could not
+// be completed, the order is loaded again so nothing else of the change is
+// saved. errors are only logged since the failure is returned anyway.
+func (o *order) saveEvent(ctx context.Context, t orderpb.OrderEvent_Type, format string, a ...interface{}) {
+	r := &order{
+		Order: orderpb.Order{
+			Id: o.GetId(),
+		},
+		fence: o.fence,
+	}
+	if err := storage.Handler().One(r); err != nil {
+		log.Errorf("Could not load order %s to save %s event => %s", o.GetId(), t, err.Error())
+		return
+	}
+	r.addEvent(ctx, t, format, a...)
+	if err := storage.Handler().Update(r); err != nil {
+		log.Errorf("Could not save order %s %s event => %s", o.GetId(), t, err.Error())
+	}
+}
@@ -0,0 +1,51 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"github.com/digota/digota/exchange"
+	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/payment/paymentpb"
+)
+
+// convertItems converts the items amounts into currency and returns the
+// applied exchange rates, one per converted currency
+func convertItems(currency paymentpb.Currency, items []*orderpb.OrderItem) (rates []*orderpb.ExchangeRate, err error) {
+	applied := make(map[paymentpb.Currency]bool)
+	for _, v := range items {
+		if v.GetCurrency() == currency {
+			continue
+		}
+		from := v.GetCurrency()
+		amount, rate, err := exchange.Convert(v.GetAmount(), from, currency)
+		if err != nil {
+			return nil, err
+		}
+		v.Amount, v.Currency = amount, currency
+		if !applied[from] {
+			applied[from] = true
+			rates = append(rates, &orderpb.ExchangeRate{Currency: from, Rate: rate})
+		}
+	}
+	return rates, nil
+}
@@ -0,0 +1,137 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"time"
+
+	"github.com/digota/digota/locker"
+	orderInterface "github.com/digota/digota/order"
+	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/storage"
+	"github.com/digota/digota/storage/object"
+	"github.com/digota/digota/util"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sweepBatch is the max number of orders expired by a single sweep
+const sweepBatch = 500
+
+// sweeper is the lock object of the expiry sweeper, the node holding the
+// lock is the only one sweeping
+type sweeper struct{}
+
+// implements object.Interface interface
+func (s *sweeper) GetNamespace() string { return ns }
+
+// implements object.Interface interface
+func (s *sweeper) GetId() string { return "sweeper" }
+
+// Expire implements the order.Expirer interface.
+// Moves the created orders that passed their expiry time to expired status
+// and releases their reservations, returns the number of expired orders.
+func (s *orderService) Expire(ctx context.Context) (int, error) {
+	// only one node sweeps at a time
+	unlock, err := locker.Handler().TryLockContext(ctx, &sweeper{}, locker.DefaultTimeout)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	now := time.Now()
+	created := object.Filter{Field: "status", Op: object.OpEq, Value: orderpb.Order_Created}
+
+	var n int
+	for _, filters := range [][]object.Filter{
+		{
+			created,
+			{Field: "expires", Op: object.OpGt, Value: 0},
+			{Field: "expires", Op: object.OpLt, Value: now.Unix()},
+		},
+		// orders created before the expiry was saved
+		{
+			created,
+			{Field: "expires", Op: object.OpEq, Value: nil},
+			{Field: "created", Op: object.OpLt, Value: now.Add(-orderInterface.TTL()).Unix()},
+		},
+	} {
+		slice := orders{}
+		if _, err := storage.Handler().List(&slice, object.ListOpt{
+			Limit:   sweepBatch,
+			Sort:    object.SortCreatedAsc,
+			Filters: filters,
+		}); err != nil {
+			return n, err
+		}
+		for _, v := range slice {
+			if err := s.expire(ctx, v.GetId()); err != nil {
+				log.Warnf("Could not expire order %s => %s", v.GetId(), err.Error())
+				continue
+			}
+			n++
+		}
+	}
+	return n, nil
+}
+
+// expire moves the order to expired status and releases its reservations
+func (s *orderService) expire(ctx context.Context, id string) error {
+	// order wrapper
+	o := &order{
+		Order: orderpb.Order{
+			Id: id,
+		},
+	}
+	// lock order
+	unlock, err := locker.Handler().TryLockContext(ctx, o, locker.DefaultTimeout)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// get order, it may have changed since listed
+	if err := storage.Handler().One(o); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	next, err := o.next(expireAction)
+	if err != nil {
+		return err
+	}
+	if time.Now().Before(o.expiresAt()) {
+		return status.Error(codes.FailedPrecondition, "Order is not expired yet.")
+	}
+	// release the reserved items back to stock
+	if err := o.reserve(ctx, o.Items, nil); err != nil {
+		return err
+	}
+	o.Status = next
+	o.CancelReason = orderpb.CancelReason_Expired
+	o.addEvent(ctx, orderpb.OrderEvent_Expired, "Order expired.")
+	// update order with retries
+	return util.Retry(func() error {
+		return storage.Handler().Update(o)
+	})
+}
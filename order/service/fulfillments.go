@@ -0,0 +1,149 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"github.com/digota/digota/order/orderpb"
+	"github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// fulfilledQuantities returns the quantity shipped so far of every sku
+func (o *order) fulfilledQuantities() map[string]int64 {
+	m := make(map[string]int64)
+	for _, f := range o.GetFulfillments() {
+		for _, v := range f.GetItems() {
+			m[v.GetParent()] += v.GetQuantity()
+		}
+	}
+	return m
+}
+
+// unfulfilledQuantities returns the quantity of every sku that was neither
+// shipped nor returned yet
+func (o *order) unfulfilledQuantities() map[string]int64 {
+	fulfilled, returned := o.fulfilledQuantities(), o.returnedQuantities()
+	left := make(map[string]int64)
+	for _, v := range o.GetItems() {
+		if v.GetType() == orderpb.OrderItem_sku {
+			left[v.GetParent()] = v.GetQuantity() - fulfilled[v.GetParent()] - returned[v.GetParent()]
+		}
+	}
+	return left
+}
+
+// fulfillmentItems merges the requested items and checks them against the
+// quantities left to ship, no items means everything that is left.
+// returns the items and whether nothing will be left to ship after them.
+func (o *order) fulfillmentItems(reqItems []*orderpb.FulfillmentItem) ([]*orderpb.FulfillmentItem, bool, error) {
+	left := o.unfulfilledQuantities()
+
+	var items []*orderpb.FulfillmentItem
+
+	if len(reqItems) == 0 {
+		for _, v := range o.GetItems() {
+			if v.GetType() == orderpb.OrderItem_sku && left[v.GetParent()] > 0 {
+				items = append(items, &orderpb.FulfillmentItem{Parent: v.GetParent(), Quantity: left[v.GetParent()]})
+			}
+		}
+		return items, true, nil
+	}
+
+	merged := make(map[string]*orderpb.FulfillmentItem)
+	for _, v := range reqItems {
+		if item, ok := merged[v.GetParent()]; ok {
+			item.Quantity += v.GetQuantity()
+			continue
+		}
+		item := &orderpb.FulfillmentItem{Parent: v.GetParent(), Quantity: v.GetQuantity()}
+		merged[v.GetParent()] = item
+		items = append(items, item)
+	}
+
+	for _, v := range items {
+		if v.GetQuantity() > left[v.GetParent()] {
+			return nil, false, status.Errorf(codes.FailedPrecondition, "Item %s has only %d left to fulfill.", v.GetParent(), left[v.GetParent()])
+		}
+		left[v.GetParent()] -= v.GetQuantity()
+	}
+
+	return items, isCovered(left), nil
+}
+
+// isCovered returns whether no quantity is left in left
+func isCovered(left map[string]int64) bool {
+	for _, v := range left {
+		if v > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// fulfill records a shipment of the requested items of the locked order,
+// the order is fulfilled and its charge is captured once all the items are
+// covered. the order is updated but not saved.
+func (o *order) fulfill(ctx context.Context, reqItems []*orderpb.FulfillmentItem, carrier, trackingNumber string) error {
+	// check the order status before looking at the items
+	if _, err := o.next(fulfillAction); err != nil {
+		return err
+	}
+	// items to ship
+	items, last, err := o.fulfillmentItems(reqItems)
+	if err != nil {
+		return err
+	}
+	// the order is fulfilled once nothing is left to ship
+	a := partialFulfillAction
+	if last {
+		a = fulfillAction
+	}
+	next, err := o.next(a)
+	if err != nil {
+		return err
+	}
+	// charge the order
+	if last {
+		if err := capture(ctx, &o.Order); err != nil {
+			o.saveEvent(ctx, orderpb.OrderEvent_CaptureFailed, "Capture failed => %s", err.Error())
+			return err
+		}
+	}
+	o.Status = next
+	o.Fulfillments = append(o.Fulfillments, &orderpb.Fulfillment{
+		Id:             uuid.NewV4().String(),
+		Items:          items,
+		Carrier:        carrier,
+		TrackingNumber: trackingNumber,
+		Created:        time.Now().Unix(),
+	})
+	var n int64
+	for _, v := range items {
+		n += v.GetQuantity()
+	}
+	o.addEvent(ctx, orderpb.OrderEvent_Fulfilled, "Shipped %d items by %q, tracking number %q.", n, carrier, trackingNumber)
+	return nil
+}
@@ -0,0 +1,149 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/outbox"
+	"github.com/digota/digota/payment"
+	"github.com/digota/digota/payment/paymentpb"
+	"github.com/digota/digota/storage"
+	"github.com/digota/digota/util"
+	"golang.org/x/net/context"
+)
+
+// payEntry is the outbox entry kind of order payments that are not saved yet
+const payEntry = "order.pay"
+
+// Orders are paid in two phases, the amount is authorized at pay and
+// captured at fulfill. items returned before fulfill are left out of the
+// capture, orders canceled before fulfill void the authorization. charges
+// of orders paid before are captured and are refunded instead.
+
+// capture captures the order charge minus the returned amount, the
+// authorization is voided if nothing is left
+func capture(ctx context.Context, o *orderpb.Order) error {
+	c, err := payment.Service().Get(ctx, &paymentpb.GetRequest{Id: o.GetChargeId()})
+	if err != nil {
+		return err
+	}
+	if c.GetStatus() != paymentpb.Charge_Authorized {
+		return nil
+	}
+	amount := o.GetAmount()
+	for _, r := range o.GetReturns() {
+		amount -= r.GetAmount()
+	}
+	if amount <= 0 {
+		_, err = payment.Service().VoidCharge(ctx, &paymentpb.VoidRequest{Id: c.GetId()})
+		return err
+	}
+	_, err = payment.Service().CaptureCharge(ctx, &paymentpb.CaptureRequest{Id: c.GetId(), Amount: uint64(amount)})
+	return err
+}
+
+// refund refunds amount of the order charge and returns the provider refund
+// id. authorized charges are not refunded, the amount is left out of the
+// capture and the authorization is voided by the last return.
+func refund(ctx context.Context, o *orderpb.Order, amount int64, reason paymentpb.RefundReason, last bool) (string, error) {
+	c, err := payment.Service().Get(ctx, &paymentpb.GetRequest{Id: o.GetChargeId()})
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case c.GetStatus() == paymentpb.Charge_Authorized:
+		if last {
+			_, err = payment.Service().VoidCharge(ctx, &paymentpb.VoidRequest{Id: c.GetId()})
+		}
+		return "", err
+	case c.GetStatus() == paymentpb.Charge_Voided, amount <= 0:
+		return "", nil
+	}
+	c, err = payment.Service().RefundCharge(ctx, &paymentpb.RefundRequest{
+		Id:     c.GetId(),
+		Amount: uint64(amount),
+		Reason: reason,
+	})
+	if err != nil {
+		return "", err
+	}
+	if refunds := c.GetRefunds(); len(refunds) > 0 {
+		return refunds[len(refunds)-1].GetProviderRefundId(), nil
+	}
+	return "", nil
+}
+
+// refundReason returns the refund reason of canceling an order for r
+func refundReason(r orderpb.CancelReason) paymentpb.RefundReason {
+	switch r {
+	case orderpb.CancelReason_RequestedByCustomer:
+		return paymentpb.RefundReason_RequestedByCustomer
+	case orderpb.CancelReason_Fraud:
+		return paymentpb.RefundReason_Fraud
+	case orderpb.CancelReason_Duplicate:
+		return paymentpb.RefundReason_Duplicate
+	}
+	return paymentpb.RefundReason_GeneralError
+}
+
+// reconcilePay voids the charge of a payment whose order was not saved,
+// orders that were saved as paid by the charge are left as is
+func reconcilePay(ctx context.Context, e *outbox.Entry) error {
+	// the charge was never made, provider charges that were not saved
+	// are left to their payment entries
+	if e.ChargeId == "" {
+		return nil
+	}
+	o := &order{
+		Order: orderpb.Order{
+			Id: e.OrderId,
+		},
+	}
+	unlock, err := locker.Handler().TryLockContext(ctx, o, locker.DefaultTimeout)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := storage.Handler().One(o); err != nil {
+		return err
+	}
+	if o.GetChargeId() == e.ChargeId {
+		return nil
+	}
+	c, err := payment.Service().Get(ctx, &paymentpb.GetRequest{Id: e.ChargeId})
+	if err != nil {
+		return err
+	}
+	if c.GetStatus() != paymentpb.Charge_Authorized {
+		return nil
+	}
+	if _, err := payment.Service().VoidCharge(ctx, &paymentpb.VoidRequest{Id: c.GetId()}); err != nil {
+		return err
+	}
+	o.addEvent(ctx, orderpb.OrderEvent_DataLoss, "Charge %s of a payment that was not saved has been voided.", c.GetId())
+	return util.Retry(func() error {
+		return storage.Handler().Update(o)
+	})
+}
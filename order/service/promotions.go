@@ -0,0 +1,161 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/payment/paymentpb"
+	"github.com/digota/digota/promotion"
+	"github.com/digota/digota/promotion/promotionpb"
+	"github.com/digota/digota/sku"
+	"github.com/digota/digota/sku/skupb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// isCouponItem reports whether v is a discount item of a coupon, coupon
+// items carry the promotion id as their parent
+func isCouponItem(v *orderpb.OrderItem) bool {
+	return v.GetType() == orderpb.OrderItem_discount && v.GetParent() != ""
+}
+
+// applyCoupons returns the discount items of the coupons for the order items,
+// the discount of every item and the unique coupon codes, every coupon
+// discounts only the items it applies to.
+func applyCoupons(ctx context.Context, currency paymentpb.Currency, items []*orderpb.OrderItem, coupons []string) (discounts []*orderpb.OrderItem, off []int64, unique []string, err error) {
+	// sku items subtotal
+	var subtotal int64
+	for _, v := range items {
+		if v.GetType() == orderpb.OrderItem_sku {
+			subtotal += v.GetQuantity() * v.GetAmount()
+		}
+	}
+
+	var applied int64
+	off = make([]int64, len(items))
+	seen := make(map[string]bool)
+	products := make(map[string]string)
+	now := time.Now()
+
+	for _, code := range coupons {
+		code = promotion.Normalize(code)
+		if seen[code] {
+			continue
+		}
+		seen[code] = true
+
+		p, err := promotion.Service().GetByCode(ctx, &promotion.GetByCodeRequest{Code: code})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if err := promotion.Valid(p, now); err != nil {
+			return nil, nil, nil, err
+		}
+		if p.GetType() == promotionpb.Promotion_Fixed && p.GetCurrency() != currency {
+			return nil, nil, nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Coupon %s currency doesn't match the order currency.", code))
+		}
+		if subtotal < p.GetMinimumAmount() {
+			return nil, nil, nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Order amount is below coupon %s minimum.", code))
+		}
+
+		// amount of the items the coupon applies to
+		var eligible int64
+		covered := make([]bool, len(items))
+		for i, v := range items {
+			if v.GetType() != orderpb.OrderItem_sku {
+				continue
+			}
+			var product string
+			if len(p.GetProducts()) > 0 {
+				if product, err = skuProduct(ctx, v.GetParent(), products); err != nil {
+					return nil, nil, nil, err
+				}
+			}
+			if promotion.Applies(p, v.GetParent(), product) {
+				eligible += v.GetQuantity() * v.GetAmount()
+				covered[i] = true
+			}
+		}
+
+		// coupons together can't take off more than the subtotal
+		d := promotion.Discount(p, eligible)
+		if left := subtotal - applied; d > left {
+			d = left
+		}
+		if d <= 0 {
+			return nil, nil, nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Coupon %s doesn't apply to the order items.", code))
+		}
+		applied += d
+		spreadDiscount(items, off, d, func(i int) bool { return covered[i] })
+
+		discounts = append(discounts, &orderpb.OrderItem{
+			Type:        orderpb.OrderItem_discount,
+			Parent:      p.GetId(),
+			Quantity:    1,
+			Amount:      -d,
+			Currency:    currency,
+			Description: "Coupon " + code,
+		})
+		unique = append(unique, code)
+	}
+	return
+}
+
+// skuProduct returns the product of the sku, products are cached by sku id
+func skuProduct(ctx context.Context, id string, products map[string]string) (string, error) {
+	if product, ok := products[id]; ok {
+		return product, nil
+	}
+	s, err := sku.Service().Get(ctx, &skupb.GetRequest{Id: id})
+	if err != nil {
+		return "", err
+	}
+	products[id] = s.GetParent()
+	return s.GetParent(), nil
+}
+
+// redeemCoupons counts a redemption of every coupon of the order, the
+// returned func takes them back if the order could not be paid
+func redeemCoupons(ctx context.Context, o *orderpb.Order) (func(), error) {
+	var redeemed []string
+	release := func() {
+		for _, id := range redeemed {
+			promotion.Service().Redeem(ctx, &promotion.RedeemRequest{Id: id, Release: true})
+		}
+	}
+	for _, v := range o.GetItems() {
+		if !isCouponItem(v) {
+			continue
+		}
+		if _, err := promotion.Service().Redeem(ctx, &promotion.RedeemRequest{Id: v.GetParent()}); err != nil {
+			release()
+			return nil, err
+		}
+		redeemed = append(redeemed, v.GetParent())
+	}
+	return release, nil
+}
@@ -0,0 +1,92 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"time"
+
+	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/sku"
+	"github.com/digota/digota/sku/skupb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reserve replaces the inventory reservations of the reserved items with
+// the items, the stock is held for the order till it expires. sku items that
+// are not part of items are released.
+func (o *order) reserve(ctx context.Context, reserved, items []*orderpb.OrderItem) error {
+	// quantities to reserve
+	quantities := make(map[string]int64)
+	for _, v := range items {
+		if v.GetType() == orderpb.OrderItem_sku {
+			quantities[v.GetParent()] += v.GetQuantity()
+		}
+	}
+	// unique skus to lock, the same sku can't be locked twice
+	var unique []*orderpb.OrderItem
+	seen := make(map[string]bool)
+	for _, v := range append(reserved, items...) {
+		if v.GetType() == orderpb.OrderItem_sku && !seen[v.GetParent()] {
+			seen[v.GetParent()] = true
+			unique = append(unique, v)
+		}
+	}
+	// lock all inventory order items (inventory objects)
+	lockedItems := getLockedOrderItems(ctx, &orderpb.Order{Items: unique})
+	// Free all locks at func return
+	defer func() {
+		for _, item := range lockedItems {
+			item.Unlock()
+		}
+	}()
+	now := time.Now()
+	// check for errors and oversell before reserving anything
+	for _, item := range lockedItems {
+		q := quantities[item.OrderItem.GetParent()]
+		if item.Err != nil {
+			// nothing to reserve, the sku may be gone already
+			if q == 0 {
+				continue
+			}
+			return item.Err
+		}
+		inv := item.Sku.GetInventory()
+		if inv.GetType() == skupb.Inventory_Finite && sku.Available(inv, o.GetId(), now) < q {
+			return status.Error(codes.Canceled, "Oversell "+item.Sku.GetId())
+		}
+	}
+	// reservations expire with the order
+	expires := o.expiresAt().Unix()
+	for _, item := range lockedItems {
+		if item.Err != nil || item.Sku.GetInventory().GetType() != skupb.Inventory_Finite {
+			continue
+		}
+		sku.Reserve(item.Sku.Inventory, o.GetId(), quantities[item.OrderItem.GetParent()], expires, now)
+		if err := item.Update(); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+	return nil
+}
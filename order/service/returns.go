@@ -0,0 +1,131 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"github.com/digota/digota/order/orderpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// returnedQuantities returns the quantity returned so far of every sku
+func (o *order) returnedQuantities() map[string]int64 {
+	m := make(map[string]int64)
+	for _, r := range o.GetReturns() {
+		for _, v := range r.GetItems() {
+			m[v.GetParent()] += v.GetQuantity()
+		}
+	}
+	return m
+}
+
+// refunded returns the amount refunded so far
+func (o *order) refunded() (amount int64) {
+	for _, r := range o.GetReturns() {
+		amount += r.GetAmount()
+	}
+	return
+}
+
+// returnItems merges the requested items and checks them against the
+// quantities left to return, no items means everything that is left.
+// returns the items and whether nothing will be left to return after them.
+func (o *order) returnItems(reqItems []*orderpb.ReturnItem) ([]*orderpb.ReturnItem, bool, error) {
+	returned := o.returnedQuantities()
+	left := make(map[string]int64)
+	for _, v := range o.GetItems() {
+		if v.GetType() == orderpb.OrderItem_sku {
+			left[v.GetParent()] = v.GetQuantity() - returned[v.GetParent()]
+		}
+	}
+
+	var items []*orderpb.ReturnItem
+
+	if len(reqItems) == 0 {
+		for _, v := range o.GetItems() {
+			if v.GetType() == orderpb.OrderItem_sku && left[v.GetParent()] > 0 {
+				items = append(items, &orderpb.ReturnItem{Parent: v.GetParent(), Quantity: left[v.GetParent()]})
+			}
+		}
+		return items, true, nil
+	}
+
+	merged := make(map[string]*orderpb.ReturnItem)
+	for _, v := range reqItems {
+		if item, ok := merged[v.GetParent()]; ok {
+			item.Quantity += v.GetQuantity()
+			item.Restock = item.Restock || v.GetRestock()
+			continue
+		}
+		item := &orderpb.ReturnItem{Parent: v.GetParent(), Quantity: v.GetQuantity(), Restock: v.GetRestock()}
+		merged[v.GetParent()] = item
+		items = append(items, item)
+	}
+
+	for _, v := range items {
+		if v.GetQuantity() > left[v.GetParent()] {
+			return nil, false, status.Errorf(codes.FailedPrecondition, "Item %s has only %d left to return.", v.GetParent(), left[v.GetParent()])
+		}
+		left[v.GetParent()] -= v.GetQuantity()
+	}
+
+	for _, v := range left {
+		if v > 0 {
+			return items, false, nil
+		}
+	}
+
+	return items, true, nil
+}
+
+// returnAmount returns the refund amount of items, discounts, taxes and
+// shipping are prorated by the items value. the last return refunds
+// whatever is left so rounding never leaves money behind.
+func (o *order) returnAmount(items []*orderpb.ReturnItem, last bool) int64 {
+	left := o.GetAmount() - o.refunded()
+	if last {
+		return left
+	}
+
+	prices := make(map[string]int64)
+	var subtotal, value int64
+	for _, v := range o.GetItems() {
+		if v.GetType() == orderpb.OrderItem_sku {
+			prices[v.GetParent()] = v.GetAmount()
+			subtotal += v.GetAmount() * v.GetQuantity()
+		}
+	}
+	for _, v := range items {
+		value += prices[v.GetParent()] * v.GetQuantity()
+	}
+
+	if subtotal <= 0 || value <= 0 {
+		return 0
+	}
+
+	amount := o.GetAmount() * value / subtotal
+	if amount > left {
+		amount = left
+	}
+	return amount
+}
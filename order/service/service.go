@@ -25,9 +25,13 @@ package service
 import (
 	"errors"
 	"fmt"
+	"github.com/digota/digota/customer"
+	"github.com/digota/digota/customer/customerpb"
+	"github.com/digota/digota/idempotency"
 	"github.com/digota/digota/locker"
 	orderInterface "github.com/digota/digota/order"
 	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/outbox"
 	"github.com/digota/digota/payment"
 	"github.com/digota/digota/payment/paymentpb"
 	"github.com/digota/digota/sku"
@@ -36,6 +40,7 @@ import (
 	"github.com/digota/digota/storage/object"
 	"github.com/digota/digota/util"
 	"github.com/digota/digota/validation"
+	"github.com/golang/protobuf/proto"
 	"github.com/rhymond/go-money"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
@@ -46,14 +51,15 @@ import (
 
 const (
 	ns                         = "order"
-	orderTTL                   = time.Minute * 2
 	defaultTaxDescription      = "Tax"
 	defaultDiscountDescription = "Discount"
 	defaultShippingDescription = "Shipping"
+	defaultCreditDescription   = "Credit"
 )
 
 func init() {
 	orderInterface.RegisterService(&orderService{})
+	outbox.Handle(payEntry, reconcilePay)
 }
 
 type lockedOrderItem struct {
@@ -71,6 +77,7 @@ func (o *orders) GetNamespace() string { return ns }
 // Order wrapper
 type order struct {
 	orderpb.Order `bson:",inline"`
+	fence         int64
 }
 
 // implements object.Interface interface
@@ -85,27 +92,75 @@ func (o *order) SetCreated(t int64) { o.Created = t }
 // implements object.TimeTracker interface
 func (o *order) SetUpdated(t int64) { o.Updated = t }
 
+// implements object.Fencer interface
+func (o *order) SetFence(t int64) { o.fence = t }
+
+// implements object.Fencer interface
+func (o *order) GetFence() int64 { return o.fence }
+
 // IsReturnable checks che
 func (o *order) IsReturnable(amount int64) error {
-	if o.Status != orderpb.Order_Paid && o.Status != orderpb.Order_Fulfilled && o.Status != orderpb.Order_Canceled {
-		return status.Error(codes.Internal, "Order is not paid or fulfilled.")
+	if _, err := o.next(returnAction); err != nil {
+		return err
 	}
-	// if refund amount is bigger than the order amount return err
-	if amount > o.GetAmount() {
+	// if refund amount is bigger than what is left to refund return err
+	if amount > o.GetAmount()-o.refunded() {
 		return status.Error(codes.Internal, "Refund amount is greater then order amount.")
 	}
 	return nil
 }
 
+// expiresAt returns the time the order can't be paid after
+func (o *order) expiresAt() time.Time {
+	if o.Expires > 0 {
+		return time.Unix(o.Expires, 0)
+	}
+	// orders created before the expiry was saved
+	return time.Unix(o.Created, 0).Add(orderInterface.TTL())
+}
+
 func (o *order) IsPayable() error {
-	if o.Status != orderpb.Order_Created {
-		return status.Error(codes.Internal, "Order is not in created status.")
+	if _, err := o.next(payAction); err != nil {
+		return err
 	}
-	if time.Since(time.Unix(o.Created, 0)) > orderTTL {
-		return status.Error(codes.Internal, "Order is too old for paying.")
+	if time.Now().After(o.expiresAt()) {
+		return status.Error(codes.FailedPrecondition, "Order is too old for paying.")
 	}
 	if o.GetAmount() <= 0 {
-		return status.Error(codes.Internal, "Order amount is Zero.")
+		return status.Error(codes.FailedPrecondition, "Order amount is Zero.")
+	}
+	return nil
+}
+
+// setCustomer assigns the order to the customer, the customer email and
+// default address are used if the order has none
+func (o *order) setCustomer(ctx context.Context, id string) error {
+	c, err := customer.Service().Get(ctx, &customerpb.GetRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	o.CustomerId = c.GetId()
+	if o.Email == "" {
+		o.Email = c.GetEmail()
+	}
+	if o.Shipping != nil {
+		return nil
+	}
+	for _, v := range c.GetAddresses() {
+		if !v.GetDefault() {
+			continue
+		}
+		o.Shipping = &orderpb.Shipping{
+			Name:    v.GetName(),
+			Phone:   v.GetPhone(),
+			Address: v.GetAddress(),
+		}
+		if o.Shipping.Name == "" {
+			o.Shipping.Name = c.GetName()
+		}
+		if o.Shipping.Phone == "" {
+			o.Shipping.Phone = c.GetPhone()
+		}
 	}
 	return nil
 }
@@ -115,11 +170,44 @@ type orderService struct{}
 // New implements the orderpb.New interface.
 // Creates new order from order Items and return Order or error, error will
 // returned if something went wrong .. let say something such as inactive Items.
+// Retries with the same idempotency key get the first created order.
 func (s *orderService) New(ctx context.Context, req *orderpb.NewRequest) (*orderpb.Order, error) {
+	res, err := idempotency.Do(ctx, "order.New", req.GetIdempotencyKey(), req, &orderpb.Order{}, func(ctx context.Context) (proto.Message, error) {
+		return s.create(ctx, req, 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*orderpb.Order), nil
+}
+
+// NewWithCredit implements the order.NewWithCredit interface.
+// Creates the order like New and discounts the credit from its amount, the
+// credit is kept through order updates.
+func (s *orderService) NewWithCredit(ctx context.Context, req *orderInterface.NewWithCreditRequest) (*orderpb.Order, error) {
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+	res, err := idempotency.Do(ctx, "order.NewWithCredit", req.Order.GetIdempotencyKey(), req, &orderpb.Order{}, func(ctx context.Context) (proto.Message, error) {
+		return s.create(ctx, req.Order, req.Credit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*orderpb.Order), nil
+}
+
+func (s *orderService) create(ctx context.Context, req *orderpb.NewRequest, credit int64) (*orderpb.Order, error) {
 	// validate input
 	if err := validation.Validate(req); err != nil {
 		return nil, err
 	}
+	if err := validation.Metadata(req.GetMetadata()); err != nil {
+		return nil, err
+	}
+	if err := validateItems(req.GetItems()); err != nil {
+		return nil, err
+	}
 	// order wrapper
 	o := &order{
 		Order: orderpb.Order{
@@ -128,24 +216,51 @@ func (s *orderService) New(ctx context.Context, req *orderpb.NewRequest) (*order
 			Amount:   0,
 			Currency: req.GetCurrency(),
 			Shipping: req.GetShipping(),
+			Metadata: req.GetMetadata(),
+			Credit:   credit,
 		},
 	}
-	// get relevant order items
-	orderItems, err := getUpdatedOrderItems(req.GetItems())
+	// the order can be paid till it expires, its items are reserved till
+	// then so the ttl is capped
+	ttl := orderInterface.TTL()
+	if x := req.GetTtl(); x > 0 {
+		ttl = time.Duration(x) * time.Second
+		if max := orderInterface.MaxTTL(); ttl > max {
+			return nil, status.Errorf(codes.InvalidArgument, "Order ttl can't be longer than %s.", max)
+		}
+	}
+	o.Expires = time.Now().Add(ttl).Unix()
+	// fill the email and shipping from the customer profile
+	if x := req.GetCustomerId(); x != "" {
+		if err := o.setCustomer(ctx, x); err != nil {
+			return nil, err
+		}
+	}
+	// get relevant order items, discount and tax items are calculated below
+	orderItems, err := getUpdatedOrderItems(ctx, req.GetItems())
 	if err != nil {
 		return nil, err
 	}
-	// update order items
-	o.Items = orderItems
-	// calculate final amount
-	amount, err := calculateTotal(o.GetCurrency(), orderItems)
-	if err != nil {
+	// convert the items into the order currency
+	if o.ExchangeRates, err = convertItems(o.GetCurrency(), orderItems); err != nil {
 		return nil, err
 	}
-	// update order amount
-	o.Amount = amount
-	// Insert and return order
-	return &o.Order, storage.Handler().Insert(o)
+	// update order items and amount
+	if err := o.price(ctx, orderItems, req.GetCoupons(), req.GetShippingRate()); err != nil {
+		return nil, err
+	}
+	o.addEvent(ctx, orderpb.OrderEvent_Created, "Order created, amount %d %s.", o.GetAmount(), o.GetCurrency())
+	// Insert order
+	if err := storage.Handler().Insert(o); err != nil {
+		return nil, err
+	}
+	// hold the items in stock till the order expires
+	if err := o.reserve(ctx, nil, o.Items); err != nil {
+		storage.Handler().Remove(o)
+		return nil, err
+	}
+	// return order
+	return &o.Order, nil
 }
 
 // Get implements the orderpb.Get interface.
@@ -163,7 +278,7 @@ func (s *orderService) Get(ctx context.Context, req *orderpb.GetRequest) (*order
 	}
 
 	// acquire order lock
-	unlock, err := locker.Handler().TryLock(o, locker.DefaultTimeout)
+	unlock, err := locker.Handler().TryLockContext(ctx, o, locker.DefaultTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -180,12 +295,26 @@ func (s *orderService) List(ctx context.Context, req *orderpb.ListRequest) (*ord
 		return nil, err
 	}
 
+	if err := validation.Metadata(req.GetMetadata()); err != nil {
+		return nil, err
+	}
+
+	// metadata key value filters
+	var filters []object.Filter
+	for k, v := range req.GetMetadata() {
+		filters = append(filters, object.Filter{Field: "metadata." + k, Op: object.OpEq, Value: v})
+	}
+	if x := req.GetCustomerId(); x != "" {
+		filters = append(filters, object.Filter{Field: "customerid", Op: object.OpEq, Value: x})
+	}
+
 	slice := orders{}
 
 	n, err := storage.Handler().List(&slice, object.ListOpt{
-		Limit: req.GetLimit(),
-		Page:  req.GetPage(),
-		Sort:  object.SortNatural,
+		Limit:   req.GetLimit(),
+		Page:    req.GetPage(),
+		Sort:    object.Sort(req.GetSort()),
+		Filters: filters,
 	})
 
 	if err != nil {
@@ -197,13 +326,29 @@ func (s *orderService) List(ctx context.Context, req *orderpb.ListRequest) (*ord
 }
 
 // Pay implements the orderpb.Pay interface.
-// Pay will call payment service to charge the same order amount, charge id will
-// be assigned to the order. This method locks the order till defers.
+// Pay will call payment service to authorize the same order amount, charge id
+// will be assigned to the order and the charge is captured at Fulfill.
+// This method locks the order till defers.
+// Retries with the same idempotency key get the first paid order.
 func (s *orderService) Pay(ctx context.Context, req *orderpb.PayRequest) (*orderpb.Order, error) {
+	res, err := idempotency.Do(ctx, "order.Pay", req.GetIdempotencyKey(), req, &orderpb.Order{}, func(ctx context.Context) (proto.Message, error) {
+		return s.pay(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*orderpb.Order), nil
+}
+
+func (s *orderService) pay(ctx context.Context, req *orderpb.PayRequest) (*orderpb.Order, error) {
 	// validate input
 	if err := validation.Validate(req); err != nil {
 		return nil, err
 	}
+	// pay with either the card or the stored payment method
+	if (req.GetCard() == nil) == (req.GetPaymentMethod() == "") {
+		return nil, status.Error(codes.InvalidArgument, "Either card or payment method is required.")
+	}
 	// order wrapper
 	o := &order{
 		Order: orderpb.Order{
@@ -212,7 +357,7 @@ func (s *orderService) Pay(ctx context.Context, req *orderpb.PayRequest) (*order
 	}
 	// lock order for any change!
 	// acquire order lock
-	unlock, err := locker.Handler().TryLock(o, time.Second*5)
+	unlock, err := locker.Handler().TryLockContext(ctx, o, time.Second*5)
 	if err != nil {
 		return nil, err
 	}
@@ -239,50 +384,80 @@ func (s *orderService) Pay(ctx context.Context, req *orderpb.PayRequest) (*order
 		if item.Err != nil {
 			return nil, item.Err
 		}
-		// check for oversell
-		if item.Sku.Inventory.Type == skupb.Inventory_Finite && item.Sku.Inventory.Quantity < item.OrderItem.Quantity {
+		// check for oversell, the order own reservation is available
+		if inv := item.Sku.GetInventory(); inv.GetType() == skupb.Inventory_Finite && sku.Available(inv, o.GetId(), time.Now()) < item.OrderItem.GetQuantity() {
 			return nil, status.Error(codes.Canceled, "Oversell "+item.Sku.Id)
 		}
 	}
-	// charge full amount for the order order
-	c, err := payment.Service().NewCharge(ctx, &paymentpb.ChargeRequest{
+	// count the coupons usage before charging
+	releaseCoupons, err := redeemCoupons(ctx, &o.Order)
+	if err != nil {
+		return nil, err
+	}
+	// remember the payment till the order is saved, the reconciler voids
+	// the charge if it is not
+	e := &outbox.Entry{Kind: payEntry, OrderId: o.GetId()}
+	if err := outbox.Add(e); err != nil {
+		releaseCoupons()
+		return nil, err
+	}
+	// authorize full amount for the order
+	c, err := payment.Service().AuthorizeCharge(ctx, &paymentpb.ChargeRequest{
 		PaymentProviderId: req.GetPaymentProviderId(),
 		Card:              req.GetCard(),
+		PaymentMethod:     req.GetPaymentMethod(),
 		Total:             uint64(o.GetAmount()),
 		Currency:          o.GetCurrency(),
 		Email:             o.GetEmail(),
+		CustomerId:        o.GetCustomerId(),
 		Statement:         fmt.Sprintf("Order %s", o.GetId()),
+		Metadata:          o.GetMetadata(),
 	})
 	// return the charge error
 	if err != nil {
+		releaseCoupons()
+		outbox.Done(e)
+		return nil, err
+	}
+	e.ChargeId = c.GetId()
+	// the reconciler can't find the charge if the entry is not saved, void it now
+	if err := outbox.Save(e); err != nil {
+		releaseCoupons()
+		if _, voidErr := payment.Service().VoidCharge(ctx, &paymentpb.VoidRequest{Id: c.GetId()}); voidErr != nil {
+			o.saveEvent(ctx, orderpb.OrderEvent_DataLoss, "Payment could not be saved and charge %s could not be voided.", c.GetId())
+			return nil, status.Error(codes.DataLoss, fmt.Sprintf("could not save the payment of order {%s} and could not void the charge {%s}!", o.Id, c.GetId()))
+		}
+		outbox.Done(e)
 		return nil, err
 	}
 	// Order has been Paid !
 	// Update order object
 	o.ChargeId = c.GetId()
-	o.Status = orderpb.Order_Paid
+	o.Status, _ = o.next(payAction)
+	o.addEvent(ctx, orderpb.OrderEvent_Paid, "Amount %d authorized by charge %s.", o.GetAmount(), c.GetId())
 	// update order with retries
 	updateErr := util.Retry(func() error {
 		return storage.Handler().Update(o)
 	})
-	// update has been failed after few times.. refund it to prevent data corruption
-	// todo change to two-phase payment method ?
+	// update has been failed after few times.. void it to prevent data corruption
 	if updateErr != nil {
-		r, err := payment.Service().RefundCharge(ctx, &paymentpb.RefundRequest{
-			Id:     c.Id,
-			Amount: uint64(o.GetAmount()),
-			Reason: paymentpb.RefundReason_GeneralError,
-		})
-		if err != nil {
-			return nil, status.Error(codes.DataLoss, fmt.Sprintf("could not update order {%s} object and could not refund the charge {%s}!", o.Id, o.ChargeId))
+		releaseCoupons()
+		if _, err := payment.Service().VoidCharge(ctx, &paymentpb.VoidRequest{Id: c.GetId()}); err != nil {
+			// the outbox entry is kept for the reconciler
+			o.saveEvent(ctx, orderpb.OrderEvent_DataLoss, "Order could not be saved as paid and charge %s could not be voided.", c.GetId())
+			return nil, status.Error(codes.DataLoss, fmt.Sprintf("could not update order {%s} object and could not void the charge {%s}!", o.Id, o.ChargeId))
 		}
-		return nil, status.Error(codes.DataLoss, fmt.Sprintf("could not update order {%s} object, order has been refunded {%s}!", o.Id, r.Id))
+		outbox.Done(e)
+		o.saveEvent(ctx, orderpb.OrderEvent_DataLoss, "Order could not be saved as paid, charge %s has been voided.", c.GetId())
+		return nil, status.Error(codes.DataLoss, fmt.Sprintf("could not update order {%s} object, charge has been voided {%s}!", o.Id, o.ChargeId))
 	}
+	outbox.Done(e)
 	// update all inventories
 	for _, item := range lockedItems {
-		if item.Sku.Inventory.Type == skupb.Inventory_Finite {
-			// update inventory Quantity
+		if item.Sku.GetInventory().GetType() == skupb.Inventory_Finite {
+			// update inventory Quantity and release the reservation
 			item.Sku.Inventory.Quantity -= item.OrderItem.Quantity
+			sku.Reserve(item.Sku.Inventory, o.GetId(), 0, 0, time.Now())
 			item.Update()
 		}
 	}
@@ -290,7 +465,9 @@ func (s *orderService) Pay(ctx context.Context, req *orderpb.PayRequest) (*order
 	return &o.Order, nil
 }
 
-// Pay implements the orderpb.Pay interface. Pay on specific orderId with CC based on payment provider supplied.
+// Return implements the orderpb.Return interface. Returns the requested items
+// of a paid or fulfilled order and refunds their prorated amount, returns
+// can be repeated till all the items are returned.
 func (s *orderService) Return(ctx context.Context, req *orderpb.ReturnRequest) (*orderpb.Order, error) {
 	// validate input
 	if err := validation.Validate(req); err != nil {
@@ -303,7 +480,7 @@ func (s *orderService) Return(ctx context.Context, req *orderpb.ReturnRequest) (
 		},
 	}
 	// lock order
-	unlock, err := locker.Handler().TryLock(o, locker.DefaultTimeout)
+	unlock, err := locker.Handler().TryLockContext(ctx, o, locker.DefaultTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -313,14 +490,78 @@ func (s *orderService) Return(ctx context.Context, req *orderpb.ReturnRequest) (
 	if err := storage.Handler().One(o); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	// calculate returns amount
-	amount, err := calculateTotal(o.GetCurrency(), o.GetItems())
-	if err != nil {
+	// return the items
+	if err := o.returnOrderItems(ctx, req.GetItems(), req.GetReason()); err != nil {
 		return nil, err
 	}
+	// update order with retries
+	updateErr := util.Retry(func() error {
+		return storage.Handler().Update(o)
+	})
+	// return err
+	if updateErr != nil {
+		o.saveEvent(ctx, orderpb.OrderEvent_DataLoss, "Return could not be saved, charge %s has been refunded.", o.ChargeId)
+		return nil, status.Error(codes.DataLoss, fmt.Sprintf("could not update order {%s} object, order has been refunded {%s}!", o.Id, o.ChargeId))
+	}
+	// return order
+	return &o.Order, nil
+}
+
+// returnOrderItems returns the requested items of the locked order, restocks
+// them and refunds their amount. the order status and returns are updated
+// but not saved.
+func (o *order) returnOrderItems(ctx context.Context, reqItems []*orderpb.ReturnItem, reason paymentpb.RefundReason) error {
+	// check the order status before looking at the items
+	if _, err := o.next(returnAction); err != nil {
+		return err
+	}
+	// items to return
+	items, last, err := o.returnItems(reqItems)
+	if err != nil {
+		return err
+	}
+	// calculate returns amount
+	amount := o.returnAmount(items, last)
 	// check if order can be refunded
 	if err := o.IsReturnable(amount); err != nil {
-		return nil, err
+		return err
+	}
+	// the order is returned or canceled once nothing is left
+	a := partialReturnAction
+	if last {
+		a = returnAction
+	}
+	next, err := o.next(a)
+	if err != nil {
+		return err
+	}
+	// items of a paid order that were not shipped yet are still in
+	// stock, otherwise only the items flagged for restock are
+	unshipped := o.unfulfilledQuantities()
+	restock := make(map[string]int64)
+	for _, v := range items {
+		q := v.GetQuantity()
+		if !v.GetRestock() {
+			q = 0
+			if o.Status == orderpb.Order_Paid && unshipped[v.GetParent()] > 0 {
+				q = unshipped[v.GetParent()]
+				if v.GetQuantity() < q {
+					q = v.GetQuantity()
+				}
+			}
+		}
+		if q > 0 {
+			v.Restock = true
+			restock[v.GetParent()] = q
+		}
+		unshipped[v.GetParent()] -= v.GetQuantity()
+	}
+	// a partially shipped order is fulfilled once the rest is returned
+	fulfilled := !last && o.Status == orderpb.Order_Paid && len(o.GetFulfillments()) > 0 && isCovered(unshipped)
+	if fulfilled {
+		if next, err = o.next(fulfillAction); err != nil {
+			return err
+		}
 	}
 	// lock all inventory order items (inventory objects)
 	lockedItems := getLockedOrderItems(ctx, &o.Order)
@@ -330,46 +571,436 @@ func (s *orderService) Return(ctx context.Context, req *orderpb.ReturnRequest) (
 			item.Unlock()
 		}
 	}()
-	// refund the order
-	if _, err := payment.Service().RefundCharge(ctx, &paymentpb.RefundRequest{Id: o.GetChargeId(), Amount: uint64(amount)}); err != nil {
-		return nil, err
+	// check for errors of the items we are about to restock
+	for _, item := range lockedItems {
+		if restock[item.OrderItem.Parent] > 0 && item.Err != nil {
+			return item.Err
+		}
+	}
+	r := &orderpb.OrderReturn{
+		Items:   items,
+		Amount:  amount,
+		Reason:  reason,
+		Created: time.Now().Unix(),
+	}
+	// refund the returned amount
+	if r.RefundId, err = refund(ctx, &o.Order, amount, reason, last); err != nil {
+		o.saveEvent(ctx, orderpb.OrderEvent_RefundFailed, "Refund of %d failed => %s", amount, err.Error())
+		return err
+	}
+	o.Returns = append(o.Returns, r)
+	// charge the shipped items
+	if fulfilled {
+		if err := capture(ctx, &o.Order); err != nil {
+			o.Returns = o.Returns[:len(o.Returns)-1]
+			o.saveEvent(ctx, orderpb.OrderEvent_CaptureFailed, "Capture failed => %s", err.Error())
+			return err
+		}
+	}
+	// notify listeners we want to return the items back in inventory
+	// update inventories
+	for _, item := range lockedItems {
+		if q := restock[item.OrderItem.Parent]; q > 0 && item.Sku.Inventory.Type == skupb.Inventory_Finite {
+			// update inventory Quantity
+			item.Sku.Inventory.Quantity += q
+			item.Update()
+		}
 	}
 	// update order status
-	switch o.Status {
-	// if the order has been paid but never fulfilled
-	// order status turns from paid into canceled
-	// inventory item will get updated since the sku is in
-	// stock again
-	case orderpb.Order_Paid:
-		o.Status = orderpb.Order_Canceled
-		// notify listeners we want to return the items back in inventory
-		// update inventories
-		for _, item := range lockedItems {
-			if item.Sku.Inventory.Type == skupb.Inventory_Finite {
-				// update inventory Quantity
-				item.Sku.Inventory.Quantity += item.OrderItem.Quantity
-				item.Update()
+	o.Status = next
+	var n int64
+	for _, v := range items {
+		n += v.GetQuantity()
+	}
+	o.addEvent(ctx, orderpb.OrderEvent_Returned, "Returned %d items, refunded %d.", n, amount)
+	if fulfilled {
+		o.addEvent(ctx, orderpb.OrderEvent_Fulfilled, "Order fulfilled, the items left were returned.")
+	}
+	return nil
+}
+
+// Fulfill implements the orderpb.Fulfill interface.
+// Ships all the items of a paid order that were not shipped or returned
+// yet and marks it as fulfilled, the carrier and tracking number are saved
+// into the order shipping. The authorized amount of the items that were
+// not returned is captured.
+func (s *orderService) Fulfill(ctx context.Context, req *orderpb.FulfillRequest) (*orderpb.Order, error) {
+	// validate input
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+	// order wrapper
+	o := &order{
+		Order: orderpb.Order{
+			Id: req.GetId(),
+		},
+	}
+	// lock order
+	unlock, err := locker.Handler().TryLockContext(ctx, o, locker.DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	// get order
+	if err := storage.Handler().One(o); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// ship whatever is left
+	if err := o.fulfill(ctx, nil, req.GetCarrier(), req.GetTrackingNumber()); err != nil {
+		return nil, err
+	}
+	// capture shipment details
+	if o.Shipping == nil {
+		o.Shipping = &orderpb.Shipping{}
+	}
+	o.Shipping.Carrier = req.GetCarrier()
+	o.Shipping.TrackingNumber = req.GetTrackingNumber()
+	// update order with retries
+	if err := util.Retry(func() error {
+		return storage.Handler().Update(o)
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// return order
+	return &o.Order, nil
+}
+
+// CreateFulfillment implements the orderpb.CreateFulfillment interface.
+// Records a shipment of some of the items of a paid order, the order is
+// fulfilled and its authorized amount is captured once all the items that
+// were not returned are shipped.
+func (s *orderService) CreateFulfillment(ctx context.Context, req *orderpb.FulfillmentRequest) (*orderpb.Order, error) {
+	// validate input
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+	// order wrapper
+	o := &order{
+		Order: orderpb.Order{
+			Id: req.GetId(),
+		},
+	}
+	// lock order
+	unlock, err := locker.Handler().TryLockContext(ctx, o, locker.DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	// get order
+	if err := storage.Handler().One(o); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// record the shipment
+	if err := o.fulfill(ctx, req.GetItems(), req.GetCarrier(), req.GetTrackingNumber()); err != nil {
+		return nil, err
+	}
+	// update order with retries
+	if err := util.Retry(func() error {
+		return storage.Handler().Update(o)
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// return order
+	return &o.Order, nil
+}
+
+// AddNote implements the orderpb.AddNote interface.
+// Appends a free text note to the order timeline in any order status, the
+// client of the request is recorded as its author.
+func (s *orderService) AddNote(ctx context.Context, req *orderpb.NoteRequest) (*orderpb.Order, error) {
+	// validate input
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+	// order wrapper
+	o := &order{
+		Order: orderpb.Order{
+			Id: req.GetId(),
+		},
+	}
+	// lock order
+	unlock, err := locker.Handler().TryLockContext(ctx, o, locker.DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	// get order
+	if err := storage.Handler().One(o); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	o.addEvent(ctx, orderpb.OrderEvent_Note, "%s", req.GetNote())
+	// update order with retries
+	if err := util.Retry(func() error {
+		return storage.Handler().Update(o)
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// return order
+	return &o.Order, nil
+}
+
+// Cancel implements the orderpb.Cancel interface.
+// Cancels an order that has not been fulfilled yet and records the reason,
+// the items of paid orders are returned and the authorization is voided.
+func (s *orderService) Cancel(ctx context.Context, req *orderpb.CancelRequest) (*orderpb.Order, error) {
+	// validate input
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+	// order wrapper
+	o := &order{
+		Order: orderpb.Order{
+			Id: req.GetId(),
+		},
+	}
+	// lock order
+	unlock, err := locker.Handler().TryLockContext(ctx, o, locker.DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	// get order
+	if err := storage.Handler().One(o); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// check if order can be canceled
+	next, err := o.next(cancelAction)
+	if err != nil {
+		return nil, err
+	}
+	// shipped items can only be returned
+	if len(o.GetFulfillments()) > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "Order is partially fulfilled and can't be canceled.")
+	}
+	if o.Status == orderpb.Order_Paid {
+		// return whatever is left back to stock
+		if err := o.returnOrderItems(ctx, nil, refundReason(req.GetReason())); err != nil {
+			return nil, err
+		}
+	} else {
+		// release the reserved items back to stock
+		if err := o.reserve(ctx, o.Items, nil); err != nil {
+			return nil, err
+		}
+	}
+	o.Status = next
+	o.CancelReason = req.GetReason()
+	o.addEvent(ctx, orderpb.OrderEvent_Canceled, "Order canceled, reason %s.", req.GetReason())
+	// update order with retries
+	if err := util.Retry(func() error {
+		return storage.Handler().Update(o)
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// return order
+	return &o.Order, nil
+}
+
+// Update implements the orderpb.Update interface.
+// Updates the items, email and shipping of an order that has not been paid
+// yet, items are fetched again and the amount is recalculated.
+func (s *orderService) Update(ctx context.Context, req *orderpb.UpdateRequest) (*orderpb.Order, error) {
+	// validate input
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+	if err := validation.Metadata(req.GetMetadata()); err != nil {
+		return nil, err
+	}
+	if err := validateItems(req.GetItems()); err != nil {
+		return nil, err
+	}
+	// order wrapper
+	o := &order{
+		Order: orderpb.Order{
+			Id: req.GetId(),
+		},
+	}
+	// lock order
+	unlock, err := locker.Handler().TryLockContext(ctx, o, locker.DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	// get order
+	if err := storage.Handler().One(o); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// check if order can be updated, metadata can be updated in any status
+	if req.GetItems() != nil || req.GetEmail() != "" || req.GetShipping() != nil || req.GetCoupons() != nil || req.GetShippingRate() != "" {
+		if _, err := o.next(updateAction); err != nil {
+			return nil, err
+		}
+	}
+
+	// update fields and keep the rest the same
+
+	if x := req.GetMetadata(); x != nil {
+		if o.Metadata == nil {
+			o.Metadata = make(map[string]string, len(x))
+		}
+		for k, v := range x {
+			if v == "" {
+				delete(o.Metadata, k)
+			} else {
+				o.Metadata[k] = v
 			}
 		}
-		// if the order has been fulfilled
-		// order status turns from fulfilled into returned
-		// inventory will not get updated since we still got no
-		// item to sell again
-	case orderpb.Order_Fulfilled:
-		o.Status = orderpb.Order_Returned
 	}
+
+	if x := req.GetEmail(); x != "" {
+		o.Email = x
+	}
+
+	if x := req.GetShipping(); x != nil {
+		o.Shipping = x
+	}
+
+	// calculated items depend on the items, coupons and shipping
+	if req.GetItems() != nil || req.GetCoupons() != nil || req.GetShipping() != nil || req.GetShippingRate() != "" {
+		// get relevant order items, discount and tax items are calculated below
+		reserved, orderItems := o.Items, skuItems(o.Items)
+		if x := req.GetItems(); x != nil {
+			if orderItems, err = getUpdatedOrderItems(ctx, x); err != nil {
+				return nil, err
+			}
+			if o.ExchangeRates, err = convertItems(o.GetCurrency(), orderItems); err != nil {
+				return nil, err
+			}
+		}
+		coupons := o.Coupons
+		if x := req.GetCoupons(); x != nil {
+			coupons = x
+		}
+		shippingRate := o.ShippingRate
+		if x := req.GetShippingRate(); x != "" {
+			shippingRate = x
+		}
+		// update order items and amount
+		if err := o.price(ctx, orderItems, coupons, shippingRate); err != nil {
+			return nil, err
+		}
+		// move the reservations to the new items
+		if err := o.reserve(ctx, reserved, o.Items); err != nil {
+			return nil, err
+		}
+	}
+	o.addEvent(ctx, orderpb.OrderEvent_Updated, "Order updated.")
+
 	// update order with retries
-	updateErr := util.Retry(func() error {
+	if err := util.Retry(func() error {
 		return storage.Handler().Update(o)
-	})
-	// return err
-	if updateErr != nil {
-		return nil, status.Error(codes.DataLoss, fmt.Sprintf("could not update order {%s} object, order has been refunded {%s}!", o.Id, o.ChargeId))
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 	// return order
 	return &o.Order, nil
 }
 
+// GetShippingRates implements the orderpb.GetShippingRates interface.
+// Returns the shipping rates available for an order draft, the chosen rate
+// id can be passed to New.
+func (s *orderService) GetShippingRates(ctx context.Context, req *orderpb.ShippingRatesRequest) (*orderpb.ShippingQuoteList, error) {
+	// validate input
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+	if err := validateItems(req.GetItems()); err != nil {
+		return nil, err
+	}
+	// get relevant order items
+	orderItems, err := getUpdatedOrderItems(ctx, req.GetItems())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := convertItems(req.GetCurrency(), orderItems); err != nil {
+		return nil, err
+	}
+	quotes, err := shippingQuotes(ctx, req.GetCurrency(), req.GetShipping().GetAddress(), orderItems)
+	if err != nil {
+		return nil, err
+	}
+	return &orderpb.ShippingQuoteList{Quotes: quotes}, nil
+}
+
+// price sets the order items, coupons, shipping rate, taxes and amount.
+// discount, tax and shipping items are calculated from the items, the
+// coupons, the shipping address and rate and the order credit.
+func (o *order) price(ctx context.Context, items []*orderpb.OrderItem, coupons []string, shippingRate string) error {
+	// coupons discount items
+	discounts, off, coupons, err := applyCoupons(ctx, o.GetCurrency(), items, coupons)
+	if err != nil {
+		return err
+	}
+	// the credit covers the sku items before their taxes
+	spreadDiscount(items, off, o.GetCredit(), func(int) bool { return true })
+	// tax items of the shipping address, on the discounted items
+	taxes, lines, err := applyTaxes(ctx, o.GetCurrency(), o.GetShipping().GetAddress(), items, off)
+	if err != nil {
+		return err
+	}
+	// shipping item of the chosen rate
+	shippingItem, err := applyShipping(ctx, o.GetCurrency(), o.GetShipping().GetAddress(), items, shippingRate)
+	if err != nil {
+		return err
+	}
+	items = append(append(items, discounts...), taxes...)
+	if shippingItem != nil {
+		items = append(items, shippingItem)
+	}
+	// calculate final amount
+	amount, err := calculateTotal(o.GetCurrency(), items)
+	if err != nil {
+		return err
+	}
+	// the credit is discounted last, up to the whole amount
+	if credit := o.GetCredit(); credit > 0 && amount > 0 {
+		if credit > amount {
+			credit = amount
+		}
+		items = append(items, &orderpb.OrderItem{
+			Type:        orderpb.OrderItem_discount,
+			Quantity:    1,
+			Amount:      -credit,
+			Currency:    o.GetCurrency(),
+			Description: defaultCreditDescription,
+		})
+		amount -= credit
+	}
+	o.Items = items
+	o.Coupons = coupons
+	o.ShippingRate = shippingRate
+	o.Taxes = lines
+	o.Amount = amount
+	return nil
+}
+
+// validateItems returns an error if items from the client are not sku
+// items, discount, tax and shipping items are calculated by the server only
+func validateItems(items []*orderpb.OrderItem) error {
+	for _, v := range items {
+		if v.GetType() != orderpb.OrderItem_sku {
+			return status.Errorf(codes.InvalidArgument, "Order items must be sku items, %s items are calculated.", v.GetType())
+		}
+	}
+	return nil
+}
+
+// skuItems returns the sku items of items without the calculated ones
+func skuItems(items []*orderpb.OrderItem) (res []*orderpb.OrderItem) {
+	for _, v := range items {
+		if v.GetType() == orderpb.OrderItem_sku {
+			res = append(res, v)
+		}
+	}
+	return
+}
+
 // calculateTotal will calculate the new amount of the cart. using
 // go-money library, which helps us to do money calculations of
 // the `Fowler's Money pattern`. will return error if something went
@@ -389,7 +1020,7 @@ func calculateTotal(currency paymentpb.Currency, orderItems []*orderpb.OrderItem
 	return m.Amount(), nil
 }
 
-func getUpdatedOrderItems(reqItems []*orderpb.OrderItem) (orderItems []*orderpb.OrderItem, err error) {
+func getUpdatedOrderItems(ctx context.Context, reqItems []*orderpb.OrderItem) (orderItems []*orderpb.OrderItem, err error) {
 
 	var skuMap = make(map[string]*orderpb.OrderItem)
 	var mtx = sync.Mutex{}
@@ -433,7 +1064,7 @@ func getUpdatedOrderItems(reqItems []*orderpb.OrderItem) (orderItems []*orderpb.
 			// get the sku object
 			go func(orderItem *orderpb.OrderItem, wg *sync.WaitGroup) {
 				defer wg.Done()
-				if item, err := sku.Service().Get(context.Background(), &skupb.GetRequest{Id: orderItem.GetParent()}); err != nil {
+				if item, err := sku.Service().Get(ctx, &skupb.GetRequest{Id: orderItem.GetParent()}); err != nil {
 					mtx.Lock()
 					errs = append(errs, err)
 					mtx.Unlock()
@@ -23,24 +23,43 @@
 package service
 
 import (
+	_ "github.com/digota/digota/customer/service"
+	_ "github.com/digota/digota/payment/service"
 	_ "github.com/digota/digota/product/service"
+	_ "github.com/digota/digota/promotion/service"
+	_ "github.com/digota/digota/shipping/service"
 	_ "github.com/digota/digota/sku/service"
+	_ "github.com/digota/digota/tax/service"
 )
 
 import (
+	"github.com/digota/digota/client"
 	"github.com/digota/digota/config"
+	"github.com/digota/digota/exchange"
 	"github.com/digota/digota/locker"
+	orderInterface "github.com/digota/digota/order"
 	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/outbox"
+	"github.com/digota/digota/payment"
 	"github.com/digota/digota/payment/paymentpb"
 	"github.com/digota/digota/payment/service/providers"
 	"github.com/digota/digota/product"
 	"github.com/digota/digota/product/productpb"
+	"github.com/digota/digota/promotion"
+	"github.com/digota/digota/promotion/promotionpb"
+	"github.com/digota/digota/shipping"
+	"github.com/digota/digota/shipping/shippingpb"
 	"github.com/digota/digota/sku"
 	"github.com/digota/digota/sku/skupb"
 	"github.com/digota/digota/storage"
+	"github.com/digota/digota/tax"
+	"github.com/digota/digota/tax/taxpb"
 	"github.com/icrowley/fake"
 	"github.com/satori/go.uuid"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/big"
 	"os"
 	"testing"
 	"time"
@@ -103,6 +122,23 @@ func createSku(product *productpb.Product, currency paymentpb.Currency, active b
 	})
 }
 
+func payOrder(o *orderpb.Order) (*orderpb.Order, error) {
+	orderService := orderService{}
+	return orderService.Pay(context.Background(), &orderpb.PayRequest{
+		Id:                o.GetId(),
+		PaymentProviderId: paymentpb.PaymentProviderId_Stripe,
+		Card: &paymentpb.Card{
+			Type:        paymentpb.CardType_Visa,
+			CVC:         "123",
+			ExpireMonth: "12",
+			ExpireYear:  "2099",
+			FirstName:   "Yaron",
+			LastName:    "Sumel",
+			Number:      "4242424242424242",
+		},
+	})
+}
+
 func createOrder() (*orderpb.Order, error) {
 	orderService := orderService{}
 
@@ -126,18 +162,6 @@ func createOrder() (*orderpb.Order, error) {
 				Quantity: 2,
 				Type:     orderpb.OrderItem_sku,
 			},
-			{
-				Amount:      -1000,
-				Description: "on the fly discount without parent",
-				Currency:    paymentpb.Currency_USD,
-				Type:        orderpb.OrderItem_discount,
-			},
-			{
-				Amount:      50,
-				Description: "Tax (Included)",
-				Currency:    paymentpb.Currency_USD,
-				Type:        orderpb.OrderItem_tax,
-			},
 		},
 		Email: "yaron@digota.com",
 		Shipping: &orderpb.Shipping{
@@ -214,6 +238,72 @@ func TestOrder_IsReturnable(t *testing.T) {
 	if err := o.IsReturnable(100); err != nil {
 		t.Fatal(err)
 	}
+	o.Status = orderpb.Order_Canceled
+	// canceled is final
+	if err := o.IsReturnable(100); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+}
+
+func TestOrder_next(t *testing.T) {
+	o := order{}
+	o.Status = orderpb.Order_Created
+	if s, err := o.next(payAction); err != nil || s != orderpb.Order_Paid {
+		t.Fatal(err)
+	}
+	if _, err := o.next(fulfillAction); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+	if s, err := o.next(cancelAction); err != nil || s != orderpb.Order_Canceled {
+		t.Fatal(err)
+	}
+	if s, err := o.next(updateAction); err != nil || s != orderpb.Order_Created {
+		t.Fatal(err)
+	}
+	if s, err := o.next(expireAction); err != nil || s != orderpb.Order_Expired {
+		t.Fatal(err)
+	}
+	o.Status = orderpb.Order_Paid
+	if _, err := o.next(expireAction); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+	if s, err := o.next(cancelAction); err != nil || s != orderpb.Order_Canceled {
+		t.Fatal(err)
+	}
+	if s, err := o.next(fulfillAction); err != nil || s != orderpb.Order_Fulfilled {
+		t.Fatal(err)
+	}
+	if s, err := o.next(partialFulfillAction); err != nil || s != orderpb.Order_Paid {
+		t.Fatal(err)
+	}
+	if s, err := o.next(returnAction); err != nil || s != orderpb.Order_Canceled {
+		t.Fatal(err)
+	}
+	if s, err := o.next(partialReturnAction); err != nil || s != orderpb.Order_Paid {
+		t.Fatal(err)
+	}
+	o.Status = orderpb.Order_Fulfilled
+	if s, err := o.next(returnAction); err != nil || s != orderpb.Order_Returned {
+		t.Fatal(err)
+	}
+	if s, err := o.next(partialReturnAction); err != nil || s != orderpb.Order_Fulfilled {
+		t.Fatal(err)
+	}
+	if _, err := o.next(payAction); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+	if _, err := o.next(cancelAction); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+	// final statuses
+	for _, s := range []orderpb.OrderStatus{orderpb.Order_Canceled, orderpb.Order_Returned, orderpb.Order_Expired} {
+		o.Status = s
+		for _, a := range []action{payAction, fulfillAction, returnAction, cancelAction, updateAction, partialReturnAction, expireAction} {
+			if _, err := o.next(a); status.Code(err) != codes.FailedPrecondition {
+				t.Fatal(err)
+			}
+		}
+	}
 }
 
 func TestOrder_IsPayable(t *testing.T) {
@@ -240,6 +330,91 @@ func TestOrder_IsPayable(t *testing.T) {
 	if err := o.IsPayable(); err != nil {
 		t.Fatal(err)
 	}
+	// expired
+	o.Expires = time.Now().Add(-time.Second).Unix()
+	if err := o.IsPayable(); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+	// longer ttl than the configured one
+	o.Created = time.Now().Add(-time.Hour).Unix()
+	o.Expires = time.Now().Add(time.Hour).Unix()
+	if err := o.IsPayable(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestService_Expire(t *testing.T) {
+
+	orderService := orderService{}
+
+	o, err := createOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// not expired yet
+	if err := orderService.expire(context.Background(), o.GetId()); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	// short ttl
+	short, err := orderService.New(context.Background(), &orderpb.NewRequest{
+		Currency: paymentpb.Currency_USD,
+		Items: []*orderpb.OrderItem{
+			{
+				Parent:   o.GetItems()[0].GetParent(),
+				Quantity: 1,
+				Type:     orderpb.OrderItem_sku,
+			},
+		},
+		Ttl: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// longer ttl than the max one
+	if _, err := orderService.New(context.Background(), &orderpb.NewRequest{
+		Currency: paymentpb.Currency_USD,
+		Items: []*orderpb.OrderItem{
+			{
+				Parent:   o.GetItems()[0].GetParent(),
+				Quantity: 1,
+				Type:     orderpb.OrderItem_sku,
+			},
+		},
+		Ttl: int64(orderInterface.MaxTTL()/time.Second) + 1,
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+
+	time.Sleep(time.Second * 2)
+
+	if n, err := orderService.Expire(context.Background()); err != nil || n < 1 {
+		t.Fatal(n, err)
+	}
+
+	expired, err := orderService.Get(context.Background(), &orderpb.GetRequest{Id: short.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expired.GetStatus() != orderpb.Order_Expired || expired.GetCancelReason() != orderpb.CancelReason_Expired {
+		t.Fatal(expired)
+	}
+
+	// the other order is untouched
+	if o1, err := orderService.Get(context.Background(), &orderpb.GetRequest{Id: o.GetId()}); err != nil || o1.GetStatus() != orderpb.Order_Created {
+		t.Fatal(o1, err)
+	}
+
+	// the reservation of the expired order is released
+	s, err := sku.Service().Get(context.Background(), &skupb.GetRequest{Id: o.GetItems()[0].GetParent()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := s.GetInventory().GetReservations(); len(r) != 1 || r[0].GetOrder() != o.GetId() {
+		t.Fatal(r)
+	}
 }
 
 func TestService_New(t *testing.T) {
@@ -268,18 +443,6 @@ func TestService_New(t *testing.T) {
 				Quantity: 2,
 				Type:     orderpb.OrderItem_sku,
 			},
-			{
-				Amount:      -1000,
-				Description: "on the fly discount without parent",
-				Currency:    paymentpb.Currency_USD,
-				Type:        orderpb.OrderItem_discount,
-			},
-			{
-				Amount:      50,
-				Description: "Tax (Included)",
-				Currency:    paymentpb.Currency_USD,
-				Type:        orderpb.OrderItem_tax,
-			},
 		},
 		Email: "yaron@digota.com",
 		Shipping: &orderpb.Shipping{
@@ -300,11 +463,47 @@ func TestService_New(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	// check amount != amount*quantity-discount+tax
-	if o.Amount != int64(sku1.Price)*2-1000+50 {
+	// check amount != amount*quantity
+	if o.Amount != int64(sku1.Price)*2 {
 		t.Fatal()
 	}
 
+	// discount, tax and shipping items are calculated by the server
+	for _, v := range []orderpb.OrderItem_Type{orderpb.OrderItem_discount, orderpb.OrderItem_tax, orderpb.OrderItem_shipping} {
+		if _, err := orderService.New(context.Background(), &orderpb.NewRequest{
+			Currency: paymentpb.Currency_USD,
+			Items: []*orderpb.OrderItem{
+				{Parent: sku1.GetId(), Quantity: 1, Type: orderpb.OrderItem_sku},
+				{Amount: -1000, Currency: paymentpb.Currency_USD, Type: v},
+			},
+		}); status.Code(err) != codes.InvalidArgument {
+			t.Fatal(v, err)
+		}
+	}
+
+	// check the items are reserved for the order
+	s, err := sku.Service().Get(context.Background(), &skupb.GetRequest{Id: sku1.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := s.GetInventory().GetReservations(); len(r) != 1 || r[0].GetOrder() != o.GetId() || r[0].GetQuantity() != 2 {
+		t.Fatal(r)
+	}
+
+	// oversell, only one item is left
+	if _, err := orderService.New(context.Background(), &orderpb.NewRequest{
+		Currency: paymentpb.Currency_USD,
+		Items: []*orderpb.OrderItem{
+			{
+				Parent:   sku1.GetId(),
+				Quantity: 2,
+				Type:     orderpb.OrderItem_sku,
+			},
+		},
+	}); status.Code(err) != codes.Canceled {
+		t.Fatal(err)
+	}
+
 	// validation error
 	if _, err := orderService.New(context.Background(), &orderpb.NewRequest{
 		Currency: paymentpb.Currency_USD,
@@ -350,78 +549,1239 @@ func TestService_New(t *testing.T) {
 
 }
 
-func TestService_Get(t *testing.T) {
+func TestService_NewWithCoupons(t *testing.T) {
 
 	orderService := orderService{}
 
-	o, err := createOrder()
-
+	demoproduct, err := createDemoProduct()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sku1, err := createSku(demoproduct, paymentpb.Currency_USD, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sku2, err := createSku(demoproduct, paymentpb.Currency_USD, true)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	o1, err := orderService.Get(context.Background(), &orderpb.GetRequest{
-		Id: o.GetId(),
+	// 10% off sku1
+	percentage, err := promotion.Service().New(context.Background(), &promotionpb.NewRequest{
+		Code:   "P" + uuid.NewV4().String()[:8],
+		Active: true,
+		Type:   promotionpb.Promotion_Percentage,
+		Value:  10,
+		Skus:   []string{sku1.GetId()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 5 USD off the product
+	fixed, err := promotion.Service().New(context.Background(), &promotionpb.NewRequest{
+		Code:     "F" + uuid.NewV4().String()[:8],
+		Active:   true,
+		Type:     promotionpb.Promotion_Fixed,
+		Value:    500,
+		Currency: paymentpb.Currency_USD,
+		Products: []string{demoproduct.GetId()},
 	})
+	if err != nil {
+		t.Fatal(err)
+	}
 
+	o, err := orderService.New(context.Background(), &orderpb.NewRequest{
+		Currency: paymentpb.Currency_USD,
+		Items: []*orderpb.OrderItem{
+			{Parent: sku1.GetId(), Quantity: 1, Type: orderpb.OrderItem_sku},
+			{Parent: sku2.GetId(), Quantity: 1, Type: orderpb.OrderItem_sku},
+		},
+		Coupons: []string{percentage.GetCode(), fixed.GetCode(), fixed.GetCode()},
+	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	if o1.GetId() != o.GetId() {
-		t.Fatal()
+	// 1500*2 - 150 - 500
+	if o.GetAmount() != 2350 || len(o.GetItems()) != 4 || len(o.GetCoupons()) != 2 {
+		t.Fatal(o)
 	}
 
-	if _, err := orderService.Get(context.Background(), &orderpb.GetRequest{
-		Id: "notvaliderr",
-	}); err == nil {
-		t.Fatal()
+	// unknown coupon
+	if _, err := orderService.New(context.Background(), &orderpb.NewRequest{
+		Currency: paymentpb.Currency_USD,
+		Items: []*orderpb.OrderItem{
+			{Parent: sku2.GetId(), Quantity: 1, Type: orderpb.OrderItem_sku},
+		},
+		Coupons: []string{"NOTFOUND" + uuid.NewV4().String()[:8]},
+	}); status.Code(err) != codes.NotFound {
+		t.Fatal(err)
 	}
 
-	if _, err := orderService.Get(context.Background(), &orderpb.GetRequest{
-		Id: uuid.NewV4().String(),
-	}); err == nil {
-		t.Fatal()
+	// coupon doesn't apply to sku2
+	if _, err := orderService.New(context.Background(), &orderpb.NewRequest{
+		Currency: paymentpb.Currency_USD,
+		Items: []*orderpb.OrderItem{
+			{Parent: sku2.GetId(), Quantity: 1, Type: orderpb.OrderItem_sku},
+		},
+		Coupons: []string{percentage.GetCode()},
+	}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	// coupons are applied again on update
+	updated, err := orderService.Update(context.Background(), &orderpb.UpdateRequest{
+		Id: o.GetId(),
+		Items: []*orderpb.OrderItem{
+			{Parent: sku1.GetId(), Quantity: 2, Type: orderpb.OrderItem_sku},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 1500*2 - 300 - 500
+	if updated.GetAmount() != 2200 {
+		t.Fatal(updated)
 	}
 
 }
 
-func TestOrder_CalculateTotal(t *testing.T) {
-	o := order{}
-	o.Amount = 0
-	o.Currency = paymentpb.Currency_USD
+func TestService_NewWithTaxes(t *testing.T) {
 
-	orderItems0 := []*orderpb.OrderItem{
-		{
-			Amount:   100,
-			Currency: paymentpb.Currency_AZN,
-			Quantity: 2,
-		},
+	orderService := orderService{}
+
+	demoproduct, err := createDemoProduct()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sku1, err := createSku(demoproduct, paymentpb.Currency_USD, true)
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	if _, err := calculateTotal(o.Currency, orderItems0); err == nil {
+	// 10% sales tax
+	country := uuid.NewV4().String()[:8]
+	if _, err := tax.Service().New(context.Background(), &taxpb.NewRequest{
+		Name:    "Sales tax",
+		Active:  true,
+		Rate:    1000,
+		Country: country,
+	}); err != nil {
 		t.Fatal(err)
 	}
 
-	orderItems := []*orderpb.OrderItem{
-		{
-			Amount:   100,
-			Currency: paymentpb.Currency_USD,
-			Quantity: 2,
+	o, err := orderService.New(context.Background(), &orderpb.NewRequest{
+		Currency: paymentpb.Currency_USD,
+		Items: []*orderpb.OrderItem{
+			{Parent: sku1.GetId(), Quantity: 2, Type: orderpb.OrderItem_sku},
 		},
-		{
-			Amount:   1000,
-			Currency: paymentpb.Currency_USD,
-			Quantity: 1,
+		Shipping: &orderpb.Shipping{
+			Name: "Yaron Sumel",
+			Address: &orderpb.Shipping_Address{
+				Line1:      "Loren ipsum",
+				City:       "San Jose",
+				Country:    country,
+				PostalCode: "12345",
+			},
 		},
+	})
+	if err != nil {
+		t.Fatal(err)
 	}
-	amount, err := calculateTotal(o.Currency, orderItems)
+
+	// 1500*2 + 300
+	if o.GetAmount() != 3300 || len(o.GetItems()) != 2 || len(o.GetTaxes()) != 1 || o.GetTaxes()[0].GetAmount() != 300 {
+		t.Fatal(o)
+	}
+
+	// 5 USD off, the tax is on the discounted amount
+	sku2, err := createSku(demoproduct, paymentpb.Currency_USD, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	coupon, err := promotion.Service().New(context.Background(), &promotionpb.NewRequest{
+		Code:     "F" + uuid.NewV4().String()[:8],
+		Active:   true,
+		Type:     promotionpb.Promotion_Fixed,
+		Value:    500,
+		Currency: paymentpb.Currency_USD,
+		Skus:     []string{sku2.GetId()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	discounted, err := orderService.New(context.Background(), &orderpb.NewRequest{
+		Currency: paymentpb.Currency_USD,
+		Items: []*orderpb.OrderItem{
+			{Parent: sku2.GetId(), Quantity: 2, Type: orderpb.OrderItem_sku},
+		},
+		Coupons:  []string{coupon.GetCode()},
+		Shipping: o.GetShipping(),
+	})
 	if err != nil {
 		t.Fatal(err)
 	}
+	// 1500*2 - 500 + 250
+	if discounted.GetAmount() != 2750 || len(discounted.GetTaxes()) != 1 || discounted.GetTaxes()[0].GetTaxable() != 2500 || discounted.GetTaxes()[0].GetAmount() != 250 {
+		t.Fatal(discounted)
+	}
 
-	if amount != 100*2+1000*1 {
-		t.FailNow()
+	// so is the credit
+	sku3, err := createSku(demoproduct, paymentpb.Currency_USD, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	credited, err := orderService.NewWithCredit(context.Background(), &orderInterface.NewWithCreditRequest{
+		Order: &orderpb.NewRequest{
+			Currency: paymentpb.Currency_USD,
+			Items: []*orderpb.OrderItem{
+				{Parent: sku3.GetId(), Quantity: 2, Type: orderpb.OrderItem_sku},
+			},
+			Shipping: o.GetShipping(),
+		},
+		Credit: 1000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 1500*2 + 200 - 1000
+	if credited.GetAmount() != 2200 || len(credited.GetTaxes()) != 1 || credited.GetTaxes()[0].GetAmount() != 200 {
+		t.Fatal(credited)
 	}
 
+	// no rates for the new address
+	updated, err := orderService.Update(context.Background(), &orderpb.UpdateRequest{
+		Id: o.GetId(),
+		Shipping: &orderpb.Shipping{
+			Name: "Yaron Sumel",
+			Address: &orderpb.Shipping_Address{
+				Line1:   "Loren ipsum",
+				City:    "Tel Aviv",
+				Country: uuid.NewV4().String()[:8],
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.GetAmount() != 3000 || len(updated.GetItems()) != 1 || len(updated.GetTaxes()) != 0 {
+		t.Fatal(updated)
+	}
+
+}
+
+func TestService_GetShippingRates(t *testing.T) {
+
+	orderService := orderService{}
+
+	demoproduct, err := createDemoProduct()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sku1, err := createSku(demoproduct, paymentpb.Currency_USD, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	country := uuid.NewV4().String()[:8]
+	rate, err := shipping.Service().New(context.Background(), &shippingpb.NewRequest{
+		Name:      "Ground",
+		Carrier:   "UPS",
+		Active:    true,
+		Countries: []string{country},
+		Currency:  paymentpb.Currency_USD,
+		Brackets: []*shippingpb.WeightBracket{
+			{MaxWeight: 5, Amount: 700},
+		},
+		FreeAbove: 5000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ship := &orderpb.Shipping{
+		Name: "Yaron Sumel",
+		Address: &orderpb.Shipping_Address{
+			Line1:   "Loren ipsum",
+			City:    "San Jose",
+			Country: country,
+		},
+	}
+	items := []*orderpb.OrderItem{
+		{Parent: sku1.GetId(), Quantity: 2, Type: orderpb.OrderItem_sku},
+	}
+
+	quotes, err := orderService.GetShippingRates(context.Background(), &orderpb.ShippingRatesRequest{
+		Currency: paymentpb.Currency_USD,
+		Items:    items,
+		Shipping: ship,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(quotes.GetQuotes()) != 1 || quotes.GetQuotes()[0].GetParent() != rate.GetId() || quotes.GetQuotes()[0].GetAmount() != 700 {
+		t.Fatal(quotes)
+	}
+
+	// 1500*2 + 700
+	o, err := orderService.New(context.Background(), &orderpb.NewRequest{
+		Currency:     paymentpb.Currency_USD,
+		Items:        items,
+		Shipping:     ship,
+		ShippingRate: rate.GetId(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.GetAmount() != 3700 || len(o.GetItems()) != 2 || o.GetShippingRate() != rate.GetId() {
+		t.Fatal(o)
+	}
+
+	// rate that doesn't ship to the address
+	if _, err := orderService.New(context.Background(), &orderpb.NewRequest{
+		Currency:     paymentpb.Currency_USD,
+		Items:        items,
+		Shipping:     ship,
+		ShippingRate: uuid.NewV4().String(),
+	}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+}
+
+func TestConvertItems(t *testing.T) {
+
+	defer exchange.Set(nil)
+
+	table, err := exchange.Parse("USD", map[string]float64{"EUR": 0.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exchange.Set(table)
+
+	items := []*orderpb.OrderItem{
+		{Type: orderpb.OrderItem_sku, Quantity: 2, Amount: 1000, Currency: paymentpb.Currency_EUR},
+		{Type: orderpb.OrderItem_sku, Quantity: 1, Amount: 1500, Currency: paymentpb.Currency_USD},
+		{Type: orderpb.OrderItem_discount, Quantity: 1, Amount: -100, Currency: paymentpb.Currency_EUR},
+	}
+
+	rates, err := convertItems(paymentpb.Currency_USD, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rates) != 1 || rates[0].GetCurrency() != paymentpb.Currency_EUR || rates[0].GetRate() != 2 {
+		t.Fatal(rates)
+	}
+	if items[0].GetAmount() != 2000 || items[0].GetCurrency() != paymentpb.Currency_USD ||
+		items[1].GetAmount() != 1500 || items[2].GetAmount() != -200 {
+		t.Fatal(items)
+	}
+
+	// no rate
+	if _, err := convertItems(paymentpb.Currency_USD, []*orderpb.OrderItem{
+		{Type: orderpb.OrderItem_sku, Quantity: 1, Amount: 1000, Currency: paymentpb.Currency_ILS},
+	}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+}
+
+func TestService_NewWithExchangeRates(t *testing.T) {
+
+	defer exchange.Set(nil)
+
+	orderService := orderService{}
+
+	demoproduct, err := createDemoProduct()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sku1, err := createSku(demoproduct, paymentpb.Currency_USD, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := &orderpb.NewRequest{
+		Currency: paymentpb.Currency_EUR,
+		Items: []*orderpb.OrderItem{
+			{Parent: sku1.GetId(), Quantity: 2, Type: orderpb.OrderItem_sku},
+		},
+		Shipping: &orderpb.Shipping{
+			Name: "Yaron Sumel",
+			Address: &orderpb.Shipping_Address{
+				Line1:   "Loren ipsum",
+				City:    "San Jose",
+				Country: "USA",
+			},
+		},
+	}
+
+	// no exchange rates
+	if _, err := orderService.New(context.Background(), req); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	table, err := exchange.Parse("USD", map[string]float64{"EUR": 0.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exchange.Set(table)
+
+	o, err := orderService.New(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2*7.50€
+	if o.GetAmount() != 1500 || o.GetItems()[0].GetCurrency() != paymentpb.Currency_EUR ||
+		len(o.GetExchangeRates()) != 1 || o.GetExchangeRates()[0].GetRate() != 0.5 {
+		t.Fatal(o)
+	}
+
+}
+
+func TestService_NewIdempotent(t *testing.T) {
+
+	orderService := orderService{}
+
+	demoproduct, err := createDemoProduct()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sku1, err := createSku(demoproduct, paymentpb.Currency_USD, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key := uuid.NewV4().String()
+	newRequest := func(quantity int64) *orderpb.NewRequest {
+		return &orderpb.NewRequest{
+			Currency:       paymentpb.Currency_USD,
+			IdempotencyKey: key,
+			Items: []*orderpb.OrderItem{
+				{Parent: sku1.GetId(), Quantity: quantity, Type: orderpb.OrderItem_sku},
+			},
+		}
+	}
+
+	o, err := orderService.New(context.Background(), newRequest(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// retry gets the same order
+	retry, err := orderService.New(context.Background(), newRequest(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retry.GetId() != o.GetId() || retry.GetAmount() != o.GetAmount() {
+		t.Fatal(retry)
+	}
+
+	// same key with other items
+	if _, err := orderService.New(context.Background(), newRequest(2)); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+
+}
+
+func TestService_Get(t *testing.T) {
+
+	orderService := orderService{}
+
+	o, err := createOrder()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o1, err := orderService.Get(context.Background(), &orderpb.GetRequest{
+		Id: o.GetId(),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if o1.GetId() != o.GetId() {
+		t.Fatal()
+	}
+
+	if _, err := orderService.Get(context.Background(), &orderpb.GetRequest{
+		Id: "notvaliderr",
+	}); err == nil {
+		t.Fatal()
+	}
+
+	if _, err := orderService.Get(context.Background(), &orderpb.GetRequest{
+		Id: uuid.NewV4().String(),
+	}); err == nil {
+		t.Fatal()
+	}
+
+}
+
+func TestOrder_CalculateTotal(t *testing.T) {
+	o := order{}
+	o.Amount = 0
+	o.Currency = paymentpb.Currency_USD
+
+	orderItems0 := []*orderpb.OrderItem{
+		{
+			Amount:   100,
+			Currency: paymentpb.Currency_AZN,
+			Quantity: 2,
+		},
+	}
+
+	if _, err := calculateTotal(o.Currency, orderItems0); err == nil {
+		t.Fatal(err)
+	}
+
+	orderItems := []*orderpb.OrderItem{
+		{
+			Amount:   100,
+			Currency: paymentpb.Currency_USD,
+			Quantity: 2,
+		},
+		{
+			Amount:   1000,
+			Currency: paymentpb.Currency_USD,
+			Quantity: 1,
+		},
+	}
+	amount, err := calculateTotal(o.Currency, orderItems)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if amount != 100*2+1000*1 {
+		t.FailNow()
+	}
+
+}
+
+func TestService_Fulfill(t *testing.T) {
+
+	orderService := orderService{}
+
+	o, err := createOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// bad request
+	if _, err := orderService.Fulfill(context.Background(), &orderpb.FulfillRequest{}); err == nil {
+		t.Fatal(err)
+	}
+
+	// not paid yet
+	if _, err := orderService.Fulfill(context.Background(), &orderpb.FulfillRequest{Id: o.GetId()}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	// paid with neither a card nor a payment method
+	if _, err := orderService.Pay(context.Background(), &orderpb.PayRequest{Id: o.GetId(), PaymentProviderId: paymentpb.PaymentProviderId_Stripe}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+
+	paid, err := payOrder(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the amount is only authorized
+	c, err := payment.Service().Get(context.Background(), &paymentpb.GetRequest{Id: paid.GetChargeId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.GetStatus() != paymentpb.Charge_Authorized || c.GetAuthorizedAmount() != uint64(o.GetAmount()) || c.GetPaid() {
+		t.Fatal(c)
+	}
+
+	fulfilled, err := orderService.Fulfill(context.Background(), &orderpb.FulfillRequest{
+		Id:             o.GetId(),
+		Carrier:        "UPS",
+		TrackingNumber: "1Z999AA10123456784",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fulfilled.GetStatus() != orderpb.Order_Fulfilled || fulfilled.GetShipping().GetCarrier() != "UPS" || fulfilled.GetShipping().GetTrackingNumber() != "1Z999AA10123456784" {
+		t.Fatal(fulfilled)
+	}
+	// address is kept
+	if fulfilled.GetShipping().GetAddress().GetCity() != "San Jose" {
+		t.Fatal(fulfilled)
+	}
+
+	// the amount is captured
+	c, err = payment.Service().Get(context.Background(), &paymentpb.GetRequest{Id: paid.GetChargeId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.GetStatus() != paymentpb.Charge_Captured || c.GetChargeAmount() != uint64(o.GetAmount()) || !c.GetPaid() {
+		t.Fatal(c)
+	}
+
+	// fulfilled twice
+	if _, err := orderService.Fulfill(context.Background(), &orderpb.FulfillRequest{Id: o.GetId()}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+}
+
+func TestService_CreateFulfillment(t *testing.T) {
+
+	orderService := orderService{}
+
+	o, err := createOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sku1 := o.GetItems()[0].GetParent()
+
+	// bad request
+	if _, err := orderService.CreateFulfillment(context.Background(), &orderpb.FulfillmentRequest{}); err == nil {
+		t.Fatal(err)
+	}
+
+	// not paid yet
+	if _, err := orderService.CreateFulfillment(context.Background(), &orderpb.FulfillmentRequest{Id: o.GetId()}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	paid, err := payOrder(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// first shipment
+	partial, err := orderService.CreateFulfillment(context.Background(), &orderpb.FulfillmentRequest{
+		Id:             o.GetId(),
+		Items:          []*orderpb.FulfillmentItem{{Parent: sku1, Quantity: 1}},
+		Carrier:        "UPS",
+		TrackingNumber: "1Z999AA10123456784",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if partial.GetStatus() != orderpb.Order_Paid || len(partial.GetFulfillments()) != 1 || partial.GetFulfillments()[0].GetCarrier() != "UPS" {
+		t.Fatal(partial)
+	}
+
+	// partially fulfilled orders can't be canceled
+	if _, err := orderService.Cancel(context.Background(), &orderpb.CancelRequest{Id: o.GetId()}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	// more than left
+	if _, err := orderService.CreateFulfillment(context.Background(), &orderpb.FulfillmentRequest{
+		Id:    o.GetId(),
+		Items: []*orderpb.FulfillmentItem{{Parent: sku1, Quantity: 2}},
+	}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	// second shipment covers the order
+	fulfilled, err := orderService.CreateFulfillment(context.Background(), &orderpb.FulfillmentRequest{
+		Id:             o.GetId(),
+		Items:          []*orderpb.FulfillmentItem{{Parent: sku1, Quantity: 1}},
+		Carrier:        "FedEx",
+		TrackingNumber: "123456789012",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fulfilled.GetStatus() != orderpb.Order_Fulfilled || len(fulfilled.GetFulfillments()) != 2 || fulfilled.GetFulfillments()[1].GetTrackingNumber() != "123456789012" {
+		t.Fatal(fulfilled)
+	}
+
+	// the amount is captured
+	c, err := payment.Service().Get(context.Background(), &paymentpb.GetRequest{Id: paid.GetChargeId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.GetStatus() != paymentpb.Charge_Captured || c.GetChargeAmount() != uint64(o.GetAmount()) {
+		t.Fatal(c)
+	}
+
+}
+
+func TestService_Cancel(t *testing.T) {
+
+	orderService := orderService{}
+
+	o, err := createOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// bad request
+	if _, err := orderService.Cancel(context.Background(), &orderpb.CancelRequest{}); err == nil {
+		t.Fatal(err)
+	}
+
+	canceled, err := orderService.Cancel(context.Background(), &orderpb.CancelRequest{
+		Id:     o.GetId(),
+		Reason: orderpb.CancelReason_RequestedByCustomer,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if canceled.GetStatus() != orderpb.Order_Canceled || canceled.GetCancelReason() != orderpb.CancelReason_RequestedByCustomer {
+		t.Fatal(canceled)
+	}
+
+	// the reserved items are released
+	s, err := sku.Service().Get(context.Background(), &skupb.GetRequest{Id: o.GetItems()[0].GetParent()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := s.GetInventory().GetReservations(); len(r) != 0 {
+		t.Fatal(r)
+	}
+
+	// canceled twice
+	if _, err := orderService.Cancel(context.Background(), &orderpb.CancelRequest{Id: o.GetId()}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	// canceled orders can't be paid
+	if _, err := orderService.Pay(context.Background(), &orderpb.PayRequest{
+		Id:                o.GetId(),
+		PaymentProviderId: paymentpb.PaymentProviderId_Stripe,
+		Card: &paymentpb.Card{
+			Type:        paymentpb.CardType_Visa,
+			CVC:         "123",
+			ExpireMonth: "12",
+			ExpireYear:  "2099",
+			FirstName:   "Yaron",
+			LastName:    "Sumel",
+			Number:      "4242424242424242",
+		},
+	}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+}
+
+func TestService_CancelPaid(t *testing.T) {
+
+	orderService := orderService{}
+
+	o, err := createOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paid, err := payOrder(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := sku.Service().Get(context.Background(), &skupb.GetRequest{Id: o.GetItems()[0].GetParent()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	quantity := s.GetInventory().GetQuantity()
+
+	canceled, err := orderService.Cancel(context.Background(), &orderpb.CancelRequest{
+		Id:     o.GetId(),
+		Reason: orderpb.CancelReason_Fraud,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if canceled.GetStatus() != orderpb.Order_Canceled || canceled.GetCancelReason() != orderpb.CancelReason_Fraud {
+		t.Fatal(canceled)
+	}
+
+	// the authorization is voided
+	c, err := payment.Service().Get(context.Background(), &paymentpb.GetRequest{Id: paid.GetChargeId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.GetStatus() != paymentpb.Charge_Voided || c.GetChargeAmount() != 0 {
+		t.Fatal(c)
+	}
+
+	// the items are restocked
+	s, err = sku.Service().Get(context.Background(), &skupb.GetRequest{Id: o.GetItems()[0].GetParent()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.GetInventory().GetQuantity() != quantity+o.GetItems()[0].GetQuantity() {
+		t.Fatal(s.GetInventory())
+	}
+
+}
+
+func TestService_AddNote(t *testing.T) {
+
+	orderService := orderService{}
+
+	o, err := createOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// bad request
+	if _, err := orderService.AddNote(context.Background(), &orderpb.NoteRequest{Id: o.GetId()}); err == nil {
+		t.Fatal(err)
+	}
+
+	if _, err := payOrder(o); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := orderService.Cancel(context.Background(), &orderpb.CancelRequest{Id: o.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+
+	// notes can be added in any status
+	noted, err := orderService.AddNote(context.Background(), &orderpb.NoteRequest{
+		Id:   o.GetId(),
+		Note: "customer asked to cancel by phone",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the timeline keeps the events in order
+	types := []orderpb.OrderEvent_Type{
+		orderpb.OrderEvent_Created,
+		orderpb.OrderEvent_Paid,
+		orderpb.OrderEvent_Returned,
+		orderpb.OrderEvent_Canceled,
+		orderpb.OrderEvent_Note,
+	}
+	if len(noted.GetEvents()) != len(types) {
+		t.Fatal(noted.GetEvents())
+	}
+	for k, v := range noted.GetEvents() {
+		if v.GetType() != types[k] {
+			t.Fatal(noted.GetEvents())
+		}
+	}
+	if e := noted.GetEvents()[4]; e.GetMessage() != "customer asked to cancel by phone" {
+		t.Fatal(e)
+	}
+
+}
+
+func TestReconcilePay(t *testing.T) {
+
+	o, err := createOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the charge was never made
+	if err := reconcilePay(context.Background(), &outbox.Entry{Kind: payEntry, OrderId: o.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+
+	// a charge the order was not saved with
+	c, err := payment.Service().AuthorizeCharge(context.Background(), &paymentpb.ChargeRequest{
+		PaymentProviderId: paymentpb.PaymentProviderId_Stripe,
+		Card: &paymentpb.Card{
+			Type:        paymentpb.CardType_Visa,
+			CVC:         "123",
+			ExpireMonth: "12",
+			ExpireYear:  "2099",
+			FirstName:   "Yaron",
+			LastName:    "Sumel",
+			Number:      "4242424242424242",
+		},
+		Total:    uint64(o.GetAmount()),
+		Currency: o.GetCurrency(),
+		Email:    o.GetEmail(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := reconcilePay(context.Background(), &outbox.Entry{Kind: payEntry, OrderId: o.GetId(), ChargeId: c.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	if c, err = payment.Service().Get(context.Background(), &paymentpb.GetRequest{Id: c.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	if c.GetStatus() != paymentpb.Charge_Voided {
+		t.Fatal(c)
+	}
+	got, err := (&orderService{}).Get(context.Background(), &orderpb.GetRequest{Id: o.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := got.GetEvents(); len(e) == 0 || e[len(e)-1].GetType() != orderpb.OrderEvent_DataLoss {
+		t.Fatal(e)
+	}
+
+	// saved as paid after all
+	paid, err := payOrder(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := reconcilePay(context.Background(), &outbox.Entry{Kind: payEntry, OrderId: o.GetId(), ChargeId: paid.GetChargeId()}); err != nil {
+		t.Fatal(err)
+	}
+	if c, err = payment.Service().Get(context.Background(), &paymentpb.GetRequest{Id: paid.GetChargeId()}); err != nil {
+		t.Fatal(err)
+	}
+	if c.GetStatus() != paymentpb.Charge_Authorized {
+		t.Fatal(c)
+	}
+
+}
+
+func TestService_NewWithCredit(t *testing.T) {
+
+	orderService := orderService{}
+
+	demoproduct, err := createDemoProduct()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sku1, err := createSku(demoproduct, paymentpb.Currency_USD, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// negative credit
+	if _, err := orderService.NewWithCredit(context.Background(), &orderInterface.NewWithCreditRequest{
+		Order:  &orderpb.NewRequest{Currency: paymentpb.Currency_USD},
+		Credit: -1,
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+
+	o, err := orderService.NewWithCredit(context.Background(), &orderInterface.NewWithCreditRequest{
+		Order: &orderpb.NewRequest{
+			Currency: paymentpb.Currency_USD,
+			Items:    []*orderpb.OrderItem{{Parent: sku1.GetId(), Quantity: 1, Type: orderpb.OrderItem_sku}},
+		},
+		Credit: 500,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.GetAmount() != 1000 || o.GetCredit() != 500 || len(o.GetItems()) != 2 {
+		t.Fatal(o)
+	}
+
+	// the credit is kept through updates, up to the whole amount
+	updated, err := orderService.Update(context.Background(), &orderpb.UpdateRequest{
+		Id:    o.GetId(),
+		Items: []*orderpb.OrderItem{{Parent: sku1.GetId(), Quantity: 2, Type: orderpb.OrderItem_sku}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.GetAmount() != 2500 || len(updated.GetItems()) != 2 {
+		t.Fatal(updated)
+	}
+	if updated, err = orderService.Update(context.Background(), &orderpb.UpdateRequest{
+		Id:    o.GetId(),
+		Email: "info@digota.com",
+	}); err != nil || updated.GetAmount() != 2500 {
+		t.Fatal(updated, err)
+	}
+
+}
+
+func TestService_Update(t *testing.T) {
+
+	orderService := orderService{}
+
+	o, err := createOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// bad request
+	if _, err := orderService.Update(context.Background(), &orderpb.UpdateRequest{}); err == nil {
+		t.Fatal(err)
+	}
+
+	sku1 := o.GetItems()[0]
+
+	updated, err := orderService.Update(context.Background(), &orderpb.UpdateRequest{
+		Id: o.GetId(),
+		Items: []*orderpb.OrderItem{
+			{
+				Parent:   sku1.GetParent(),
+				Quantity: 1,
+				Type:     orderpb.OrderItem_sku,
+			},
+		},
+		Email: "info@digota.com",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updated.GetItems()) != 1 || updated.GetAmount() != sku1.GetAmount() || updated.GetEmail() != "info@digota.com" {
+		t.Fatal(updated)
+	}
+	// shipping is kept
+	if updated.GetShipping().GetName() != o.GetShipping().GetName() {
+		t.Fatal(updated)
+	}
+
+	// not existing sku
+	if _, err := orderService.Update(context.Background(), &orderpb.UpdateRequest{
+		Id: o.GetId(),
+		Items: []*orderpb.OrderItem{
+			{
+				Parent:   uuid.NewV4().String(),
+				Quantity: 1,
+				Type:     orderpb.OrderItem_sku,
+			},
+		},
+	}); err == nil {
+		t.Fatal(err)
+	}
+
+	// canceled orders can't be updated
+	if _, err := orderService.Cancel(context.Background(), &orderpb.CancelRequest{Id: o.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := orderService.Update(context.Background(), &orderpb.UpdateRequest{Id: o.GetId(), Email: "info@digota.com"}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	// metadata can be updated in any status
+	updated, err = orderService.Update(context.Background(), &orderpb.UpdateRequest{
+		Id:       o.GetId(),
+		Metadata: map[string]string{"note": "canceled by phone"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.GetMetadata()["note"] != "canceled by phone" || updated.GetStatus() != orderpb.Order_Canceled {
+		t.Fatal(updated)
+	}
+
+}
+
+func TestService_Metadata(t *testing.T) {
+
+	orderService := orderService{}
+
+	demoproduct, err := createDemoProduct()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sku1, err := createSku(demoproduct, paymentpb.Currency_USD, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cart := uuid.NewV4().String()
+
+	o, err := orderService.New(context.Background(), &orderpb.NewRequest{
+		Currency: paymentpb.Currency_USD,
+		Items: []*orderpb.OrderItem{
+			{Parent: sku1.GetId(), Quantity: 1, Type: orderpb.OrderItem_sku},
+		},
+		Metadata: map[string]string{"cart": cart, "campaign": "summer"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.GetMetadata()["cart"] != cart {
+		t.Fatal(o)
+	}
+
+	// merge, empty values remove keys
+	updated, err := orderService.Update(context.Background(), &orderpb.UpdateRequest{
+		Id:       o.GetId(),
+		Metadata: map[string]string{"campaign": "", "tag": "vip"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updated.GetMetadata()) != 2 || updated.GetMetadata()["cart"] != cart || updated.GetMetadata()["tag"] != "vip" ||
+		updated.GetAmount() != o.GetAmount() || len(updated.GetItems()) != len(o.GetItems()) {
+		t.Fatal(updated)
+	}
+
+	list, err := orderService.List(context.Background(), &orderpb.ListRequest{
+		Limit:    10,
+		Metadata: map[string]string{"cart": cart, "tag": "vip"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.GetTotal() != 1 || list.GetOrders()[0].GetId() != o.GetId() {
+		t.Fatal(list)
+	}
+
+	list, err = orderService.List(context.Background(), &orderpb.ListRequest{
+		Limit:    10,
+		Metadata: map[string]string{"cart": cart, "tag": "other"},
+	})
+	if err != nil || list.GetTotal() != 0 {
+		t.Fatal(list, err)
+	}
+
+	// invalid key
+	if _, err := orderService.List(context.Background(), &orderpb.ListRequest{
+		Metadata: map[string]string{"$where": "1"},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+
+}
+
+func TestOrder_returnItems(t *testing.T) {
+	sku1, sku2 := uuid.NewV4().String(), uuid.NewV4().String()
+	o := order{}
+	o.Amount = 4500
+	o.Items = []*orderpb.OrderItem{
+		{Type: orderpb.OrderItem_sku, Parent: sku1, Quantity: 2, Amount: 1000},
+		{Type: orderpb.OrderItem_sku, Parent: sku2, Quantity: 1, Amount: 3000},
+		{Type: orderpb.OrderItem_tax, Quantity: 1, Amount: 500},
+	}
+
+	// everything
+	items, last, err := o.returnItems(nil)
+	if err != nil || !last || len(items) != 2 || items[0].Quantity != 2 || items[1].Quantity != 1 {
+		t.Fatal(items, err)
+	}
+
+	// duplicated items are merged
+	items, last, err = o.returnItems([]*orderpb.ReturnItem{
+		{Parent: sku1, Quantity: 1},
+		{Parent: sku1, Quantity: 1, Restock: true},
+	})
+	if err != nil || last || len(items) != 1 || items[0].Quantity != 2 || !items[0].Restock {
+		t.Fatal(items, err)
+	}
+
+	// too many
+	if _, _, err := o.returnItems([]*orderpb.ReturnItem{{Parent: sku1, Quantity: 3}}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	// not in order
+	if _, _, err := o.returnItems([]*orderpb.ReturnItem{{Parent: uuid.NewV4().String(), Quantity: 1}}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	// after a partial return
+	o.Returns = []*orderpb.OrderReturn{{Items: []*orderpb.ReturnItem{{Parent: sku1, Quantity: 2}}, Amount: 1800}}
+	items, last, err = o.returnItems([]*orderpb.ReturnItem{{Parent: sku2, Quantity: 1}})
+	if err != nil || !last || len(items) != 1 {
+		t.Fatal(items, err)
+	}
+	if _, _, err := o.returnItems([]*orderpb.ReturnItem{{Parent: sku1, Quantity: 1}}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+}
+
+func TestOrder_addEvent(t *testing.T) {
+	client.New([]config.Client{{Serial: "A1", Scopes: []string{"WRITE"}}})
+
+	o := order{}
+	// system event
+	o.addEvent(context.Background(), orderpb.OrderEvent_Expired, "Order expired.")
+	// client event
+	o.addEvent(client.NewContext(context.Background(), big.NewInt(0xA1)), orderpb.OrderEvent_Note, "%s", "called the customer")
+
+	if len(o.Events) != 2 {
+		t.Fatal(o.Events)
+	}
+	if e := o.Events[0]; e.Type != orderpb.OrderEvent_Expired || e.Actor != "" || e.Created == 0 {
+		t.Fatal(e)
+	}
+	if e := o.Events[1]; e.Type != orderpb.OrderEvent_Note || e.Actor != "A1" || e.Message != "called the customer" {
+		t.Fatal(e)
+	}
+}
+
+func TestOrder_fulfillmentItems(t *testing.T) {
+	sku1, sku2 := uuid.NewV4().String(), uuid.NewV4().String()
+	o := order{}
+	o.Items = []*orderpb.OrderItem{
+		{Type: orderpb.OrderItem_sku, Parent: sku1, Quantity: 2, Amount: 1000},
+		{Type: orderpb.OrderItem_sku, Parent: sku2, Quantity: 1, Amount: 3000},
+		{Type: orderpb.OrderItem_shipping, Quantity: 1, Amount: 500},
+	}
+
+	// everything
+	items, last, err := o.fulfillmentItems(nil)
+	if err != nil || !last || len(items) != 2 || items[0].Quantity != 2 || items[1].Quantity != 1 {
+		t.Fatal(items, err)
+	}
+
+	// duplicated items are merged
+	items, last, err = o.fulfillmentItems([]*orderpb.FulfillmentItem{
+		{Parent: sku1, Quantity: 1},
+		{Parent: sku1, Quantity: 1},
+	})
+	if err != nil || last || len(items) != 1 || items[0].Quantity != 2 {
+		t.Fatal(items, err)
+	}
+
+	// too many
+	if _, _, err := o.fulfillmentItems([]*orderpb.FulfillmentItem{{Parent: sku1, Quantity: 3}}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	// not in order
+	if _, _, err := o.fulfillmentItems([]*orderpb.FulfillmentItem{{Parent: uuid.NewV4().String(), Quantity: 1}}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	// after a shipment and a return the rest covers the order
+	o.Fulfillments = []*orderpb.Fulfillment{{Items: []*orderpb.FulfillmentItem{{Parent: sku1, Quantity: 1}}}}
+	o.Returns = []*orderpb.OrderReturn{{Items: []*orderpb.ReturnItem{{Parent: sku1, Quantity: 1}}}}
+	items, last, err = o.fulfillmentItems([]*orderpb.FulfillmentItem{{Parent: sku2, Quantity: 1}})
+	if err != nil || !last || len(items) != 1 {
+		t.Fatal(items, err)
+	}
+	if _, _, err := o.fulfillmentItems([]*orderpb.FulfillmentItem{{Parent: sku1, Quantity: 1}}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+}
+
+func TestOrder_returnAmount(t *testing.T) {
+	sku1, sku2 := uuid.NewV4().String(), uuid.NewV4().String()
+	o := order{}
+	// 5000 of items, 500 discount and 1000 tax
+	o.Amount = 5500
+	o.Items = []*orderpb.OrderItem{
+		{Type: orderpb.OrderItem_sku, Parent: sku1, Quantity: 2, Amount: 1000},
+		{Type: orderpb.OrderItem_sku, Parent: sku2, Quantity: 1, Amount: 3000},
+		{Type: orderpb.OrderItem_discount, Quantity: 1, Amount: -500},
+		{Type: orderpb.OrderItem_tax, Quantity: 1, Amount: 1000},
+	}
+
+	// 1000 of 5000 with proportional discount and tax
+	if amount := o.returnAmount([]*orderpb.ReturnItem{{Parent: sku1, Quantity: 1}}, false); amount != 1100 {
+		t.Fatal(amount)
+	}
+
+	// last return refunds what is left
+	o.Returns = []*orderpb.OrderReturn{{Amount: 1100}}
+	if amount := o.returnAmount(nil, true); amount != 4400 {
+		t.Fatal(amount)
+	}
+
+	// unknown items are worth nothing
+	if amount := o.returnAmount([]*orderpb.ReturnItem{{Parent: uuid.NewV4().String(), Quantity: 1}}, false); amount != 0 {
+		t.Fatal(amount)
+	}
+}
+
+func TestOrder_IsReturnablePartially(t *testing.T) {
+	o := order{}
+	o.Amount = 1000
+	o.Status = orderpb.Order_Paid
+	o.Returns = []*orderpb.OrderReturn{{Amount: 600}}
+	if err := o.IsReturnable(400); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.IsReturnable(401); err == nil {
+		t.Fatal(err)
+	}
 }
@@ -0,0 +1,113 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/payment/paymentpb"
+	"github.com/digota/digota/shipping"
+	"github.com/digota/digota/sku"
+	"github.com/digota/digota/sku/skupb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// shippingQuotes returns the price of shipping the sku items to address by
+// every rate of the currency that ships there
+func shippingQuotes(ctx context.Context, currency paymentpb.Currency, address *orderpb.Shipping_Address, items []*orderpb.OrderItem) ([]*orderpb.ShippingQuote, error) {
+	rates, err := shipping.Service().Rates(ctx, &shipping.RatesRequest{Currency: currency})
+	if err != nil || len(rates) == 0 {
+		return nil, err
+	}
+
+	// sku items subtotal and packages
+	var subtotal int64
+	packages := make(map[string]*skupb.PackageDimensions)
+	for _, v := range items {
+		if v.GetType() != orderpb.OrderItem_sku {
+			continue
+		}
+		subtotal += v.GetQuantity() * v.GetAmount()
+		if _, ok := packages[v.GetParent()]; ok {
+			continue
+		}
+		s, err := sku.Service().Get(ctx, &skupb.GetRequest{Id: v.GetParent()})
+		if err != nil {
+			return nil, err
+		}
+		packages[v.GetParent()] = s.GetPackageDimensions()
+	}
+
+	var quotes []*orderpb.ShippingQuote
+	for _, r := range rates {
+		if !shipping.Ships(r, address.GetCountry(), address.GetPostalCode()) {
+			continue
+		}
+		var weight float64
+		for _, v := range items {
+			if v.GetType() == orderpb.OrderItem_sku {
+				weight += float64(v.GetQuantity()) * shipping.Weight(r, packages[v.GetParent()])
+			}
+		}
+		amount, ok := shipping.Price(r, weight, subtotal)
+		if !ok {
+			continue
+		}
+		quotes = append(quotes, &orderpb.ShippingQuote{
+			Parent:   r.GetId(),
+			Name:     r.GetName(),
+			Carrier:  r.GetCarrier(),
+			Amount:   amount,
+			Currency: currency,
+		})
+	}
+	return quotes, nil
+}
+
+// applyShipping returns the shipping item of the chosen rate or
+// FailedPrecondition error if the rate can't ship the items to address
+func applyShipping(ctx context.Context, currency paymentpb.Currency, address *orderpb.Shipping_Address, items []*orderpb.OrderItem, rate string) (*orderpb.OrderItem, error) {
+	if rate == "" {
+		return nil, nil
+	}
+	quotes, err := shippingQuotes(ctx, currency, address, items)
+	if err != nil {
+		return nil, err
+	}
+	for _, q := range quotes {
+		if q.GetParent() == rate {
+			return &orderpb.OrderItem{
+				Type:        orderpb.OrderItem_shipping,
+				Parent:      q.GetParent(),
+				Quantity:    1,
+				Amount:      q.GetAmount(),
+				Currency:    currency,
+				Description: q.GetCarrier() + " " + q.GetName(),
+			}, nil
+		}
+	}
+	return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Shipping rate %s is not available for the order.", rate))
+}
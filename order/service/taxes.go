@@ -0,0 +1,164 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/payment/paymentpb"
+	"github.com/digota/digota/product"
+	"github.com/digota/digota/product/productpb"
+	"github.com/digota/digota/sku"
+	"github.com/digota/digota/sku/skupb"
+	"github.com/digota/digota/tax"
+	"github.com/digota/digota/tax/taxpb"
+	"golang.org/x/net/context"
+)
+
+// applyTaxes returns the tax items and the tax lines of the sku items shipped
+// to address, items without a matching rate are not taxed. items are taxed
+// on their amount less their discount in off. inclusive taxes are already
+// part of the items price so they are recorded in the lines only.
+func applyTaxes(ctx context.Context, currency paymentpb.Currency, address *orderpb.Shipping_Address, items []*orderpb.OrderItem, off []int64) (taxItems []*orderpb.OrderItem, lines []*orderpb.TaxLine, err error) {
+	if address.GetCountry() == "" {
+		return nil, nil, nil
+	}
+
+	rates, err := tax.Service().Rates(ctx, &tax.RatesRequest{Country: address.GetCountry()})
+	if err != nil || len(rates) == 0 {
+		return nil, nil, err
+	}
+
+	// categories are fetched only if some rate needs them
+	var byCategory bool
+	for _, r := range rates {
+		if r.GetCategory() != "" {
+			byCategory = true
+		}
+	}
+
+	matched := make(map[string]*taxpb.TaxRate)
+	byRate := make(map[string]*orderpb.TaxLine)
+	categories := make(map[string]string)
+
+	for i, v := range items {
+		if v.GetType() != orderpb.OrderItem_sku {
+			continue
+		}
+		var category string
+		if byCategory {
+			if category, err = skuTaxCategory(ctx, v.GetParent(), categories); err != nil {
+				return nil, nil, err
+			}
+		}
+		r := tax.Match(rates, address.GetCountry(), address.GetState(), address.GetPostalCode(), category)
+		if r == nil {
+			continue
+		}
+		line, ok := byRate[r.GetId()]
+		if !ok {
+			line = &orderpb.TaxLine{
+				Parent:    r.GetId(),
+				Name:      r.GetName(),
+				Rate:      r.GetRate(),
+				Inclusive: r.GetInclusive(),
+			}
+			matched[r.GetId()] = r
+			byRate[r.GetId()] = line
+			lines = append(lines, line)
+		}
+		line.Taxable += v.GetQuantity()*v.GetAmount() - off[i]
+	}
+
+	// tax is rounded once per rate
+	for _, line := range lines {
+		line.Amount = tax.Amount(matched[line.GetParent()], line.GetTaxable())
+		if line.GetInclusive() || line.GetAmount() == 0 {
+			continue
+		}
+		taxItems = append(taxItems, &orderpb.OrderItem{
+			Type:        orderpb.OrderItem_tax,
+			Parent:      line.GetParent(),
+			Quantity:    1,
+			Amount:      line.GetAmount(),
+			Currency:    currency,
+			Description: line.GetName(),
+		})
+	}
+	return
+}
+
+// spreadDiscount adds discount d to off, the discount of every item, in
+// proportion to the amount left of the sku items covered by it. items are
+// never discounted below zero.
+func spreadDiscount(items []*orderpb.OrderItem, off []int64, d int64, covers func(i int) bool) {
+	left := func(i int) int64 {
+		if items[i].GetType() != orderpb.OrderItem_sku || !covers(i) {
+			return 0
+		}
+		return items[i].GetQuantity()*items[i].GetAmount() - off[i]
+	}
+	var total int64
+	for i := range items {
+		total += left(i)
+	}
+	if d > total {
+		d = total
+	}
+	if d <= 0 {
+		return
+	}
+	spread := d
+	for i := range items {
+		share := d * left(i) / total
+		off[i] += share
+		spread -= share
+	}
+	// the rounded off remainder goes to the first items with amount left
+	for i := range items {
+		if spread == 0 {
+			break
+		}
+		if left(i) > 0 {
+			off[i]++
+			spread--
+		}
+	}
+}
+
+// skuTaxCategory returns the tax category of the sku product, categories
+// are cached by sku id
+func skuTaxCategory(ctx context.Context, id string, categories map[string]string) (string, error) {
+	if category, ok := categories[id]; ok {
+		return category, nil
+	}
+	s, err := sku.Service().Get(ctx, &skupb.GetRequest{Id: id})
+	if err != nil {
+		return "", err
+	}
+	p, err := product.Service().Get(ctx, &productpb.GetRequest{Id: s.GetParent()})
+	if err != nil {
+		return "", err
+	}
+	categories[id] = p.GetTaxCategory()
+	return p.GetTaxCategory(), nil
+}
@@ -0,0 +1,85 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/digota/digota/order/orderpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// action is something that moves an order from one status to another
+type action string
+
+const (
+	payAction     action = "paid"
+	fulfillAction action = "fulfilled"
+	returnAction  action = "returned"
+	cancelAction  action = "canceled"
+	updateAction  action = "updated"
+	expireAction  action = "expired"
+	// some of the items are returned
+	partialReturnAction action = "partially returned"
+	// some of the items are shipped
+	partialFulfillAction action = "partially fulfilled"
+)
+
+// transitions is the order state machine, it maps every status to the
+// actions allowed on it and the status each of them leads to. statuses
+// that are missing are final.
+var transitions = map[orderpb.OrderStatus]map[action]orderpb.OrderStatus{
+	orderpb.Order_Created: {
+		payAction: orderpb.Order_Paid,
+		// abandoned before paying
+		cancelAction: orderpb.Order_Canceled,
+		// cart changed before paying
+		updateAction: orderpb.Order_Created,
+		// not paid in time
+		expireAction: orderpb.Order_Expired,
+	},
+	orderpb.Order_Paid: {
+		fulfillAction: orderpb.Order_Fulfilled,
+		// shipped in parts, fulfilled by the last one
+		partialFulfillAction: orderpb.Order_Paid,
+		// not fulfilled, the authorization is voided
+		cancelAction: orderpb.Order_Canceled,
+		// paid but never fulfilled, the items are back in stock
+		returnAction:        orderpb.Order_Canceled,
+		partialReturnAction: orderpb.Order_Paid,
+	},
+	orderpb.Order_Fulfilled: {
+		returnAction:        orderpb.Order_Returned,
+		partialReturnAction: orderpb.Order_Fulfilled,
+	},
+}
+
+// next returns the status the order moves to by a, or FailedPrecondition
+// error if a is not allowed in the current status
+func (o *order) next(a action) (orderpb.OrderStatus, error) {
+	if s, ok := transitions[o.Status][a]; ok {
+		return s, nil
+	}
+	return o.Status, status.Error(codes.FailedPrecondition, fmt.Sprintf("Order in %s status can't be %s.", o.Status, a))
+}
@@ -0,0 +1,245 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package outbox
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/payment/paymentpb"
+	"github.com/digota/digota/storage"
+	"github.com/digota/digota/storage/object"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ns = "outbox"
+	// DefaultInterval is the time between reconciles if not configured
+	DefaultInterval = time.Minute
+	// DefaultGrace is how old entries are before they are reconciled if not
+	// configured, calls in flight finish their entries before
+	DefaultGrace = 5 * time.Minute
+	// reconcileBatch is the max number of entries of a single reconcile
+	reconcileBatch = 500
+	// maxBackoff is the max time between reconciles of a failing entry
+	maxBackoff = time.Hour
+)
+
+var (
+	interval = DefaultInterval
+	grace    = DefaultGrace
+	handlers = make(map[string]Handler)
+)
+
+// Entry is a pending compensation of a payment provider call, it is written
+// before the call and removed once the call and whatever depends on it are
+// saved. entries left behind are handled by the reconciler.
+type Entry struct {
+	Id string `bson:"_id"`
+	// handler of the entry
+	Kind             string
+	OrderId          string
+	ChargeId         string
+	ProviderId       paymentpb.PaymentProviderId
+	ProviderChargeId string
+	Amount           uint64
+	Currency         paymentpb.Currency
+	// the provider charge was only authorized
+	Authorized bool
+	// failed reconciles and the last error, failing entries are skipped
+	// till their next attempt
+	Attempts    int
+	Error       string
+	NextAttempt int64
+	Created     int64
+	Updated     int64
+}
+
+// implements object.Interface interface
+func (e *Entry) GetNamespace() string { return ns }
+
+// implements object.Interface interface
+func (e *Entry) GetId() string { return e.Id }
+
+// implements object.IdSetter interface
+func (e *Entry) SetId(id string) { e.Id = id }
+
+// implements object.TimeTracker interface
+func (e *Entry) SetCreated(t int64) { e.Created = t }
+
+// implements object.TimeTracker interface
+func (e *Entry) GetCreated() int64 { return e.Created }
+
+// implements object.TimeTracker interface
+func (e *Entry) SetUpdated(t int64) { e.Updated = t }
+
+// implements object.TimeTracker interface
+func (e *Entry) GetUpdated() int64 { return e.Updated }
+
+type entries []*Entry
+
+func (e *entries) GetNamespace() string { return ns }
+
+// Handler finishes or compensates e, the entry is removed once it returns
+// nil. handlers are called again for entries they failed.
+type Handler func(ctx context.Context, e *Entry) error
+
+// Handle registers h as the handler of kind entries
+func Handle(kind string, h Handler) {
+	if _, ok := handlers[kind]; ok {
+		panic(fmt.Sprintf("Outbox handler of %s is already registered", kind))
+	}
+	handlers[kind] = h
+}
+
+// New sets the config.Outbox interval and grace and starts the reconciler
+// in the background, the defaults are used if empty
+func New(c config.Outbox) {
+	interval, grace = DefaultInterval, DefaultGrace
+	if c.Interval > 0 {
+		interval = c.Interval
+	}
+	if c.Grace > 0 {
+		grace = c.Grace
+	}
+	go run()
+}
+
+// Add writes e before the call it compensates
+func Add(e *Entry) error {
+	if _, ok := handlers[e.Kind]; !ok {
+		return status.Error(codes.Internal, fmt.Sprintf("Outbox handler of %s is not registered.", e.Kind))
+	}
+	if err := storage.Handler().Insert(e); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// Save updates e with the call results, handlers look up results that were
+// not saved by the entry id if they can, callers compensate the call
+// themselves otherwise
+func Save(e *Entry) error {
+	if err := storage.Handler().Update(e); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// Done removes e once nothing is left to compensate, errors are only logged
+// since the handler finds nothing to do with it later
+func Done(e *Entry) {
+	if err := storage.Handler().Remove(e); err != nil {
+		log.Warnf("Could not remove outbox entry %s => %s", e.GetId(), err.Error())
+	}
+}
+
+// reconciler is the lock object of the reconciler, the node holding the
+// lock is the only one reconciling
+type reconciler struct{}
+
+// implements object.Interface interface
+func (r *reconciler) GetNamespace() string { return ns }
+
+// implements object.Interface interface
+func (r *reconciler) GetId() string { return "reconciler" }
+
+// run reconciles every interval
+func run() {
+	for range time.Tick(interval) {
+		n, err := Reconcile(context.Background())
+		switch {
+		// another node is reconciling
+		case status.Code(err) == codes.Aborted:
+		case err != nil:
+			log.Warnf("Outbox reconcile failed => %s", err.Error())
+		case n > 0:
+			log.Infof("Outbox reconcile handled %d entries", n)
+		}
+	}
+}
+
+// Reconcile passes the entries older than the grace to their handlers, the
+// handled entries are removed and failures are counted and backed off so
+// they don't hold up the rest of the entries. returns the number of handled
+// entries.
+func Reconcile(ctx context.Context) (int, error) {
+	// only one node reconciles at a time
+	unlock, err := locker.Handler().TryLockContext(ctx, &reconciler{}, locker.DefaultTimeout)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	slice := entries{}
+	if _, err := storage.Handler().List(&slice, object.ListOpt{
+		Limit: reconcileBatch,
+		Sort:  object.SortCreatedAsc,
+		Filters: []object.Filter{
+			{Field: "created", Op: object.OpLt, Value: time.Now().Add(-grace).Unix()},
+			{Field: "nextattempt", Op: object.OpLt, Value: time.Now().Unix() + 1},
+		},
+	}); err != nil {
+		return 0, err
+	}
+
+	var n int
+	for _, e := range slice {
+		h, ok := handlers[e.Kind]
+		if !ok {
+			log.Warnf("Outbox entry %s of %s has no handler", e.GetId(), e.Kind)
+			continue
+		}
+		if err := h(ctx, e); err != nil {
+			log.Warnf("Could not reconcile outbox entry %s of %s => %s", e.GetId(), e.Kind, err.Error())
+			e.Attempts++
+			e.Error = err.Error()
+			e.NextAttempt = time.Now().Add(backoff(e.Attempts)).Unix()
+			if err := Save(e); err != nil {
+				log.Warnf("Could not save outbox entry %s => %s", e.GetId(), err.Error())
+			}
+			continue
+		}
+		Done(e)
+		n++
+	}
+	return n, nil
+}
+
+// backoff returns the time till the next reconcile of an entry that failed
+// attempts times, doubling the interval up to maxBackoff
+func backoff(attempts int) time.Duration {
+	d := interval
+	for i := 1; i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		return maxBackoff
+	}
+	return d
+}
@@ -0,0 +1,190 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package outbox
+
+import (
+	"errors"
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/storage"
+	"github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+	"os"
+	"testing"
+	"time"
+)
+
+var db = "testing" + uuid.NewV4().String()
+
+func TestMain(m *testing.M) {
+
+	// setup
+	if err := storage.New(config.Storage{
+		Address:  []string{"localhost"},
+		Handler:  "mongodb",
+		Database: db,
+	}); err != nil {
+		panic(err)
+	}
+
+	// in-memory locker
+	locker.New(config.Locker{})
+
+	retCode := m.Run()
+	// teardown
+	storage.Handler().DropDatabase(db)
+	os.Exit(retCode)
+}
+
+func TestEntry_GetNamespace(t *testing.T) {
+	e := Entry{}
+	if e.GetNamespace() != ns {
+		t.FailNow()
+	}
+}
+
+func TestHandle(t *testing.T) {
+	Handle("test.handle", func(context.Context, *Entry) error { return nil })
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal(r)
+		}
+	}()
+	Handle("test.handle", func(context.Context, *Entry) error { return nil })
+}
+
+func TestAdd(t *testing.T) {
+	// no handler
+	if err := Add(&Entry{Kind: "test.missing"}); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestSave(t *testing.T) {
+	Handle("test.save", func(context.Context, *Entry) error { return nil })
+
+	e := &Entry{Kind: "test.save", OrderId: uuid.NewV4().String()}
+	if err := Add(e); err != nil {
+		t.Fatal(err)
+	}
+	e.ChargeId = uuid.NewV4().String()
+	if err := Save(e); err != nil {
+		t.Fatal(err)
+	}
+	saved := &Entry{Id: e.Id}
+	if err := storage.Handler().One(saved); err != nil || saved.ChargeId != e.ChargeId {
+		t.Fatal(saved, err)
+	}
+
+	// removed entries can't be saved
+	Done(e)
+	if err := Save(e); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	// entries are reconciled right away
+	defer func() { grace = DefaultGrace }()
+	grace = -time.Minute
+
+	var handled []string
+	Handle("test.ok", func(ctx context.Context, e *Entry) error {
+		handled = append(handled, e.OrderId)
+		return nil
+	})
+	var failed int
+	Handle("test.fail", func(ctx context.Context, e *Entry) error {
+		failed++
+		return errors.New("provider is down")
+	})
+
+	ok := &Entry{Kind: "test.ok", OrderId: uuid.NewV4().String()}
+	if err := Add(ok); err != nil {
+		t.Fatal(err)
+	}
+	fail := &Entry{Kind: "test.fail", OrderId: uuid.NewV4().String()}
+	if err := Add(fail); err != nil {
+		t.Fatal(err)
+	}
+	done := &Entry{Kind: "test.ok", OrderId: uuid.NewV4().String()}
+	if err := Add(done); err != nil {
+		t.Fatal(err)
+	}
+	// finished before the reconciler
+	Done(done)
+
+	n, err := Reconcile(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 || len(handled) != 1 || handled[0] != ok.OrderId {
+		t.Fatal(n, handled)
+	}
+
+	// handled entries are removed
+	if err := storage.Handler().One(&Entry{Id: ok.Id}); err == nil {
+		t.FailNow()
+	}
+
+	// failed entries are kept with their error
+	e := &Entry{Id: fail.Id}
+	if err := storage.Handler().One(e); err != nil {
+		t.Fatal(err)
+	}
+	if e.Attempts != 1 || e.Error != "provider is down" || e.NextAttempt <= time.Now().Unix() {
+		t.Fatal(e)
+	}
+
+	// failed entries are skipped till their next attempt
+	if _, err := Reconcile(context.Background()); err != nil || failed != 1 {
+		t.Fatal(failed, err)
+	}
+	e.NextAttempt = time.Now().Unix()
+	if err := Save(e); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Reconcile(context.Background()); err != nil || failed != 2 {
+		t.Fatal(failed, err)
+	}
+	if err := storage.Handler().One(e); err != nil {
+		t.Fatal(err)
+	}
+	if e.Attempts != 2 {
+		t.Fatal(e)
+	}
+	Done(e)
+}
+
+func TestBackoff(t *testing.T) {
+	for attempts, d := range map[int]time.Duration{
+		1:  DefaultInterval,
+		2:  2 * DefaultInterval,
+		3:  4 * DefaultInterval,
+		20: maxBackoff,
+	} {
+		if b := backoff(attempts); b != d {
+			t.Fatal(attempts, b, d)
+		}
+	}
+}
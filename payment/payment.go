@@ -68,5 +68,9 @@ func WriteMethods() []*regexp.Regexp {
 	return []*regexp.Regexp{
 		regexp.MustCompile(baseMethod + "NewCharge"),
 		regexp.MustCompile(baseMethod + "RefundCharge"),
+		regexp.MustCompile(baseMethod + "AuthorizeCharge"),
+		regexp.MustCompile(baseMethod + "CaptureCharge"),
+		regexp.MustCompile(baseMethod + "VoidCharge"),
+		regexp.MustCompile(baseMethod + "SavePaymentMethod"),
 	}
 }
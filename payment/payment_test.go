@@ -47,6 +47,18 @@ func (s *dummyService) RefundCharge(context.Context, *paymentpb.RefundRequest) (
 func (s *dummyService) List(context.Context, *paymentpb.ListRequest) (*paymentpb.ChargeList, error) {
 	return nil, nil
 }
+func (s *dummyService) AuthorizeCharge(context.Context, *paymentpb.ChargeRequest) (*paymentpb.Charge, error) {
+	return nil, nil
+}
+func (s *dummyService) CaptureCharge(context.Context, *paymentpb.CaptureRequest) (*paymentpb.Charge, error) {
+	return nil, nil
+}
+func (s *dummyService) VoidCharge(context.Context, *paymentpb.VoidRequest) (*paymentpb.Charge, error) {
+	return nil, nil
+}
+func (s *dummyService) SavePaymentMethod(context.Context, *paymentpb.PaymentMethodRequest) (*paymentpb.PaymentMethod, error) {
+	return nil, nil
+}
 
 func TestRegisterService(t *testing.T) {
 	service := &dummyService{}
@@ -80,6 +92,10 @@ func TestWriteMethods(t *testing.T) {
 	methods := []*regexp.Regexp{
 		regexp.MustCompile(baseMethod + "NewCharge"),
 		regexp.MustCompile(baseMethod + "RefundCharge"),
+		regexp.MustCompile(baseMethod + "AuthorizeCharge"),
+		regexp.MustCompile(baseMethod + "CaptureCharge"),
+		regexp.MustCompile(baseMethod + "VoidCharge"),
+		regexp.MustCompile(baseMethod + "SavePaymentMethod"),
 	}
 	// check methods in same order
 	for k, v := range WriteMethods() {
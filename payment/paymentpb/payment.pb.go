@@ -12,8 +12,12 @@
 		Refund
 		Card
 		ChargeRequest
+		PaymentMethod
+		PaymentMethodRequest
 		GetRequest
 		RefundRequest
+		CaptureRequest
+		VoidRequest
 		ListRequest
 		ChargeList
 */
@@ -555,6 +559,33 @@ func (x RefundReason) String() string {
 }
 func (RefundReason) EnumDescriptor() ([]byte, []int) { return fileDescriptorPayment, []int{4} }
 
+type Charge_Status int32
+
+const (
+	// charged, chargeAmount is the captured amount
+	Charge_Captured Charge_Status = 0
+	// held on the card till captured or voided
+	Charge_Authorized Charge_Status = 1
+	// authorization released without charging
+	Charge_Voided Charge_Status = 2
+)
+
+var Charge_Status_name = map[int32]string{
+	0: "Captured",
+	1: "Authorized",
+	2: "Voided",
+}
+var Charge_Status_value = map[string]int32{
+	"Captured":   0,
+	"Authorized": 1,
+	"Voided":     2,
+}
+
+func (x Charge_Status) String() string {
+	return proto.EnumName(Charge_Status_name, int32(x))
+}
+func (Charge_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptorPayment, []int{0, 0} }
+
 type ListRequest_Sort int32
 
 const (
@@ -583,7 +614,7 @@ var ListRequest_Sort_value = map[string]int32{
 func (x ListRequest_Sort) String() string {
 	return proto.EnumName(ListRequest_Sort_name, int32(x))
 }
-func (ListRequest_Sort) EnumDescriptor() ([]byte, []int) { return fileDescriptorPayment, []int{6, 0} }
+func (ListRequest_Sort) EnumDescriptor() ([]byte, []int) { return fileDescriptorPayment, []int{10, 0} }
 
 type Charge struct {
 	Id               string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
@@ -597,6 +628,10 @@ type Charge struct {
 	Refunded         bool              `protobuf:"varint,9,opt,name=refunded,proto3" json:"refunded,omitempty"`
 	ProviderId       PaymentProviderId `protobuf:"varint,10,opt,name=providerId,proto3,enum=paymentpb.PaymentProviderId" json:"providerId,omitempty"`
 	ProviderChargeId string            `protobuf:"bytes,11,opt,name=providerChargeId,proto3" json:"providerChargeId,omitempty"`
+	Metadata         map[string]string `protobuf:"bytes,12,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Status           Charge_Status     `protobuf:"varint,13,opt,name=status,proto3,enum=paymentpb.Charge_Status" json:"status,omitempty"`
+	AuthorizedAmount uint64            `protobuf:"varint,14,opt,name=authorizedAmount,proto3" json:"authorizedAmount,omitempty"`
+	CustomerId       string            `protobuf:"bytes,15,opt,name=customerId,proto3" json:"customerId,omitempty"`
 	Created          int64             `protobuf:"varint,998,opt,name=created,proto3" json:"created,omitempty"`
 	Updated          int64             `protobuf:"varint,999,opt,name=updated,proto3" json:"updated,omitempty"`
 }
@@ -683,6 +718,34 @@ func (m *Charge) GetProviderChargeId() string {
 	return ""
 }
 
+func (m *Charge) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Charge) GetStatus() Charge_Status {
+	if m != nil {
+		return m.Status
+	}
+	return Charge_Captured
+}
+
+func (m *Charge) GetAuthorizedAmount() uint64 {
+	if m != nil {
+		return m.AuthorizedAmount
+	}
+	return 0
+}
+
+func (m *Charge) GetCustomerId() string {
+	if m != nil {
+		return m.CustomerId
+	}
+	return ""
+}
+
 func (m *Charge) GetCreated() int64 {
 	if m != nil {
 		return m.Created
@@ -802,13 +865,20 @@ func (m *Card) GetType() CardType {
 }
 
 type ChargeRequest struct {
-	Currency          Currency          `protobuf:"varint,1,opt,name=currency,proto3,enum=paymentpb.Currency" json:"currency,omitempty" validate:"required,gte=1,lte=128"`
-	Total             uint64            `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty" validate:"required,gte=0"`
-	Card              *Card             `protobuf:"bytes,3,opt,name=Card" json:"Card,omitempty" validate:"required,dive"`
+	Currency Currency `protobuf:"varint,1,opt,name=currency,proto3,enum=paymentpb.Currency" json:"currency,omitempty" validate:"required,gte=1,lte=128"`
+	Total    uint64   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty" validate:"required,gte=0"`
+	// either the card or a stored payment method is required
+	Card              *Card             `protobuf:"bytes,3,opt,name=Card" json:"Card,omitempty" validate:"omitempty,dive"`
 	Email             string            `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty" validate:"email"`
 	Statement         string            `protobuf:"bytes,5,opt,name=statement,proto3" json:"statement,omitempty"`
 	PaymentProviderId PaymentProviderId `protobuf:"varint,6,opt,name=paymentProviderId,proto3,enum=paymentpb.PaymentProviderId" json:"paymentProviderId,omitempty" validate:"required,gte=1,lte=3"`
 	Metadata          map[string]string `protobuf:"bytes,7,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// retries with the same key get the first response, the idempotency-key
+	// grpc metadata is used if empty
+	IdempotencyKey string `protobuf:"bytes,8,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty" validate:"omitempty,max=255"`
+	// provider reference of a payment method saved by SavePaymentMethod
+	PaymentMethod string `protobuf:"bytes,9,opt,name=paymentMethod,proto3" json:"paymentMethod,omitempty" validate:"omitempty,max=255"`
+	CustomerId    string `protobuf:"bytes,10,opt,name=customerId,proto3" json:"customerId,omitempty" validate:"omitempty,uuid4"`
 }
 
 func (m *ChargeRequest) Reset()                    { *m = ChargeRequest{} }
@@ -865,6 +935,117 @@ func (m *ChargeRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+func (m *ChargeRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+func (m *ChargeRequest) GetPaymentMethod() string {
+	if m != nil {
+		return m.PaymentMethod
+	}
+	return ""
+}
+
+func (m *ChargeRequest) GetCustomerId() string {
+	if m != nil {
+		return m.CustomerId
+	}
+	return ""
+}
+
+// PaymentMethod is a card stored with the payment provider, only the
+// provider reference and the card details needed for display are kept
+type PaymentMethod struct {
+	ProviderId  PaymentProviderId `protobuf:"varint,1,opt,name=providerId,proto3,enum=paymentpb.PaymentProviderId" json:"providerId,omitempty"`
+	Reference   string            `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Type        CardType          `protobuf:"varint,3,opt,name=type,proto3,enum=paymentpb.CardType" json:"type,omitempty"`
+	Last4       string            `protobuf:"bytes,4,opt,name=last4,proto3" json:"last4,omitempty"`
+	ExpireMonth string            `protobuf:"bytes,5,opt,name=expireMonth,proto3" json:"expireMonth,omitempty"`
+	ExpireYear  string            `protobuf:"bytes,6,opt,name=expireYear,proto3" json:"expireYear,omitempty"`
+}
+
+func (m *PaymentMethod) Reset()                    { *m = PaymentMethod{} }
+func (m *PaymentMethod) String() string            { return proto.CompactTextString(m) }
+func (*PaymentMethod) ProtoMessage()               {}
+func (*PaymentMethod) Descriptor() ([]byte, []int) { return fileDescriptorPayment, []int{4} }
+
+func (m *PaymentMethod) GetProviderId() PaymentProviderId {
+	if m != nil {
+		return m.ProviderId
+	}
+	return PaymentProviderId_PROVIDER_Reserved
+}
+
+func (m *PaymentMethod) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *PaymentMethod) GetType() CardType {
+	if m != nil {
+		return m.Type
+	}
+	return CardType_CARD_Reserved
+}
+
+func (m *PaymentMethod) GetLast4() string {
+	if m != nil {
+		return m.Last4
+	}
+	return ""
+}
+
+func (m *PaymentMethod) GetExpireMonth() string {
+	if m != nil {
+		return m.ExpireMonth
+	}
+	return ""
+}
+
+func (m *PaymentMethod) GetExpireYear() string {
+	if m != nil {
+		return m.ExpireYear
+	}
+	return ""
+}
+
+type PaymentMethodRequest struct {
+	Card              *Card             `protobuf:"bytes,1,opt,name=card" json:"card,omitempty" validate:"required,dive"`
+	Email             string            `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty" validate:"omitempty,email"`
+	PaymentProviderId PaymentProviderId `protobuf:"varint,3,opt,name=paymentProviderId,proto3,enum=paymentpb.PaymentProviderId" json:"paymentProviderId,omitempty" validate:"required,gte=1,lte=3"`
+}
+
+func (m *PaymentMethodRequest) Reset()                    { *m = PaymentMethodRequest{} }
+func (m *PaymentMethodRequest) String() string            { return proto.CompactTextString(m) }
+func (*PaymentMethodRequest) ProtoMessage()               {}
+func (*PaymentMethodRequest) Descriptor() ([]byte, []int) { return fileDescriptorPayment, []int{5} }
+
+func (m *PaymentMethodRequest) GetCard() *Card {
+	if m != nil {
+		return m.Card
+	}
+	return nil
+}
+
+func (m *PaymentMethodRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *PaymentMethodRequest) GetPaymentProviderId() PaymentProviderId {
+	if m != nil {
+		return m.PaymentProviderId
+	}
+	return PaymentProviderId_PROVIDER_Reserved
+}
+
 type GetRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
 }
@@ -872,7 +1053,7 @@ type GetRequest struct {
 func (m *GetRequest) Reset()                    { *m = GetRequest{} }
 func (m *GetRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()               {}
-func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptorPayment, []int{4} }
+func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptorPayment, []int{6} }
 
 func (m *GetRequest) GetId() string {
 	if m != nil {
@@ -890,7 +1071,7 @@ type RefundRequest struct {
 func (m *RefundRequest) Reset()                    { *m = RefundRequest{} }
 func (m *RefundRequest) String() string            { return proto.CompactTextString(m) }
 func (*RefundRequest) ProtoMessage()               {}
-func (*RefundRequest) Descriptor() ([]byte, []int) { return fileDescriptorPayment, []int{5} }
+func (*RefundRequest) Descriptor() ([]byte, []int) { return fileDescriptorPayment, []int{7} }
 
 func (m *RefundRequest) GetId() string {
 	if m != nil {
@@ -913,16 +1094,59 @@ func (m *RefundRequest) GetReason() RefundReason {
 	return RefundReason_GeneralError
 }
 
+// captures the whole authorized amount if the amount is empty
+type CaptureRequest struct {
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *CaptureRequest) Reset()                    { *m = CaptureRequest{} }
+func (m *CaptureRequest) String() string            { return proto.CompactTextString(m) }
+func (*CaptureRequest) ProtoMessage()               {}
+func (*CaptureRequest) Descriptor() ([]byte, []int) { return fileDescriptorPayment, []int{8} }
+
+func (m *CaptureRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CaptureRequest) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type VoidRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+}
+
+func (m *VoidRequest) Reset()                    { *m = VoidRequest{} }
+func (m *VoidRequest) String() string            { return proto.CompactTextString(m) }
+func (*VoidRequest) ProtoMessage()               {}
+func (*VoidRequest) Descriptor() ([]byte, []int) { return fileDescriptorPayment, []int{9} }
+
+func (m *VoidRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 type ListRequest struct {
 	Page  int64            `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty" validate:"omitempty,required,gte=0"`
 	Limit int64            `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" validate:"omitempty,required,gt=0"`
 	Sort  ListRequest_Sort `protobuf:"varint,3,opt,name=sort,proto3,enum=paymentpb.ListRequest_Sort" json:"sort,omitempty" validate:"omitempty,required,gte=0,lte=4"`
+	// only charges of the customer
+	CustomerId string `protobuf:"bytes,4,opt,name=customerId,proto3" json:"customerId,omitempty" validate:"omitempty,uuid4"`
 }
 
 func (m *ListRequest) Reset()                    { *m = ListRequest{} }
 func (m *ListRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListRequest) ProtoMessage()               {}
-func (*ListRequest) Descriptor() ([]byte, []int) { return fileDescriptorPayment, []int{6} }
+func (*ListRequest) Descriptor() ([]byte, []int) { return fileDescriptorPayment, []int{10} }
 
 func (m *ListRequest) GetPage() int64 {
 	if m != nil {
@@ -945,6 +1169,13 @@ func (m *ListRequest) GetSort() ListRequest_Sort {
 	return ListRequest_Natural
 }
 
+func (m *ListRequest) GetCustomerId() string {
+	if m != nil {
+		return m.CustomerId
+	}
+	return ""
+}
+
 type ChargeList struct {
 	Charges []*Charge `protobuf:"bytes,1,rep,name=charges" json:"charges,omitempty"`
 	Total   int32     `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
@@ -953,7 +1184,7 @@ type ChargeList struct {
 func (m *ChargeList) Reset()                    { *m = ChargeList{} }
 func (m *ChargeList) String() string            { return proto.CompactTextString(m) }
 func (*ChargeList) ProtoMessage()               {}
-func (*ChargeList) Descriptor() ([]byte, []int) { return fileDescriptorPayment, []int{7} }
+func (*ChargeList) Descriptor() ([]byte, []int) { return fileDescriptorPayment, []int{11} }
 
 func (m *ChargeList) GetCharges() []*Charge {
 	if m != nil {
@@ -974,8 +1205,12 @@ func init() {
 	proto.RegisterType((*Refund)(nil), "paymentpb.Refund")
 	proto.RegisterType((*Card)(nil), "paymentpb.Card")
 	proto.RegisterType((*ChargeRequest)(nil), "paymentpb.ChargeRequest")
+	proto.RegisterType((*PaymentMethod)(nil), "paymentpb.PaymentMethod")
+	proto.RegisterType((*PaymentMethodRequest)(nil), "paymentpb.PaymentMethodRequest")
 	proto.RegisterType((*GetRequest)(nil), "paymentpb.GetRequest")
 	proto.RegisterType((*RefundRequest)(nil), "paymentpb.RefundRequest")
+	proto.RegisterType((*CaptureRequest)(nil), "paymentpb.CaptureRequest")
+	proto.RegisterType((*VoidRequest)(nil), "paymentpb.VoidRequest")
 	proto.RegisterType((*ListRequest)(nil), "paymentpb.ListRequest")
 	proto.RegisterType((*ChargeList)(nil), "paymentpb.ChargeList")
 	proto.RegisterEnum("paymentpb.Currency", Currency_name, Currency_value)
@@ -983,6 +1218,7 @@ func init() {
 	proto.RegisterEnum("paymentpb.CardType", CardType_name, CardType_value)
 	proto.RegisterEnum("paymentpb.PaymentProviderId", PaymentProviderId_name, PaymentProviderId_value)
 	proto.RegisterEnum("paymentpb.RefundReason", RefundReason_name, RefundReason_value)
+	proto.RegisterEnum("paymentpb.Charge_Status", Charge_Status_name, Charge_Status_value)
 	proto.RegisterEnum("paymentpb.ListRequest_Sort", ListRequest_Sort_name, ListRequest_Sort_value)
 }
 
@@ -1001,6 +1237,10 @@ type PaymentServiceClient interface {
 	RefundCharge(ctx context.Context, in *RefundRequest, opts ...grpc.CallOption) (*Charge, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Charge, error)
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ChargeList, error)
+	AuthorizeCharge(ctx context.Context, in *ChargeRequest, opts ...grpc.CallOption) (*Charge, error)
+	CaptureCharge(ctx context.Context, in *CaptureRequest, opts ...grpc.CallOption) (*Charge, error)
+	VoidCharge(ctx context.Context, in *VoidRequest, opts ...grpc.CallOption) (*Charge, error)
+	SavePaymentMethod(ctx context.Context, in *PaymentMethodRequest, opts ...grpc.CallOption) (*PaymentMethod, error)
 }
 
 type paymentServiceClient struct {
@@ -1047,6 +1287,42 @@ func (c *paymentServiceClient) List(ctx context.Context, in *ListRequest, opts .
 	return out, nil
 }
 
+func (c *paymentServiceClient) AuthorizeCharge(ctx context.Context, in *ChargeRequest, opts ...grpc.CallOption) (*Charge, error) {
+	out := new(Charge)
+	err := grpc.Invoke(ctx, "/paymentpb.PaymentService/AuthorizeCharge", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CaptureCharge(ctx context.Context, in *CaptureRequest, opts ...grpc.CallOption) (*Charge, error) {
+	out := new(Charge)
+	err := grpc.Invoke(ctx, "/paymentpb.PaymentService/CaptureCharge", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) VoidCharge(ctx context.Context, in *VoidRequest, opts ...grpc.CallOption) (*Charge, error) {
+	out := new(Charge)
+	err := grpc.Invoke(ctx, "/paymentpb.PaymentService/VoidCharge", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) SavePaymentMethod(ctx context.Context, in *PaymentMethodRequest, opts ...grpc.CallOption) (*PaymentMethod, error) {
+	out := new(PaymentMethod)
+	err := grpc.Invoke(ctx, "/paymentpb.PaymentService/SavePaymentMethod", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for PaymentService service
 
 type PaymentServiceServer interface {
@@ -1054,6 +1330,10 @@ type PaymentServiceServer interface {
 	RefundCharge(context.Context, *RefundRequest) (*Charge, error)
 	Get(context.Context, *GetRequest) (*Charge, error)
 	List(context.Context, *ListRequest) (*ChargeList, error)
+	AuthorizeCharge(context.Context, *ChargeRequest) (*Charge, error)
+	CaptureCharge(context.Context, *CaptureRequest) (*Charge, error)
+	VoidCharge(context.Context, *VoidRequest) (*Charge, error)
+	SavePaymentMethod(context.Context, *PaymentMethodRequest) (*PaymentMethod, error)
 }
 
 func RegisterPaymentServiceServer(s *grpc.Server, srv PaymentServiceServer) {
@@ -1132,6 +1412,78 @@ func _PaymentService_List_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_AuthorizeCharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).AuthorizeCharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/paymentpb.PaymentService/AuthorizeCharge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).AuthorizeCharge(ctx, req.(*ChargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CaptureCharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CaptureCharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/paymentpb.PaymentService/CaptureCharge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CaptureCharge(ctx, req.(*CaptureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_VoidCharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).VoidCharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/paymentpb.PaymentService/VoidCharge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).VoidCharge(ctx, req.(*VoidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_SavePaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).SavePaymentMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/paymentpb.PaymentService/SavePaymentMethod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).SavePaymentMethod(ctx, req.(*PaymentMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PaymentService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "paymentpb.PaymentService",
 	HandlerType: (*PaymentServiceServer)(nil),
@@ -1152,6 +1504,22 @@ var _PaymentService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _PaymentService_List_Handler,
 		},
+		{
+			MethodName: "AuthorizeCharge",
+			Handler:    _PaymentService_AuthorizeCharge_Handler,
+		},
+		{
+			MethodName: "CaptureCharge",
+			Handler:    _PaymentService_CaptureCharge_Handler,
+		},
+		{
+			MethodName: "VoidCharge",
+			Handler:    _PaymentService_VoidCharge_Handler,
+		},
+		{
+			MethodName: "SavePaymentMethod",
+			Handler:    _PaymentService_SavePaymentMethod_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment/paymentpb/payment.proto",
@@ -1248,6 +1616,39 @@ func (m *Charge) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPayment(dAtA, i, uint64(len(m.ProviderChargeId)))
 		i += copy(dAtA[i:], m.ProviderChargeId)
 	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x62
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovPayment(uint64(len(k))) + 1 + len(v) + sovPayment(uint64(len(v)))
+			i = encodeVarintPayment(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPayment(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPayment(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(m.Status))
+	}
+	if m.AuthorizedAmount != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(m.AuthorizedAmount))
+	}
+	if len(m.CustomerId) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.CustomerId)))
+		i += copy(dAtA[i:], m.CustomerId)
+	}
 	if m.Created != 0 {
 		dAtA[i] = 0xb0
 		i++
@@ -1432,10 +1833,28 @@ func (m *ChargeRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], v)
 		}
 	}
+	if len(m.IdempotencyKey) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i += copy(dAtA[i:], m.IdempotencyKey)
+	}
+	if len(m.PaymentMethod) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.PaymentMethod)))
+		i += copy(dAtA[i:], m.PaymentMethod)
+	}
+	if len(m.CustomerId) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.CustomerId)))
+		i += copy(dAtA[i:], m.CustomerId)
+	}
 	return i, nil
 }
 
-func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+func (m *PaymentMethod) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1445,16 +1864,107 @@ func (m *GetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PaymentMethod) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
+	if m.ProviderId != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPayment(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+		i = encodeVarintPayment(dAtA, i, uint64(m.ProviderId))
+	}
+	if len(m.Reference) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.Reference)))
+		i += copy(dAtA[i:], m.Reference)
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(m.Type))
+	}
+	if len(m.Last4) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.Last4)))
+		i += copy(dAtA[i:], m.Last4)
+	}
+	if len(m.ExpireMonth) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.ExpireMonth)))
+		i += copy(dAtA[i:], m.ExpireMonth)
+	}
+	if len(m.ExpireYear) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.ExpireYear)))
+		i += copy(dAtA[i:], m.ExpireYear)
+	}
+	return i, nil
+}
+
+func (m *PaymentMethodRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentMethodRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Card != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(m.Card.Size()))
+		n2, err := m.Card.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.Email) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.Email)))
+		i += copy(dAtA[i:], m.Email)
+	}
+	if m.PaymentProviderId != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(m.PaymentProviderId))
+	}
+	return i, nil
+}
+
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
 	}
 	return i, nil
 }
@@ -1493,6 +2003,59 @@ func (m *RefundRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *CaptureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CaptureRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Amount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(m.Amount))
+	}
+	return i, nil
+}
+
+func (m *VoidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoidRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
 func (m *ListRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1523,6 +2086,12 @@ func (m *ListRequest) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPayment(dAtA, i, uint64(m.Sort))
 	}
+	if len(m.CustomerId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPayment(dAtA, i, uint64(len(m.CustomerId)))
+		i += copy(dAtA[i:], m.CustomerId)
+	}
 	return i, nil
 }
 
@@ -1631,6 +2200,24 @@ func (m *Charge) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPayment(uint64(l))
 	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPayment(uint64(len(k))) + 1 + len(v) + sovPayment(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPayment(uint64(mapEntrySize))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovPayment(uint64(m.Status))
+	}
+	if m.AuthorizedAmount != 0 {
+		n += 1 + sovPayment(uint64(m.AuthorizedAmount))
+	}
+	l = len(m.CustomerId)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
 	if m.Created != 0 {
 		n += 2 + sovPayment(uint64(m.Created))
 	}
@@ -1724,6 +2311,63 @@ func (m *ChargeRequest) Size() (n int) {
 			n += mapEntrySize + 1 + sovPayment(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	l = len(m.PaymentMethod)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	l = len(m.CustomerId)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	return n
+}
+
+func (m *PaymentMethod) Size() (n int) {
+	var l int
+	_ = l
+	if m.ProviderId != 0 {
+		n += 1 + sovPayment(uint64(m.ProviderId))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovPayment(uint64(m.Type))
+	}
+	l = len(m.Last4)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	l = len(m.ExpireMonth)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	l = len(m.ExpireYear)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	return n
+}
+
+func (m *PaymentMethodRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Card != nil {
+		l = m.Card.Size()
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	if m.PaymentProviderId != 0 {
+		n += 1 + sovPayment(uint64(m.PaymentProviderId))
+	}
 	return n
 }
 
@@ -1753,6 +2397,29 @@ func (m *RefundRequest) Size() (n int) {
 	return n
 }
 
+func (m *CaptureRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovPayment(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *VoidRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
+	return n
+}
+
 func (m *ListRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -1765,6 +2432,10 @@ func (m *ListRequest) Size() (n int) {
 	if m.Sort != 0 {
 		n += 1 + sovPayment(uint64(m.Sort))
 	}
+	l = len(m.CustomerId)
+	if l > 0 {
+		n += 1 + l + sovPayment(uint64(l))
+	}
 	return n
 }
 
@@ -2088,11 +2759,11 @@ func (m *Charge) Unmarshal(dAtA []byte) error {
 			}
 			m.ProviderChargeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 998:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			m.Created = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayment
@@ -2102,16 +2773,19 @@ func (m *Charge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Created |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 999:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPayment
 			}
-			m.Updated = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayment
@@ -2121,23 +2795,203 @@ func (m *Charge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Updated |= (int64(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPayment(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPayment
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPayment
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPayment
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthPayment
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Metadata[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Metadata[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (Charge_Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedAmount", wireType)
+			}
+			m.AuthorizedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthorizedAmount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 998:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 999:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			m.Updated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updated |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
 			iNdEx += skippy
 		}
 	}
@@ -2819,59 +3673,38 @@ func (m *ChargeRequest) Unmarshal(dAtA []byte) error {
 				m.Metadata[mapkey] = mapvalue
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPayment(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPayment
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPayment
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentMethod", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2896,12 +3729,455 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.PaymentMethod = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPayment(dAtA[iNdEx:])
-			if err != nil {
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymentMethod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentMethod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentMethod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderId", wireType)
+			}
+			m.ProviderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProviderId |= (PaymentProviderId(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (CardType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Last4", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Last4 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireMonth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpireMonth = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireYear", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpireYear = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymentMethodRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentMethodRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentMethodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Card", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Card == nil {
+				m.Card = &Card{}
+			}
+			if err := m.Card.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentProviderId", wireType)
+			}
+			m.PaymentProviderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaymentProviderId |= (PaymentProviderId(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayment(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
 			if skippy < 0 {
@@ -3036,6 +4312,183 @@ func (m *RefundRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CaptureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CaptureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CaptureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3122,6 +4575,35 @@ func (m *ListRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayment(dAtA[iNdEx:])
@@ -3351,127 +4833,149 @@ var (
 func init() { proto.RegisterFile("payment/paymentpb/payment.proto", fileDescriptorPayment) }
 
 var fileDescriptorPayment = []byte{
-	// 1938 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0xd8, 0x4d, 0x73, 0xdb, 0xb8,
-	0xf9, 0x00, 0x70, 0x53, 0x6f, 0x96, 0xe0, 0x97, 0x3c, 0x46, 0xde, 0x14, 0x6f, 0xd6, 0xf2, 0x32,
-	0xbb, 0x59, 0xaf, 0x37, 0xb1, 0x63, 0x27, 0xff, 0x6c, 0xfe, 0x49, 0xdc, 0xad, 0x28, 0xc8, 0xb2,
-	0x23, 0x89, 0x62, 0x20, 0xcb, 0x8a, 0xd4, 0x76, 0x53, 0x5a, 0x44, 0x1c, 0x76, 0x25, 0x51, 0x21,
-	0x29, 0x6f, 0xdc, 0xf7, 0x8f, 0xd1, 0x99, 0x4e, 0x4f, 0x3d, 0xf7, 0xde, 0x5b, 0xaf, 0x3d, 0x76,
-	0xfa, 0x01, 0x34, 0x9d, 0x74, 0xa6, 0xbd, 0xeb, 0xd2, 0x6b, 0x07, 0x0f, 0x29, 0x5b, 0xb1, 0x9c,
-	0x6c, 0xa6, 0x27, 0xff, 0x40, 0x3c, 0x0f, 0x08, 0x10, 0x20, 0x40, 0x99, 0x64, 0x7a, 0xe6, 0x71,
-	0x47, 0x74, 0xfd, 0xf5, 0xf0, 0x6f, 0xef, 0x60, 0xa4, 0xb5, 0x9e, 0xeb, 0xf8, 0x0e, 0x4d, 0x9d,
-	0x54, 0x2c, 0xde, 0x3e, 0xb4, 0xfd, 0x97, 0xfd, 0x83, 0xb5, 0x96, 0xd3, 0x59, 0x3f, 0x74, 0x0e,
-	0x9d, 0x75, 0x8c, 0x38, 0xe8, 0xbf, 0xc0, 0x12, 0x16, 0x50, 0x41, 0xa6, 0xfa, 0xf7, 0x28, 0x49,
-	0xe4, 0x5e, 0x9a, 0xee, 0xa1, 0xa0, 0x4b, 0x24, 0x62, 0x5b, 0x69, 0x65, 0x59, 0x59, 0x49, 0x69,
-	0xf3, 0xc3, 0x41, 0x86, 0x1c, 0x78, 0x4e, 0xf7, 0xa1, 0xfa, 0xdc, 0xb6, 0x54, 0x1e, 0xb1, 0x2d,
-	0x7a, 0x9d, 0xa4, 0x3c, 0xdf, 0xf4, 0x85, 0xbc, 0x51, 0x3a, 0x22, 0xc3, 0xf8, 0xe9, 0x05, 0xaa,
-	0x92, 0xd9, 0x16, 0xb6, 0x93, 0xed, 0x38, 0xfd, 0xae, 0x9f, 0x8e, 0x2e, 0x2b, 0x2b, 0x31, 0xfe,
-	0xd6, 0x35, 0x19, 0xe3, 0x8a, 0x17, 0xfd, 0xae, 0x15, 0xc6, 0xc4, 0x82, 0x98, 0xf1, 0x6b, 0xf4,
-	0x4b, 0x32, 0x1d, 0x94, 0xbd, 0x74, 0x7c, 0x39, 0xba, 0x32, 0xb3, 0xb9, 0xb0, 0x76, 0x32, 0xb8,
-	0x35, 0x8e, 0x35, 0x7c, 0x14, 0x41, 0xd7, 0x49, 0xb2, 0xd5, 0x77, 0x5d, 0xd1, 0x6d, 0x1d, 0xa7,
-	0x13, 0xcb, 0xca, 0xca, 0xfc, 0xe6, 0xc5, 0xb1, 0xe8, 0x5c, 0x58, 0xc5, 0x4f, 0x82, 0xe8, 0x25,
-	0x12, 0x17, 0x1d, 0xd3, 0x6e, 0xa7, 0xa7, 0xb1, 0xff, 0x41, 0x81, 0x52, 0x12, 0xeb, 0x99, 0xb6,
-	0x95, 0x4e, 0x2e, 0x2b, 0x2b, 0x49, 0x8e, 0xa6, 0x8b, 0x24, 0x19, 0xdc, 0x45, 0x58, 0xe9, 0x14,
-	0x5e, 0x3f, 0x29, 0xd3, 0xc7, 0x84, 0xf4, 0x5c, 0xe7, 0xc8, 0xb6, 0x84, 0xbb, 0x6b, 0xa5, 0x09,
-	0xde, 0xf8, 0xfa, 0xd8, 0x8d, 0x8d, 0x40, 0xc6, 0x49, 0x0c, 0x1f, 0x8b, 0xa7, 0xab, 0x04, 0x46,
-	0xa5, 0xe0, 0xc9, 0xef, 0x5a, 0xe9, 0x19, 0xec, 0xce, 0xc4, 0x75, 0x7a, 0x8d, 0x4c, 0xb7, 0x5c,
-	0x61, 0xfa, 0xc2, 0x4a, 0xff, 0x4b, 0x76, 0x39, 0xca, 0x47, 0x65, 0x59, 0xd5, 0xef, 0x59, 0x58,
-	0xf5, 0xef, 0xb0, 0x2a, 0x2c, 0xab, 0x7f, 0x54, 0x48, 0x22, 0x78, 0x54, 0x13, 0x8f, 0x5c, 0x39,
-	0xe7, 0x91, 0x8f, 0x75, 0x28, 0xc8, 0xda, 0xb5, 0xc2, 0xf9, 0x9d, 0xb8, 0x4e, 0xd7, 0x49, 0xc2,
-	0x15, 0xa6, 0xe7, 0x74, 0x71, 0x82, 0xe7, 0x37, 0xaf, 0x4e, 0xce, 0x0e, 0x56, 0xf3, 0x30, 0x8c,
-	0xa6, 0x4f, 0x47, 0x10, 0x7b, 0x6b, 0x00, 0xea, 0x9f, 0xa3, 0x24, 0x96, 0x33, 0x5d, 0x8b, 0xde,
-	0x27, 0x09, 0xbd, 0xdf, 0x39, 0x10, 0x6e, 0xb8, 0xf8, 0x96, 0x86, 0x83, 0xcc, 0xe2, 0x91, 0xd9,
-	0xb6, 0xe5, 0x68, 0x1e, 0xaa, 0xae, 0x78, 0xd5, 0xb7, 0x5d, 0x61, 0xdd, 0x6a, 0x8b, 0xee, 0xd6,
-	0xc6, 0x7d, 0x95, 0x87, 0xd1, 0xf4, 0x6b, 0x32, 0x93, 0x7f, 0xdd, 0xb3, 0x5d, 0x51, 0x76, 0xba,
-	0xfe, 0xcb, 0xa0, 0xcb, 0xda, 0xc7, 0xc3, 0x41, 0xe6, 0xda, 0x3b, 0x92, 0x37, 0x55, 0x3e, 0x9e,
-	0x41, 0xb7, 0x08, 0x09, 0x8a, 0x0d, 0x61, 0xba, 0x38, 0xa0, 0xf7, 0xe7, 0xdf, 0x53, 0xf9, 0x58,
-	0x02, 0x7d, 0x4c, 0x52, 0xdb, 0xb6, 0xeb, 0xf9, 0xba, 0xd9, 0x11, 0x38, 0xb8, 0x89, 0xae, 0x3b,
-	0x1d, 0xdb, 0x17, 0x9d, 0x9e, 0x7f, 0x7c, 0xab, 0x63, 0x77, 0xb7, 0x36, 0x54, 0x7e, 0x9a, 0x40,
-	0x1f, 0x92, 0x64, 0xc9, 0x0c, 0x93, 0xe3, 0x1f, 0x94, 0x7c, 0x12, 0x4f, 0xef, 0x90, 0x68, 0x6e,
-	0x3f, 0x87, 0x4b, 0xfe, 0x3d, 0x69, 0xb2, 0xcb, 0x77, 0x55, 0x2e, 0x43, 0x69, 0x89, 0xc4, 0xfc,
-	0xe3, 0x9e, 0xc0, 0x25, 0x7e, 0xe6, 0x2d, 0x31, 0x5d, 0x6b, 0xef, 0xb8, 0x27, 0xb4, 0x1b, 0xc3,
-	0x41, 0x26, 0x73, 0xce, 0xc8, 0x0f, 0x7d, 0xb1, 0xb5, 0x71, 0xab, 0xed, 0x8b, 0xad, 0xfb, 0x2a,
-	0xc7, 0x56, 0xd4, 0xdf, 0xc7, 0xc8, 0x5c, 0xb0, 0x46, 0xb9, 0x78, 0xd5, 0x17, 0x9e, 0x4f, 0xf7,
-	0xc7, 0xde, 0x44, 0xe5, 0x9d, 0x6f, 0xa2, 0xf6, 0xd9, 0x70, 0x90, 0xf9, 0xe4, 0xbd, 0xf7, 0xd8,
-	0xd8, 0x7c, 0xa0, 0x8e, 0xbd, 0xb0, 0x77, 0x49, 0xdc, 0x77, 0x7c, 0xb3, 0x8d, 0xb3, 0x1b, 0x7b,
-	0xe7, 0xec, 0xc8, 0xfc, 0x3b, 0x2a, 0x0f, 0x62, 0x69, 0x36, 0x58, 0x58, 0x38, 0xa3, 0x33, 0x9b,
-	0x17, 0xce, 0x0c, 0x56, 0xbb, 0x3e, 0x1c, 0x64, 0xd2, 0xe7, 0x34, 0x62, 0xd9, 0x47, 0x42, 0xe5,
-	0xc1, 0x9a, 0x5c, 0x1d, 0x6d, 0x14, 0xc1, 0xbc, 0x5e, 0x1a, 0x0e, 0x32, 0x70, 0x9a, 0x82, 0x55,
-	0xea, 0x68, 0xfb, 0x78, 0x6b, 0x63, 0x8c, 0x9f, 0xdd, 0x18, 0x1d, 0xb2, 0xd0, 0x3b, 0xbb, 0x1f,
-	0x84, 0x9b, 0xd5, 0x7b, 0xf7, 0x8c, 0x0f, 0x98, 0x8f, 0xbb, 0x2a, 0x9f, 0x6c, 0x9b, 0x6a, 0x24,
-	0xd9, 0x11, 0xbe, 0x69, 0x99, 0xbe, 0x99, 0x9e, 0xc6, 0x2d, 0xf4, 0xe6, 0xf8, 0x13, 0x18, 0x9f,
-	0xb6, 0xb5, 0x72, 0x18, 0x98, 0xef, 0xfa, 0xee, 0x31, 0x3f, 0xc9, 0x5b, 0x7c, 0x44, 0xe6, 0xde,
-	0xaa, 0xa2, 0x40, 0xa2, 0xdf, 0x8a, 0x60, 0x6a, 0x53, 0x5c, 0x52, 0x6e, 0xa5, 0x47, 0x66, 0xbb,
-	0x2f, 0xc2, 0xad, 0x22, 0x28, 0x3c, 0x8c, 0x3c, 0x50, 0xd4, 0x47, 0x84, 0x14, 0x84, 0x3f, 0x5a,
-	0x19, 0xb7, 0xc7, 0x8e, 0x95, 0x33, 0xd3, 0xd7, 0xef, 0xdb, 0xd6, 0xbd, 0x5b, 0xa3, 0x81, 0xe1,
-	0x29, 0xa3, 0xfe, 0x49, 0x21, 0x73, 0xa3, 0x8d, 0xe4, 0x7f, 0x69, 0x80, 0x5e, 0x21, 0x09, 0x33,
-	0xd8, 0xeb, 0x70, 0xc9, 0xf0, 0xb0, 0x44, 0x6b, 0x1f, 0xb8, 0x73, 0x69, 0x9f, 0x0e, 0x07, 0x99,
-	0xe5, 0xf3, 0xde, 0x27, 0x5c, 0x64, 0xa3, 0x07, 0x1f, 0x36, 0xa6, 0xfe, 0x25, 0x42, 0x66, 0x4a,
-	0xb6, 0x77, 0x32, 0xdc, 0x47, 0xf2, 0x2c, 0x39, 0x14, 0xd8, 0xdf, 0xa8, 0xf6, 0xf9, 0x70, 0x90,
-	0xb9, 0x71, 0x5e, 0x5b, 0x67, 0x57, 0x2e, 0x26, 0xd1, 0xc7, 0x24, 0xde, 0xb6, 0x3b, 0x76, 0xd0,
-	0xf5, 0xa8, 0x76, 0x73, 0x38, 0xc8, 0xa8, 0xdf, 0x93, 0x8d, 0xcb, 0x1e, 0x93, 0xe8, 0x37, 0x24,
-	0xe6, 0x39, 0xae, 0x1f, 0x8e, 0xef, 0xa3, 0xb1, 0xf1, 0x8d, 0x75, 0x70, 0xad, 0xea, 0xb8, 0xbe,
-	0x76, 0x7b, 0x38, 0xc8, 0x7c, 0xf1, 0xfd, 0xfd, 0xc2, 0xc1, 0xde, 0x53, 0x39, 0xb6, 0xab, 0xd6,
-	0x48, 0x4c, 0x26, 0xd3, 0x19, 0x32, 0xad, 0x9b, 0x7e, 0xdf, 0x35, 0xdb, 0x30, 0x45, 0x2f, 0x90,
-	0x99, 0x5c, 0xb0, 0xa1, 0x33, 0xe1, 0xb5, 0x40, 0xa1, 0xf3, 0x84, 0x84, 0x17, 0xb2, 0x5e, 0x0b,
-	0x22, 0x32, 0xa0, 0x16, 0x9c, 0x4b, 0x18, 0x10, 0x95, 0x01, 0xe1, 0x05, 0x19, 0x10, 0x53, 0x2b,
-	0x84, 0x04, 0x8b, 0x52, 0xf6, 0x52, 0x9e, 0xff, 0xc1, 0x37, 0x83, 0x97, 0x56, 0x26, 0xce, 0xff,
-	0x70, 0xf1, 0x8e, 0x22, 0xe4, 0x1a, 0x3c, 0xdd, 0x1d, 0xe2, 0xe1, 0xeb, 0xbf, 0xfa, 0x87, 0x14,
-	0x49, 0x8e, 0x76, 0x1c, 0x0a, 0x64, 0x36, 0x57, 0xe3, 0xcf, 0x79, 0xbe, 0x9a, 0xe7, 0xfb, 0x79,
-	0x06, 0x53, 0x74, 0x9a, 0x44, 0xb3, 0xdb, 0x3a, 0x28, 0x88, 0x52, 0x09, 0x22, 0x88, 0x32, 0x83,
-	0x28, 0x42, 0x2f, 0x40, 0x0c, 0xc1, 0xab, 0x10, 0x47, 0xd4, 0x18, 0x24, 0x10, 0xf5, 0x02, 0x4c,
-	0x23, 0x9a, 0x3a, 0x24, 0x25, 0xb4, 0x6c, 0x19, 0x52, 0x08, 0x8d, 0x01, 0x41, 0x14, 0x74, 0x98,
-	0x41, 0xec, 0x30, 0x98, 0x45, 0x94, 0x19, 0xcc, 0x21, 0x74, 0x06, 0xf3, 0x88, 0x8a, 0x06, 0x17,
-	0x10, 0xbc, 0x04, 0x80, 0xa8, 0x32, 0x58, 0x40, 0xd4, 0x0d, 0xa0, 0x88, 0x86, 0x0e, 0x17, 0x03,
-	0x70, 0xb8, 0x84, 0x68, 0x32, 0xb8, 0x2c, 0x91, 0xcb, 0x32, 0xb8, 0x82, 0x28, 0x19, 0x70, 0x15,
-	0xa1, 0x37, 0x20, 0x8d, 0xa8, 0x18, 0x70, 0x0d, 0xc1, 0x73, 0xb0, 0x88, 0xa8, 0x19, 0xf0, 0x11,
-	0xa2, 0x59, 0x84, 0xeb, 0x12, 0xac, 0x58, 0x84, 0x8f, 0x11, 0x15, 0x03, 0x96, 0x10, 0x4d, 0x06,
-	0x19, 0x89, 0x7c, 0xbe, 0x08, 0xcb, 0x88, 0x82, 0x01, 0x9f, 0x20, 0x6a, 0x1c, 0x54, 0x89, 0xed,
-	0x27, 0x0c, 0x6e, 0x20, 0x8a, 0x06, 0x7c, 0x2a, 0x51, 0xd0, 0x0c, 0xf8, 0x0c, 0x51, 0x30, 0xe0,
-	0x26, 0x62, 0x27, 0x07, 0x9f, 0x23, 0x76, 0x0d, 0x58, 0x41, 0xec, 0x3d, 0x85, 0x2f, 0x10, 0x0d,
-	0x06, 0xab, 0x12, 0x3b, 0x45, 0x06, 0x5f, 0x22, 0xf4, 0x12, 0xdc, 0x42, 0xf0, 0x22, 0xdc, 0x46,
-	0xd4, 0xb6, 0x61, 0x4d, 0x62, 0x97, 0x71, 0x58, 0x47, 0x94, 0xaa, 0x70, 0x07, 0x51, 0x36, 0x60,
-	0x03, 0xa1, 0x73, 0xd8, 0x44, 0x3c, 0x65, 0x70, 0x17, 0xc1, 0x39, 0xdc, 0x43, 0x54, 0x8b, 0xf0,
-	0x7f, 0x12, 0x4f, 0xf2, 0x06, 0xdc, 0x47, 0x94, 0x19, 0x7c, 0x85, 0xa8, 0x30, 0x78, 0x80, 0x30,
-	0x1a, 0xf0, 0xff, 0x12, 0xc5, 0x7c, 0x15, 0x1e, 0x22, 0x0a, 0x55, 0x78, 0x84, 0xd8, 0xe1, 0xf0,
-	0x18, 0x61, 0xd4, 0x61, 0x0b, 0xc1, 0xeb, 0xf0, 0x03, 0x44, 0x9d, 0xc1, 0xd7, 0x88, 0x06, 0x83,
-	0x1f, 0x22, 0x9a, 0x7b, 0x90, 0x95, 0x28, 0x65, 0x8b, 0xa0, 0x21, 0x34, 0x03, 0x72, 0x88, 0x22,
-	0x07, 0x86, 0xe0, 0x0c, 0xf2, 0x88, 0xbd, 0x12, 0x6c, 0x23, 0xf6, 0x4b, 0x50, 0x40, 0x34, 0x18,
-	0xec, 0x48, 0x94, 0xb3, 0x0c, 0x76, 0x11, 0x45, 0x06, 0x4f, 0x10, 0xfa, 0x1e, 0x14, 0x11, 0x35,
-	0x0e, 0x25, 0xc4, 0x33, 0x1d, 0xca, 0x88, 0x7a, 0x11, 0x74, 0x44, 0x83, 0x43, 0x05, 0xd1, 0xd4,
-	0xc1, 0x90, 0xd0, 0xb3, 0x0c, 0x9e, 0x22, 0x0a, 0x3a, 0x70, 0xc4, 0x6e, 0x05, 0xaa, 0x88, 0x4a,
-	0x11, 0xf6, 0x10, 0x06, 0x87, 0x1a, 0xa2, 0xc9, 0x60, 0x5f, 0xa2, 0x52, 0xe6, 0x50, 0x97, 0x30,
-	0xb2, 0x1a, 0x3c, 0x43, 0xe4, 0x75, 0x68, 0x20, 0x76, 0x0c, 0x68, 0x22, 0x8a, 0x1c, 0x7e, 0x84,
-	0x28, 0xe9, 0xf0, 0x63, 0x44, 0xa3, 0x00, 0x3f, 0x91, 0x78, 0x9a, 0xe5, 0xf0, 0x8d, 0x04, 0xaf,
-	0xe8, 0xf0, 0x1c, 0x51, 0x65, 0xf0, 0x53, 0x44, 0x4d, 0x03, 0x33, 0x00, 0x87, 0x03, 0x89, 0x6a,
-	0x96, 0x43, 0x0b, 0xa1, 0x31, 0xb0, 0x10, 0x39, 0x0e, 0x02, 0x91, 0x2f, 0xc2, 0x0b, 0x44, 0x81,
-	0xc1, 0x21, 0x62, 0xc7, 0x80, 0x97, 0x88, 0x4a, 0x15, 0x6c, 0x04, 0x67, 0xf0, 0x33, 0xc4, 0x7e,
-	0x0e, 0xbe, 0x45, 0x34, 0x0c, 0x68, 0x4b, 0xec, 0xed, 0x68, 0xd0, 0x41, 0xe8, 0x0c, 0xba, 0x08,
-	0x5e, 0x02, 0x27, 0x40, 0x03, 0x7a, 0x88, 0x3d, 0x06, 0xaf, 0x10, 0x75, 0x06, 0x2e, 0xa2, 0x59,
-	0x05, 0x4f, 0xa2, 0x96, 0xdd, 0x01, 0x1f, 0x51, 0x78, 0x06, 0x7d, 0x7c, 0xbb, 0xf3, 0x0c, 0x8e,
-	0xf0, 0x4a, 0xa3, 0x06, 0xdf, 0x21, 0x9a, 0x55, 0x78, 0x2d, 0xb1, 0x9f, 0xdf, 0x86, 0x63, 0x84,
-	0xce, 0xe0, 0xe7, 0x12, 0xcf, 0x72, 0x0c, 0x7e, 0x21, 0xd1, 0xc8, 0x73, 0xf8, 0xa5, 0x44, 0x33,
-	0xcb, 0xe1, 0x57, 0x88, 0x72, 0x1d, 0x7e, 0x8d, 0xa8, 0x33, 0xf8, 0x0d, 0x4d, 0x92, 0x68, 0xad,
-	0xca, 0xe0, 0xb7, 0xca, 0xea, 0x4d, 0x32, 0x1b, 0x6c, 0x64, 0x55, 0xdf, 0xf4, 0xfb, 0x1e, 0x4d,
-	0x92, 0x98, 0x61, 0xda, 0x16, 0x4c, 0xd1, 0x59, 0x92, 0xe4, 0xe1, 0x8f, 0x0c, 0x50, 0x56, 0x3d,
-	0x92, 0x1c, 0x7d, 0x9c, 0xd1, 0x05, 0x32, 0x97, 0xcb, 0x72, 0xf6, 0x9c, 0x0b, 0x4f, 0xb8, 0x47,
-	0x42, 0x06, 0xcf, 0x13, 0x52, 0x36, 0x3d, 0x5f, 0xb8, 0x2d, 0xd3, 0xb5, 0x40, 0x91, 0xcd, 0xec,
-	0xdb, 0x9e, 0x09, 0x11, 0x7a, 0x91, 0x5c, 0xc8, 0x76, 0x84, 0x6b, 0xb7, 0xcc, 0x6e, 0xfe, 0x75,
-	0xcf, 0x15, 0x9e, 0x17, 0xec, 0x6d, 0x4f, 0x72, 0x1a, 0xc4, 0xe4, 0x4d, 0x98, 0xed, 0xb5, 0x9c,
-	0x23, 0xe1, 0x42, 0x5c, 0xb6, 0xc2, 0xec, 0xae, 0x70, 0xbd, 0x5c, 0xbb, 0x7f, 0x00, 0x89, 0xd5,
-	0xa7, 0x64, 0x61, 0xe2, 0x53, 0x84, 0x5e, 0x26, 0x0b, 0x06, 0xaf, 0xec, 0xef, 0xb2, 0x3c, 0x1f,
-	0xef, 0x01, 0x21, 0x89, 0xaa, 0xef, 0xda, 0x3d, 0x01, 0x8a, 0xb4, 0x61, 0x1e, 0xf7, 0xcc, 0x36,
-	0x44, 0xe8, 0x1c, 0x49, 0x69, 0xae, 0x69, 0x77, 0x7d, 0x57, 0x08, 0x88, 0xae, 0x56, 0xc9, 0xec,
-	0xf8, 0x01, 0x2b, 0xb7, 0xe4, 0x82, 0xe8, 0x0a, 0xd7, 0x6c, 0xe7, 0x5d, 0xd7, 0x71, 0x61, 0x8a,
-	0xa6, 0x48, 0x7c, 0xdb, 0x35, 0xfb, 0x72, 0x14, 0x73, 0x24, 0xc5, 0xfa, 0xbd, 0xb6, 0xdd, 0x32,
-	0x7d, 0x01, 0x11, 0x7a, 0x95, 0x5c, 0x0c, 0x0f, 0x2e, 0x61, 0x69, 0xc7, 0xb9, 0xbe, 0xe7, 0x3b,
-	0x1d, 0xe1, 0x42, 0x74, 0xf3, 0x3f, 0x0a, 0x99, 0x0f, 0x3b, 0x5a, 0x15, 0xee, 0x91, 0xdd, 0x92,
-	0x5f, 0xd4, 0x29, 0x5d, 0x7c, 0x17, 0xfe, 0x9a, 0x4d, 0xbf, 0xeb, 0x9b, 0x67, 0x71, 0xf2, 0x40,
-	0x51, 0xa7, 0xe8, 0xd6, 0xa8, 0x8f, 0xe7, 0xa4, 0xbf, 0xf5, 0x39, 0x72, 0x7e, 0xfa, 0x06, 0x89,
-	0x16, 0x84, 0x4f, 0x2f, 0x8f, 0xd5, 0x9d, 0x7e, 0x02, 0x9d, 0x9f, 0xf2, 0x15, 0x89, 0xe1, 0x81,
-	0x77, 0xe5, 0xfc, 0x73, 0x7a, 0xf1, 0xf2, 0x44, 0x92, 0xac, 0x55, 0xa7, 0xb4, 0xc7, 0x7f, 0x7d,
-	0xb3, 0xa4, 0xfc, 0xed, 0xcd, 0x92, 0xf2, 0x8f, 0x37, 0x4b, 0xca, 0xef, 0xfe, 0xb9, 0x34, 0xd5,
-	0x5c, 0x1d, 0xfb, 0xcd, 0x6f, 0xd9, 0x87, 0x8e, 0x6f, 0x8e, 0xfe, 0x4c, 0xfc, 0xe3, 0xe0, 0x20,
-	0x81, 0xbf, 0xfb, 0xef, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x21, 0x71, 0xbe, 0xbc, 0x54, 0x10,
-	0x00, 0x00,
+	// 2297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcd, 0x76, 0xdb, 0xc6,
+	0x15, 0x80, 0xc5, 0x5f, 0x91, 0xa3, 0x1f, 0x5f, 0x8d, 0xed, 0x04, 0x56, 0x14, 0x51, 0x41, 0x12,
+	0x47, 0x51, 0x6c, 0xc9, 0x92, 0x1d, 0x27, 0xb1, 0xa5, 0x24, 0x04, 0x41, 0x51, 0x32, 0x49, 0x10,
+	0x1e, 0x8a, 0x92, 0xc9, 0xb6, 0x71, 0x21, 0x62, 0x2c, 0xa1, 0x21, 0x09, 0x06, 0x04, 0x15, 0x2b,
+	0xfd, 0xcb, 0xae, 0xaf, 0xd0, 0x4d, 0x57, 0x5d, 0x77, 0xdf, 0x47, 0xe8, 0xb2, 0x4f, 0xc0, 0xd3,
+	0x93, 0x9e, 0xd3, 0x6e, 0x7a, 0xba, 0xe0, 0x03, 0xb4, 0x3d, 0x73, 0x01, 0x4a, 0xa0, 0x48, 0xd9,
+	0x4e, 0xda, 0xae, 0xf0, 0xcd, 0xcc, 0xbd, 0x33, 0x77, 0xfe, 0xee, 0xbd, 0x03, 0x92, 0x6a, 0x1b,
+	0xa7, 0x4d, 0xde, 0x72, 0xd7, 0xfc, 0x6f, 0xfb, 0x70, 0x40, 0xab, 0x6d, 0xc7, 0x76, 0x6d, 0x9a,
+	0x3c, 0x6b, 0x98, 0xbf, 0x7d, 0x64, 0xb9, 0xc7, 0xdd, 0xc3, 0xd5, 0xba, 0xdd, 0x5c, 0x3b, 0xb2,
+	0x8f, 0xec, 0x35, 0x94, 0x38, 0xec, 0x3e, 0xc3, 0x12, 0x16, 0x90, 0x3c, 0x4d, 0xf9, 0x5f, 0x31,
+	0x12, 0xcf, 0x1c, 0x1b, 0xce, 0x11, 0xa7, 0x8b, 0x24, 0x6c, 0x99, 0x52, 0x68, 0x29, 0xb4, 0x9c,
+	0x54, 0x66, 0xfb, 0xbd, 0x14, 0x39, 0xec, 0xd8, 0xad, 0x07, 0xf2, 0x53, 0xcb, 0x94, 0x59, 0xd8,
+	0x32, 0xe9, 0x02, 0x49, 0x76, 0x5c, 0xc3, 0xe5, 0x62, 0x20, 0x29, 0x2c, 0xc4, 0xd8, 0x79, 0x05,
+	0x95, 0xc9, 0x74, 0x1d, 0xfb, 0x49, 0x37, 0xed, 0x6e, 0xcb, 0x95, 0x22, 0x4b, 0xa1, 0xe5, 0x28,
+	0x1b, 0xaa, 0x13, 0x32, 0x0e, 0x7f, 0xd6, 0x6d, 0x99, 0xbe, 0x4c, 0xd4, 0x93, 0x09, 0xd6, 0xd1,
+	0x0f, 0xc8, 0xa4, 0x57, 0xee, 0x48, 0xb1, 0xa5, 0xc8, 0xf2, 0xd4, 0xc6, 0xdc, 0xea, 0xd9, 0xe4,
+	0x56, 0x19, 0xb6, 0xb0, 0x81, 0x04, 0x5d, 0x23, 0x89, 0x7a, 0xd7, 0x71, 0x78, 0xab, 0x7e, 0x2a,
+	0xc5, 0x97, 0x42, 0xcb, 0xb3, 0x1b, 0x57, 0x03, 0xd2, 0x19, 0xbf, 0x89, 0x9d, 0x09, 0xd1, 0x6b,
+	0x24, 0xc6, 0x9b, 0x86, 0xd5, 0x90, 0x26, 0xd1, 0x7e, 0xaf, 0x40, 0x29, 0x89, 0xb6, 0x0d, 0xcb,
+	0x94, 0x12, 0x4b, 0xa1, 0xe5, 0x04, 0x43, 0xa6, 0xf3, 0x24, 0xe1, 0x8d, 0xc2, 0x4d, 0x29, 0x89,
+	0xf5, 0x67, 0x65, 0xba, 0x49, 0x48, 0xdb, 0xb1, 0x4f, 0x2c, 0x93, 0x3b, 0xbb, 0xa6, 0x44, 0x70,
+	0xe0, 0x85, 0xc0, 0xc0, 0xba, 0x47, 0xfa, 0x99, 0x0c, 0x0b, 0xc8, 0xd3, 0x15, 0x02, 0x83, 0x92,
+	0xb7, 0xf2, 0xbb, 0xa6, 0x34, 0x85, 0xe6, 0x8c, 0xd4, 0xd3, 0x87, 0x24, 0xd1, 0xe4, 0xae, 0x61,
+	0x1a, 0xae, 0x21, 0x4d, 0xe3, 0x72, 0xa4, 0x82, 0x13, 0x44, 0xb1, 0xd5, 0xa2, 0x2f, 0x91, 0x6d,
+	0xb9, 0xce, 0x29, 0x3b, 0x53, 0xa0, 0x77, 0x48, 0x5c, 0xec, 0x4f, 0xb7, 0x23, 0xcd, 0xa0, 0x89,
+	0xd2, 0xa8, 0x6a, 0x19, 0xdb, 0x99, 0x2f, 0x27, 0x4c, 0x33, 0xba, 0xee, 0xb1, 0xed, 0x58, 0xdf,
+	0xf0, 0xc1, 0x26, 0xcd, 0xe2, 0x26, 0x8d, 0xd4, 0xd3, 0x45, 0x42, 0xea, 0xdd, 0x8e, 0x6b, 0x37,
+	0x71, 0x11, 0xae, 0xe0, 0x04, 0x02, 0x35, 0xf4, 0x06, 0x99, 0xac, 0x3b, 0xdc, 0x70, 0xb9, 0x29,
+	0xfd, 0x4d, 0xac, 0x76, 0x84, 0x0d, 0xca, 0xa2, 0xa9, 0xdb, 0x36, 0xb1, 0xe9, 0xef, 0x7e, 0x93,
+	0x5f, 0x9e, 0x7f, 0x48, 0x66, 0x86, 0xa6, 0x43, 0x81, 0x44, 0xbe, 0xe4, 0xa7, 0xde, 0xb1, 0x64,
+	0x02, 0xc5, 0x1e, 0x9e, 0x18, 0x8d, 0x2e, 0xf7, 0xcf, 0xa0, 0x57, 0x78, 0x10, 0xfe, 0x38, 0x24,
+	0x6f, 0x90, 0xb8, 0x37, 0x21, 0x3a, 0x4d, 0x12, 0x19, 0xa3, 0xed, 0x76, 0x1d, 0x6e, 0xc2, 0x04,
+	0x9d, 0x25, 0x24, 0x7d, 0x66, 0x3e, 0x84, 0x28, 0x21, 0xf1, 0x7d, 0xdb, 0x32, 0xb9, 0x09, 0x61,
+	0xf9, 0xf7, 0x21, 0x12, 0xf7, 0x8e, 0xd5, 0xc8, 0xf1, 0x0c, 0x8d, 0x39, 0x9e, 0x81, 0xcd, 0xf3,
+	0xb4, 0x76, 0x4d, 0xdf, 0x8e, 0x91, 0x7a, 0xba, 0x46, 0xe2, 0x0e, 0x37, 0x3a, 0x76, 0x0b, 0x2f,
+	0xc3, 0xec, 0xc6, 0xeb, 0xa3, 0x27, 0x19, 0x9b, 0x99, 0x2f, 0x46, 0xa5, 0xf3, 0x25, 0x8b, 0x0e,
+	0xad, 0x98, 0xfc, 0xc7, 0x08, 0x89, 0x66, 0x0c, 0xc7, 0xa4, 0xf7, 0x49, 0x5c, 0xeb, 0x36, 0x0f,
+	0xb9, 0xe3, 0x5f, 0xd4, 0xc5, 0x7e, 0x2f, 0x35, 0x7f, 0x62, 0x34, 0x2c, 0xb1, 0x7c, 0x0f, 0x64,
+	0x87, 0x7f, 0xd5, 0xb5, 0x1c, 0x6e, 0xde, 0x6a, 0xf0, 0xd6, 0xd6, 0xfa, 0x7d, 0x99, 0xf9, 0xd2,
+	0xf4, 0x33, 0x32, 0x95, 0x7d, 0xde, 0xb6, 0x1c, 0x5e, 0xb4, 0x5b, 0xee, 0xb1, 0x67, 0xb2, 0xf2,
+	0x66, 0xbf, 0x97, 0xba, 0x71, 0x89, 0xf2, 0x86, 0xcc, 0x82, 0x1a, 0x74, 0x8b, 0x10, 0xaf, 0x58,
+	0xe5, 0x86, 0x23, 0x45, 0x5e, 0xaa, 0x7f, 0x4f, 0x66, 0x01, 0x05, 0xba, 0x49, 0x92, 0xdb, 0x96,
+	0xd3, 0x71, 0x35, 0xa3, 0xc9, 0xa5, 0xe8, 0x38, 0xd3, 0xed, 0xa6, 0xe5, 0xf2, 0x66, 0xdb, 0x3d,
+	0xbd, 0xd5, 0xb4, 0x5a, 0x5b, 0xeb, 0x32, 0x3b, 0x57, 0xa0, 0x0f, 0x48, 0xa2, 0x60, 0xf8, 0xca,
+	0xb1, 0x57, 0x52, 0x3e, 0x93, 0xa7, 0x77, 0x48, 0x24, 0xb3, 0x9f, 0x91, 0xe2, 0x2f, 0x56, 0x13,
+	0x26, 0xdf, 0x95, 0x99, 0x10, 0xa5, 0x05, 0x12, 0x75, 0x4f, 0xdb, 0x5c, 0x4a, 0x8c, 0x7a, 0x14,
+	0xc3, 0x31, 0xf7, 0x4e, 0xdb, 0x5c, 0x79, 0xbb, 0xdf, 0x4b, 0xa5, 0xc6, 0xcc, 0xfc, 0xc8, 0xe5,
+	0x5b, 0xeb, 0xb7, 0x1a, 0x2e, 0xdf, 0xba, 0x2f, 0x33, 0xec, 0x45, 0xfe, 0x67, 0x8c, 0xcc, 0x78,
+	0xb7, 0x8d, 0xf1, 0xaf, 0xba, 0xbc, 0xe3, 0xd2, 0xfd, 0x80, 0xd7, 0x0a, 0x5d, 0xea, 0xb5, 0x94,
+	0x77, 0xfb, 0xbd, 0xd4, 0x5b, 0x2f, 0x1c, 0x63, 0x7d, 0xe3, 0x63, 0x39, 0xe0, 0xdc, 0xee, 0x92,
+	0x98, 0x6b, 0xbb, 0x46, 0x03, 0x77, 0x37, 0x7a, 0xe9, 0xee, 0x08, 0xfd, 0x3b, 0x32, 0xf3, 0x64,
+	0xa9, 0xe2, 0x1d, 0x2c, 0xdc, 0xd1, 0xa9, 0x8d, 0x2b, 0x17, 0x26, 0x7b, 0xb1, 0x93, 0xf3, 0x05,
+	0x33, 0xad, 0x13, 0x2e, 0x33, 0xef, 0x50, 0xae, 0x0c, 0xbc, 0xaa, 0xb7, 0xb1, 0xd7, 0xfa, 0xbd,
+	0x14, 0x9c, 0xeb, 0x60, 0x93, 0x3c, 0xf0, 0xb5, 0x43, 0x51, 0x24, 0x76, 0x31, 0x8a, 0xd8, 0x64,
+	0xae, 0x7d, 0xd1, 0x79, 0x4a, 0xf1, 0x97, 0x3b, 0xd8, 0x57, 0xd8, 0x90, 0xbb, 0x32, 0x1b, 0xed,
+	0x9b, 0x2a, 0x01, 0x07, 0x3b, 0x89, 0x0e, 0xf6, 0xe6, 0x88, 0x97, 0xf4, 0xf7, 0xed, 0x52, 0x3f,
+	0xbb, 0x43, 0x66, 0x2d, 0x93, 0x37, 0xdb, 0xb6, 0x2b, 0xb6, 0x21, 0xcf, 0x4f, 0xf1, 0xe4, 0x24,
+	0x95, 0xa5, 0x7e, 0x2f, 0xb5, 0x30, 0xf6, 0x8c, 0x1a, 0xcf, 0xb7, 0x36, 0x3e, 0xfc, 0x50, 0x66,
+	0x17, 0xf4, 0xe8, 0x36, 0x99, 0xf1, 0x07, 0x2f, 0x72, 0xf7, 0xd8, 0xf6, 0x22, 0xcf, 0xab, 0x74,
+	0x34, 0xac, 0x46, 0x3f, 0x1d, 0xf2, 0xcd, 0xe4, 0xc5, 0x47, 0xbf, 0xdb, 0xb5, 0x4c, 0x71, 0x5b,
+	0xcf, 0x35, 0xfe, 0x3b, 0x2f, 0xfc, 0x8f, 0x10, 0x99, 0xd1, 0x87, 0xcc, 0x19, 0x8e, 0x97, 0xa1,
+	0xef, 0x19, 0x2f, 0x17, 0x48, 0xd2, 0xe1, 0xcf, 0xb8, 0x38, 0xe3, 0x83, 0xd1, 0xce, 0x2b, 0xe8,
+	0x7b, 0xfe, 0x65, 0x8d, 0x5c, 0x7a, 0x59, 0xbd, 0x7b, 0x28, 0x0c, 0x6e, 0x18, 0x1d, 0xf7, 0x9e,
+	0x77, 0x48, 0x99, 0x57, 0xa0, 0x4b, 0x64, 0x8a, 0x07, 0xfc, 0xa2, 0x77, 0x20, 0x83, 0x55, 0x22,
+	0xce, 0xf1, 0x73, 0xc7, 0x87, 0x6e, 0x84, 0x05, 0x6a, 0xe4, 0x6f, 0xc3, 0xe4, 0xda, 0xd0, 0x74,
+	0x07, 0xd7, 0x3c, 0x4d, 0xa2, 0x75, 0xc3, 0xf1, 0xe6, 0x3b, 0xe6, 0x66, 0x2d, 0xf4, 0x7b, 0x29,
+	0x69, 0xcc, 0x89, 0xf5, 0x2f, 0x96, 0x50, 0xa5, 0xf7, 0x06, 0x17, 0x2b, 0xfc, 0xe2, 0x2d, 0x1c,
+	0xbe, 0x62, 0x63, 0x2f, 0x51, 0xe4, 0xff, 0x77, 0x89, 0xe4, 0x87, 0x84, 0xe4, 0xb8, 0x3b, 0x98,
+	0xf7, 0xed, 0x40, 0x1e, 0x79, 0xc1, 0x7d, 0xe0, 0x51, 0xbb, 0x35, 0xe8, 0x17, 0xd3, 0x4a, 0xf9,
+	0x0f, 0x21, 0x32, 0x33, 0x88, 0x86, 0x3f, 0xa4, 0x03, 0xfa, 0x1a, 0x89, 0x1b, 0x5e, 0xc0, 0x46,
+	0xbf, 0xc7, 0xfc, 0x12, 0xad, 0xbc, 0x62, 0xf8, 0x55, 0xde, 0xe9, 0xf7, 0x52, 0x4b, 0xe3, 0x96,
+	0x15, 0x3d, 0xe5, 0x60, 0xde, 0x7e, 0x67, 0xf2, 0x01, 0x99, 0xf5, 0x53, 0x8b, 0xff, 0xad, 0xbd,
+	0xf2, 0x26, 0x99, 0x12, 0x59, 0xc9, 0x0f, 0x5c, 0xc6, 0x7f, 0x87, 0xc9, 0x54, 0xc1, 0xea, 0x9c,
+	0xed, 0xc2, 0x43, 0x91, 0xd3, 0x1e, 0x71, 0xec, 0x20, 0xa2, 0xbc, 0xd7, 0xef, 0xa5, 0xde, 0x1e,
+	0x37, 0xc5, 0x8b, 0x51, 0x01, 0x95, 0xe8, 0x26, 0x89, 0x35, 0xac, 0xa6, 0xe5, 0x59, 0x18, 0x51,
+	0x6e, 0xf6, 0x7b, 0x29, 0xf9, 0x25, 0xda, 0x18, 0x52, 0x50, 0x89, 0x7e, 0x41, 0xa2, 0x1d, 0xdb,
+	0x71, 0xfd, 0x65, 0x7f, 0x23, 0xb0, 0xec, 0x01, 0x03, 0x57, 0xcb, 0xb6, 0xe3, 0x2a, 0xb7, 0xfb,
+	0xbd, 0xd4, 0xfb, 0x2f, 0xb7, 0x0b, 0xf7, 0xe0, 0x9e, 0xcc, 0xb0, 0xdf, 0x0b, 0xde, 0x2d, 0xfa,
+	0x7d, 0xbd, 0x9b, 0x5c, 0x21, 0x51, 0x31, 0x38, 0x9d, 0x22, 0x93, 0x9a, 0xe1, 0x76, 0x1d, 0xa3,
+	0x01, 0x13, 0xf4, 0x0a, 0x99, 0xca, 0x78, 0xc9, 0x96, 0xca, 0x3b, 0x75, 0x08, 0x89, 0xa4, 0xd1,
+	0xaf, 0x48, 0x77, 0xea, 0x10, 0x16, 0x02, 0x95, 0xb6, 0x79, 0x26, 0x10, 0x11, 0x02, 0x7e, 0x85,
+	0x10, 0x88, 0xca, 0x25, 0x42, 0xbc, 0x78, 0x21, 0x66, 0x29, 0xde, 0x31, 0xde, 0xdb, 0xa7, 0x23,
+	0x85, 0x46, 0xde, 0x31, 0x9e, 0x1c, 0x1b, 0x48, 0x08, 0xdf, 0x74, 0x1e, 0xb9, 0x63, 0x7e, 0x68,
+	0x5e, 0xf9, 0x5d, 0x92, 0x24, 0x06, 0xd9, 0x00, 0x05, 0x32, 0x9d, 0xa9, 0xb0, 0xa7, 0x2c, 0x5b,
+	0xce, 0xb2, 0xfd, 0xac, 0x0a, 0x13, 0x74, 0x92, 0x44, 0xd2, 0xdb, 0x1a, 0x84, 0x10, 0x0a, 0x05,
+	0x08, 0x23, 0x14, 0x55, 0x88, 0x20, 0x68, 0x39, 0x88, 0x22, 0xb0, 0x32, 0xc4, 0x10, 0x2a, 0x2a,
+	0xc4, 0x11, 0x0e, 0x72, 0x30, 0x89, 0x50, 0xd3, 0x20, 0x21, 0x40, 0x49, 0x17, 0x21, 0x89, 0xa0,
+	0xa8, 0x40, 0x10, 0x72, 0x1a, 0x4c, 0x21, 0xec, 0xa8, 0x30, 0x8d, 0x50, 0x54, 0x61, 0x06, 0x41,
+	0x53, 0x61, 0x16, 0xa1, 0xa4, 0xc0, 0x15, 0x04, 0x56, 0x00, 0x40, 0x28, 0xab, 0x30, 0x87, 0x70,
+	0xa0, 0x03, 0x45, 0xa8, 0x6a, 0x70, 0xd5, 0x03, 0x06, 0xd7, 0x10, 0x6a, 0x2a, 0x5c, 0x17, 0x90,
+	0x49, 0xab, 0xf0, 0x1a, 0x42, 0x41, 0x87, 0xd7, 0x11, 0xb4, 0x2a, 0x48, 0x08, 0x25, 0x1d, 0x6e,
+	0x20, 0xb0, 0x0c, 0xcc, 0x23, 0x54, 0x74, 0x78, 0x03, 0xa1, 0x96, 0x87, 0x05, 0x01, 0x6a, 0x3e,
+	0x0f, 0x6f, 0x22, 0x94, 0x74, 0x58, 0x44, 0xa8, 0xa9, 0x90, 0x12, 0x90, 0xcd, 0xe6, 0x61, 0x09,
+	0x21, 0xa7, 0xc3, 0x5b, 0x08, 0x15, 0x06, 0xb2, 0x80, 0xed, 0x47, 0x2a, 0xbc, 0x8d, 0x90, 0xd7,
+	0xe1, 0x1d, 0x01, 0x39, 0x45, 0x87, 0x77, 0x11, 0x72, 0x3a, 0xdc, 0x44, 0xd8, 0xc9, 0xc0, 0x7b,
+	0x08, 0xbb, 0x3a, 0x2c, 0x23, 0xec, 0x3d, 0x86, 0xf7, 0x11, 0xaa, 0x2a, 0xac, 0x08, 0xd8, 0xc9,
+	0xab, 0xf0, 0x01, 0x82, 0x56, 0x80, 0x5b, 0x08, 0x2c, 0x0f, 0xb7, 0x11, 0x2a, 0xdb, 0xb0, 0x2a,
+	0x60, 0x57, 0x65, 0xb0, 0x86, 0x50, 0x28, 0xc3, 0x1d, 0x84, 0xa2, 0x0e, 0xeb, 0x08, 0x1a, 0x83,
+	0x0d, 0x84, 0xc7, 0x2a, 0xdc, 0x45, 0x60, 0x0c, 0xee, 0x21, 0x94, 0xf3, 0xf0, 0xa1, 0x80, 0x47,
+	0x59, 0x1d, 0xee, 0x23, 0x14, 0x55, 0xf8, 0x08, 0xa1, 0xa4, 0xc2, 0xc7, 0x08, 0x7a, 0x15, 0x3e,
+	0x11, 0x90, 0xcf, 0x96, 0xe1, 0x01, 0x42, 0xae, 0x0c, 0x0f, 0x11, 0x76, 0x18, 0x6c, 0x22, 0xe8,
+	0x07, 0xb0, 0x85, 0xc0, 0x0e, 0xe0, 0x53, 0x84, 0x03, 0x15, 0x3e, 0x43, 0xa8, 0xaa, 0xf0, 0x39,
+	0x42, 0x6d, 0x0f, 0xd2, 0x02, 0x0a, 0xe9, 0x3c, 0x28, 0x08, 0x8a, 0x0e, 0x19, 0x84, 0x3c, 0x03,
+	0x15, 0x81, 0xa9, 0x90, 0x45, 0xd8, 0x2b, 0xc0, 0x36, 0xc2, 0x7e, 0x01, 0x72, 0x08, 0x55, 0x15,
+	0x76, 0x04, 0x14, 0xd3, 0x2a, 0xec, 0x22, 0xe4, 0x55, 0x78, 0x84, 0xa0, 0xed, 0x41, 0x1e, 0xa1,
+	0xc2, 0xa0, 0x80, 0xf0, 0x44, 0x83, 0x22, 0xc2, 0x41, 0x1e, 0x34, 0x84, 0x2a, 0x83, 0x12, 0x42,
+	0x4d, 0x03, 0x5d, 0x80, 0x96, 0x56, 0xe1, 0x31, 0x42, 0x4e, 0x03, 0x86, 0xb0, 0x5b, 0x82, 0x32,
+	0x42, 0x29, 0x0f, 0x7b, 0x08, 0x3a, 0x83, 0x0a, 0x42, 0x4d, 0x85, 0x7d, 0x01, 0xa5, 0x22, 0x83,
+	0x03, 0x01, 0x7a, 0x5a, 0x81, 0x27, 0x08, 0x59, 0x0d, 0xaa, 0x08, 0x3b, 0x3a, 0xd4, 0x10, 0xf2,
+	0x0c, 0x7e, 0x84, 0x50, 0xd0, 0xe0, 0xc7, 0x08, 0xd5, 0x1c, 0xfc, 0x44, 0xc0, 0xe3, 0x34, 0x83,
+	0x2f, 0x04, 0xb0, 0x92, 0x06, 0x4f, 0x11, 0xca, 0x2a, 0xfc, 0x14, 0xa1, 0xa2, 0x80, 0xe1, 0x01,
+	0x83, 0x43, 0x01, 0xe5, 0x34, 0x83, 0x3a, 0x82, 0xa2, 0x82, 0x89, 0x90, 0x61, 0xc0, 0x11, 0xb2,
+	0x79, 0x78, 0x86, 0x90, 0x53, 0xe1, 0x08, 0x61, 0x47, 0x87, 0x63, 0x84, 0x52, 0x19, 0x2c, 0x04,
+	0xa6, 0xc2, 0xcf, 0x10, 0xf6, 0x33, 0xf0, 0x25, 0x42, 0x55, 0x87, 0x86, 0x80, 0xbd, 0x1d, 0x05,
+	0x9a, 0x08, 0x9a, 0x0a, 0x2d, 0x04, 0x56, 0x00, 0xdb, 0x83, 0x2a, 0xb4, 0x11, 0xf6, 0x54, 0xf8,
+	0x0a, 0xe1, 0x40, 0x05, 0x07, 0xa1, 0x56, 0x86, 0x8e, 0x80, 0x4a, 0x7a, 0x07, 0x5c, 0x84, 0xdc,
+	0x13, 0xe8, 0xe2, 0xed, 0xce, 0xaa, 0x70, 0x82, 0x35, 0xd5, 0x0a, 0x7c, 0x8d, 0x50, 0x2b, 0xc3,
+	0x73, 0x01, 0xfb, 0xd9, 0x6d, 0x38, 0x45, 0xd0, 0x54, 0xf8, 0x46, 0xc0, 0x93, 0x8c, 0x0a, 0x3f,
+	0x17, 0x50, 0xcd, 0x32, 0xf8, 0x85, 0x80, 0x5a, 0x9a, 0xc1, 0x2f, 0x11, 0x8a, 0x07, 0xf0, 0x2b,
+	0x84, 0x03, 0x15, 0x7e, 0x4d, 0x13, 0x24, 0x52, 0x29, 0xab, 0xf0, 0x6d, 0x68, 0xe5, 0x26, 0x99,
+	0xf6, 0x1c, 0x99, 0xff, 0xe8, 0x4e, 0x90, 0xa8, 0x6e, 0x58, 0xe2, 0xc1, 0x3d, 0x4d, 0x12, 0xcc,
+	0xff, 0x59, 0x02, 0xa1, 0x95, 0x0e, 0x49, 0x0c, 0x72, 0x31, 0x3a, 0x47, 0x66, 0x32, 0x69, 0xa6,
+	0x3e, 0x65, 0xbc, 0xc3, 0x9d, 0x93, 0xc1, 0xeb, 0xbc, 0x68, 0x74, 0x5c, 0xee, 0x88, 0x94, 0x07,
+	0x42, 0xa2, 0x9b, 0x7d, 0xab, 0x63, 0x40, 0x98, 0x5e, 0x25, 0x57, 0xd2, 0x4d, 0xee, 0x58, 0x75,
+	0xa3, 0x95, 0x7d, 0xde, 0x76, 0x78, 0xa7, 0xe3, 0xf9, 0xb6, 0x47, 0x19, 0x05, 0xa2, 0x62, 0x10,
+	0xd5, 0xea, 0xd4, 0xed, 0x13, 0xee, 0x40, 0x4c, 0xf4, 0xa2, 0x5a, 0x2d, 0xee, 0x74, 0x32, 0x8d,
+	0xee, 0x21, 0xc4, 0x57, 0x1e, 0x93, 0xb9, 0x91, 0x04, 0x87, 0x5e, 0x27, 0x73, 0x3a, 0x2b, 0xed,
+	0xef, 0xaa, 0x59, 0x16, 0xb4, 0x80, 0x88, 0xff, 0x06, 0x8e, 0xd5, 0xe6, 0xde, 0xbf, 0x01, 0xdd,
+	0x38, 0x6d, 0x1b, 0x0d, 0x08, 0xd3, 0x19, 0x92, 0x54, 0x1c, 0xc3, 0x6a, 0xb9, 0x0e, 0xe7, 0x10,
+	0x59, 0x29, 0x93, 0xe9, 0x60, 0xde, 0x20, 0x5c, 0x72, 0x8e, 0xb7, 0xb8, 0x63, 0x34, 0xb2, 0x8e,
+	0x63, 0x3b, 0x30, 0x41, 0x93, 0x24, 0xb6, 0xed, 0x18, 0x5d, 0x31, 0x8b, 0x19, 0x92, 0x54, 0xbb,
+	0xed, 0x86, 0x55, 0x37, 0x5c, 0x0e, 0x61, 0xfa, 0x3a, 0xb9, 0xea, 0x07, 0x3e, 0x6e, 0x2a, 0xa7,
+	0x19, 0x3f, 0x18, 0x41, 0x64, 0xe3, 0x37, 0x51, 0x32, 0xeb, 0x1b, 0x5a, 0xe6, 0xce, 0x89, 0x55,
+	0x17, 0xaf, 0xdd, 0xa4, 0xc6, 0xbf, 0xf6, 0xff, 0xca, 0x49, 0x97, 0x3d, 0x47, 0xe6, 0x47, 0x03,
+	0x8a, 0x3c, 0x41, 0xb7, 0x06, 0x36, 0x8e, 0x51, 0x1f, 0xca, 0xb2, 0xc6, 0xab, 0xaf, 0x93, 0x48,
+	0x8e, 0xbb, 0xf4, 0x7a, 0xa0, 0xed, 0x3c, 0xb3, 0x1b, 0xaf, 0xf2, 0x11, 0x89, 0x62, 0xc0, 0x7b,
+	0x6d, 0x7c, 0x9c, 0x9f, 0xbf, 0x3e, 0xa2, 0x24, 0x5a, 0xe5, 0x09, 0xfa, 0x39, 0xb9, 0x72, 0xf6,
+	0x57, 0xe6, 0x87, 0x4d, 0xf6, 0x33, 0x32, 0xe3, 0xa7, 0x62, 0xbe, 0xfe, 0x8d, 0xa0, 0xd4, 0x50,
+	0x92, 0x36, 0xbe, 0x83, 0x4f, 0x08, 0x11, 0x29, 0x97, 0xaf, 0x1d, 0x9c, 0x41, 0x20, 0x13, 0x1b,
+	0xaf, 0xaa, 0x93, 0xb9, 0xb2, 0x71, 0xc2, 0x87, 0x1f, 0x3a, 0xa9, 0xd1, 0xf4, 0x7a, 0xe8, 0x4d,
+	0x30, 0x2f, 0x5d, 0x26, 0x20, 0x4f, 0x28, 0x9b, 0x7f, 0xfa, 0x6e, 0x31, 0xf4, 0xe7, 0xef, 0x16,
+	0x43, 0x7f, 0xf9, 0x6e, 0x31, 0xf4, 0xdb, 0xbf, 0x2e, 0x4e, 0xd4, 0x56, 0x02, 0xff, 0x72, 0x4d,
+	0xeb, 0xc8, 0x76, 0x8d, 0xc1, 0x67, 0xe4, 0x87, 0xf0, 0x61, 0x1c, 0xff, 0xe7, 0xde, 0xfd, 0xcf,
+	0x00, 0xdc, 0x7e, 0x98, 0x7a, 0x2c, 0x16, 0x00, 0x00,
 }
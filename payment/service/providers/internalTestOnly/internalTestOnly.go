@@ -26,15 +26,23 @@ import (
 	"errors"
 	"github.com/digota/digota/payment/paymentpb"
 	"github.com/satori/go.uuid"
+	"sync"
 	"time"
 )
 
+// declinedPaymentMethod is saved for cards of error@error.com, charging
+// it always fails
+const declinedPaymentMethod = "pm_declined"
+
 type provider struct {
+	mtx sync.Mutex
+	// charge ids by their keys
+	keys map[string]string
 }
 
 // NewProvider create new provider for testings
 func NewProvider() (*provider, error) {
-	return &provider{}, nil
+	return &provider{keys: make(map[string]string)}, nil
 }
 
 func (p *provider) SupportedCards() []paymentpb.CardType {
@@ -47,15 +55,22 @@ func (p *provider) ProviderId() paymentpb.PaymentProviderId {
 	return paymentpb.PaymentProviderId(paymentpb.PaymentProviderId_Stripe)
 }
 
-func (p *provider) Charge(req *paymentpb.ChargeRequest) (*paymentpb.Charge, error) {
+func (p *provider) Charge(req *paymentpb.ChargeRequest, key string) (*paymentpb.Charge, error) {
 
-	if req.GetEmail() == "error@error.com" {
+	if req.GetEmail() == "error@error.com" || req.GetPaymentMethod() == declinedPaymentMethod {
 		return nil, errors.New("expected charge error")
 	}
 
+	id := uuid.NewV4().String()
+	if key != "" {
+		p.mtx.Lock()
+		p.keys[key] = id
+		p.mtx.Unlock()
+	}
+
 	return &paymentpb.Charge{
 		ProviderId:       p.ProviderId(),
-		ProviderChargeId: uuid.NewV4().String(),
+		ProviderChargeId: id,
 		Paid:             true,
 		Email:            req.GetEmail(),
 		Currency:         req.GetCurrency(),
@@ -65,6 +80,36 @@ func (p *provider) Charge(req *paymentpb.ChargeRequest) (*paymentpb.Charge, erro
 
 }
 
+func (p *provider) Authorize(req *paymentpb.ChargeRequest, key string) (*paymentpb.Charge, error) {
+	return p.Charge(req, key)
+}
+
+func (p *provider) FindCharge(key string, since int64) (string, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.keys[key], nil
+}
+
+func (p *provider) Capture(ch string, amount uint64, currency paymentpb.Currency) error {
+
+	if amount == 990099 {
+		return errors.New("expected capture error")
+	}
+
+	return nil
+}
+
+func (p *provider) Void(ch string) error {
+	return nil
+}
+
+func (p *provider) SavePaymentMethod(card *paymentpb.Card, email string) (string, error) {
+	if email == "error@error.com" {
+		return declinedPaymentMethod, nil
+	}
+	return "pm_" + uuid.NewV4().String(), nil
+}
+
 func (p *provider) Refund(ch string, amount uint64, currency paymentpb.Currency, reason paymentpb.RefundReason) (*paymentpb.Refund, error) {
 
 	if amount == 990099 {
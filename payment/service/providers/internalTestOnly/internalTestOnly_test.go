@@ -63,7 +63,7 @@ func TestProvider_Charge(t *testing.T) {
 		PaymentProviderId: paymentpb.PaymentProviderId(10),
 		Statement:         "statement",
 		Card:              &paymentpb.Card{},
-	}); err != nil {
+	}, ""); err != nil {
 		t.Fatal(err)
 	}
 	if _, err := p.Charge(&paymentpb.ChargeRequest{
@@ -73,7 +73,7 @@ func TestProvider_Charge(t *testing.T) {
 		PaymentProviderId: paymentpb.PaymentProviderId(10),
 		Statement:         "statement",
 		Card:              &paymentpb.Card{},
-	}); err == nil {
+	}, ""); err == nil {
 		t.Fatal(err)
 	}
 }
@@ -87,3 +87,58 @@ func TestProvider_Refund(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestProvider_Authorize(t *testing.T) {
+	p, _ := NewProvider()
+	ch, err := p.Authorize(&paymentpb.ChargeRequest{
+		Currency:          paymentpb.Currency_USD,
+		Total:             120,
+		Email:             "aa@aa.com",
+		PaymentProviderId: paymentpb.PaymentProviderId(10),
+		Statement:         "statement",
+		Card:              &paymentpb.Card{},
+	}, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the charge is found by its key
+	if id, err := p.FindCharge("key", 0); err != nil || id != ch.GetProviderChargeId() {
+		t.Fatal(id, err)
+	}
+	if id, err := p.FindCharge("other", 0); err != nil || id != "" {
+		t.Fatal(id, err)
+	}
+	if err := p.Capture(ch.GetProviderChargeId(), 100, paymentpb.Currency_USD); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Capture(ch.GetProviderChargeId(), 990099, paymentpb.Currency_USD); err == nil {
+		t.Fatal(err)
+	}
+	if err := p.Void(ch.GetProviderChargeId()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProvider_SavePaymentMethod(t *testing.T) {
+	p, _ := NewProvider()
+	ref, err := p.SavePaymentMethod(&paymentpb.Card{}, "aa@aa.com")
+	if err != nil || ref == "" || ref == declinedPaymentMethod {
+		t.Fatal(ref, err)
+	}
+	if _, err := p.Charge(&paymentpb.ChargeRequest{
+		Currency:      paymentpb.Currency_USD,
+		Total:         120,
+		PaymentMethod: ref,
+	}, ""); err != nil {
+		t.Fatal(err)
+	}
+	// cards of error@error.com are declined when charged
+	ref, _ = p.SavePaymentMethod(&paymentpb.Card{}, "error@error.com")
+	if _, err := p.Charge(&paymentpb.ChargeRequest{
+		Currency:      paymentpb.Currency_USD,
+		Total:         120,
+		PaymentMethod: ref,
+	}, ""); err == nil {
+		t.Fatal(err)
+	}
+}
@@ -36,12 +36,25 @@ var mtx = sync.Mutex{}
 var providers = make(map[paymentpb.PaymentProviderId]Interface)
 
 // Interface defines the base functionality which any payment
-// provider should implement to become valid payment provider
+// provider should implement to become valid payment provider.
+// Authorize holds the amount on the card, the authorization is later
+// captured fully or partially by Capture or released by Void.
+// SavePaymentMethod stores the card with the provider and returns its
+// reference, requests with that reference as payment method are charged
+// without the card.
+// Charges and authorizations are sent with a key, FindCharge returns the
+// provider charge id of the charge created since with that key or "" if
+// there is none.
 type Interface interface {
 	ProviderId() paymentpb.PaymentProviderId
-	Charge(req *paymentpb.ChargeRequest) (*paymentpb.Charge, error)
+	Charge(req *paymentpb.ChargeRequest, key string) (*paymentpb.Charge, error)
+	Authorize(req *paymentpb.ChargeRequest, key string) (*paymentpb.Charge, error)
+	FindCharge(key string, since int64) (string, error)
+	Capture(chargeId string, amount uint64, currency paymentpb.Currency) error
+	Void(chargeId string) error
 	Refund(chargeId string, amount uint64, currency paymentpb.Currency, reason paymentpb.RefundReason) (*paymentpb.Refund, error)
 	SupportedCards() []paymentpb.CardType
+	SavePaymentMethod(card *paymentpb.Card, email string) (string, error)
 }
 
 // New creates several payment providers from the provided
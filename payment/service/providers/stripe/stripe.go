@@ -29,11 +29,15 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stripe/stripe-go"
 	"github.com/stripe/stripe-go/charge"
+	"github.com/stripe/stripe-go/form"
 	"github.com/stripe/stripe-go/refund"
 	"strings"
 	"time"
 )
 
+// keyMeta is the metadata of the charges that holds their key
+const keyMeta = "digota_key"
+
 type provider struct {
 }
 
@@ -59,24 +63,91 @@ func (p *provider) ProviderId() paymentpb.PaymentProviderId {
 	return paymentpb.PaymentProviderId(paymentpb.PaymentProviderId_Stripe)
 }
 
-func (p *provider) Charge(req *paymentpb.ChargeRequest) (*paymentpb.Charge, error) {
-	// perform new charge
-	ch, err := charge.New(&stripe.ChargeParams{
-		Amount:   uint64(req.GetTotal()),
-		Currency: stripe.Currency(strings.ToLower(req.GetCurrency().String())),
+func (p *provider) Charge(req *paymentpb.ChargeRequest, key string) (*paymentpb.Charge, error) {
+	return p.charge(req, key, true)
+}
+
+func (p *provider) Authorize(req *paymentpb.ChargeRequest, key string) (*paymentpb.Charge, error) {
+	return p.charge(req, key, false)
+}
+
+// FindCharge looks for the charge created since with key in its metadata
+func (p *provider) FindCharge(key string, since int64) (string, error) {
+	it := charge.List(&stripe.ChargeListParams{
+		CreatedRange: &stripe.RangeQueryParams{GreaterThanOrEqual: since},
+	})
+	for it.Next() {
+		if ch := it.Charge(); ch.Meta[keyMeta] == key {
+			return ch.ID, nil
+		}
+	}
+	return "", it.Err()
+}
+
+func (p *provider) Capture(chargeId string, amount uint64, currency paymentpb.Currency) error {
+	if _, err := charge.Capture(chargeId, &stripe.CaptureParams{Amount: amount}); err != nil {
+		if x, ok := err.(*stripe.Error); ok {
+			if err := convertStripeError(x.Code); err != nil {
+				return err
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// Void releases an uncaptured charge, stripe does it by refunding it
+func (p *provider) Void(chargeId string) error {
+	_, err := refund.New(&stripe.RefundParams{Charge: chargeId})
+	return err
+}
+
+// SavePaymentMethod creates a stripe customer with the card as its
+// default source, the customer id is the payment method reference
+func (p *provider) SavePaymentMethod(card *paymentpb.Card, email string) (string, error) {
+	params := &stripe.CustomerParams{
+		Email:  email,
+		Source: &stripe.SourceParams{Card: cardParams(card)},
+	}
+	body := &form.Values{}
+	form.AppendTo(body, params)
+	cu := &stripe.Customer{}
+	if err := stripe.GetBackend(stripe.APIBackend).Call("POST", "/customers", stripe.Key, body, &params.Params, cu); err != nil {
+		if x, ok := err.(*stripe.Error); ok {
+			if err := convertStripeError(x.Code); err != nil {
+				return "", err
+			}
+		}
+		return "", err
+	}
+	return cu.ID, nil
+}
+
+func (p *provider) charge(req *paymentpb.ChargeRequest, key string, capture bool) (*paymentpb.Charge, error) {
+	params := &stripe.ChargeParams{
+		Params:    stripe.Params{IdempotencyKey: key},
+		NoCapture: !capture,
+		Amount:    uint64(req.GetTotal()),
+		Currency:  stripe.Currency(strings.ToLower(req.GetCurrency().String())),
 		//Desc:      charge.Description,
 		Desc:  req.GetStatement(),
 		Email: req.GetEmail(),
-		Source: &stripe.SourceParams{
-			Card: &stripe.CardParams{
-				Number: req.GetCard().GetNumber(),
-				Month:  req.GetCard().GetExpireMonth(),
-				Year:   req.GetCard().GetExpireYear(),
-				CVC:    req.GetCard().GetCVC(),
-				Name:   req.GetCard().GetLastName() + " " + req.GetCard().GetFirstName(),
-			},
-		},
-	})
+	}
+	for k, v := range req.GetMetadata() {
+		params.AddMeta(k, v)
+	}
+	// the key finds the charge if it is never saved
+	if key != "" {
+		params.AddMeta(keyMeta, key)
+	}
+	// charge the default source of the saved customer or the card
+	if req.GetPaymentMethod() != "" {
+		params.Customer = req.GetPaymentMethod()
+	} else {
+		params.Source = &stripe.SourceParams{Card: cardParams(req.GetCard())}
+	}
+	// perform new charge
+	ch, err := charge.New(params)
 
 	// convert err
 	if err != nil || !ch.Paid {
@@ -130,6 +201,16 @@ func (p *provider) Refund(chargeId string, amount uint64, currency paymentpb.Cur
 	}, nil
 }
 
+func cardParams(card *paymentpb.Card) *stripe.CardParams {
+	return &stripe.CardParams{
+		Number: card.GetNumber(),
+		Month:  card.GetExpireMonth(),
+		Year:   card.GetExpireYear(),
+		CVC:    card.GetCVC(),
+		Name:   card.GetLastName() + " " + card.GetFirstName(),
+	}
+}
+
 func convertStripeError(errorCode stripe.ErrorCode) error {
 	switch errorCode {
 	case stripe.IncorrectNum:
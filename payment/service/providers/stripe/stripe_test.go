@@ -25,9 +25,11 @@ package stripe
 import (
 	"github.com/digota/digota/config"
 	"github.com/digota/digota/payment/paymentpb"
+	"github.com/stripe/stripe-go"
 	"os"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func GetTestKey() string {
@@ -73,7 +75,7 @@ func TestProvider_Charge(t *testing.T) {
 			FirstName:   "Yaron",
 			Number:      "4111111111111111",
 		},
-	}); err != nil {
+	}, ""); err != nil {
 		t.Fatal(err)
 	}
 
@@ -92,7 +94,7 @@ func TestProvider_Charge(t *testing.T) {
 			FirstName:   "Yaron",
 			Number:      "4000111111111111",
 		},
-	}); err == nil {
+	}, ""); err == nil {
 		t.Fatal(err)
 	}
 
@@ -109,7 +111,96 @@ func TestProvider_Charge(t *testing.T) {
 			ExpireYear:  "2010",
 			Number:      "4111111111111111",
 		},
-	}); err == nil {
+	}, ""); err == nil {
+		t.Fatal(err)
+	}
+
+}
+
+func TestProvider_Authorize(t *testing.T) {
+	p, err := NewProvider(&config.PaymentProvider{
+		Secret: GetTestKey(),
+	})
+	if err != nil {
+		t.Fatal()
+	}
+
+	req := &paymentpb.ChargeRequest{
+		Total:             10 * 1000,
+		Currency:          paymentpb.Currency_USD,
+		Email:             "yaron@digota.com",
+		Statement:         "Authorize statement",
+		PaymentProviderId: paymentpb.PaymentProviderId_Stripe,
+		Card: &paymentpb.Card{
+			Type:        paymentpb.CardType_Visa,
+			CVC:         "123",
+			ExpireMonth: "12",
+			ExpireYear:  "2022",
+			LastName:    "Sumel",
+			FirstName:   "Yaron",
+			Number:      "4111111111111111",
+		},
+	}
+
+	// partial capture
+	key := stripe.NewIdempotencyKey()
+	ch, err := p.Authorize(req, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the charge is found by its key
+	if id, err := p.FindCharge(key, time.Now().Add(-time.Hour).Unix()); err != nil || id != ch.GetProviderChargeId() {
+		t.Fatal(id, err)
+	}
+	if err := p.Capture(ch.GetProviderChargeId(), 5*1000, paymentpb.Currency_USD); err != nil {
+		t.Fatal(err)
+	}
+	// already captured
+	if err := p.Capture(ch.GetProviderChargeId(), 5*1000, paymentpb.Currency_USD); err == nil {
+		t.Fatal(err)
+	}
+
+	// void
+	ch, err = p.Authorize(req, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Void(ch.GetProviderChargeId()); err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+func TestProvider_SavePaymentMethod(t *testing.T) {
+	p, err := NewProvider(&config.PaymentProvider{
+		Secret: GetTestKey(),
+	})
+	if err != nil {
+		t.Fatal()
+	}
+
+	ref, err := p.SavePaymentMethod(&paymentpb.Card{
+		Type:        paymentpb.CardType_Visa,
+		CVC:         "123",
+		ExpireMonth: "12",
+		ExpireYear:  "2022",
+		LastName:    "Sumel",
+		FirstName:   "Yaron",
+		Number:      "4111111111111111",
+	}, "yaron@digota.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// charged without the card
+	if _, err := p.Charge(&paymentpb.ChargeRequest{
+		Total:             10 * 1000,
+		Currency:          paymentpb.Currency_USD,
+		Email:             "yaron@digota.com",
+		Statement:         "Saved card statement",
+		PaymentProviderId: paymentpb.PaymentProviderId_Stripe,
+		PaymentMethod:     ref,
+	}, ""); err != nil {
 		t.Fatal(err)
 	}
 
@@ -145,7 +236,7 @@ func TestProvider_Refund(t *testing.T) {
 			FirstName:   "Yaron",
 			Number:      "4111111111111111",
 		},
-	})
+	}, "")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -169,7 +260,7 @@ func TestProvider_Refund(t *testing.T) {
 			FirstName:   "Yaron",
 			Number:      "4111111111111111",
 		},
-	}); err != nil {
+	}, ""); err != nil {
 		t.Fatal(err)
 	} else {
 		if _, err := p.Refund(ch.ProviderChargeId, 10*1000, paymentpb.Currency_USD, paymentpb.RefundReason_Duplicate); err != nil {
@@ -192,7 +283,7 @@ func TestProvider_Refund(t *testing.T) {
 			FirstName:   "Yaron",
 			Number:      "4111111111111111",
 		},
-	}); err != nil {
+	}, ""); err != nil {
 		t.Fatal(err)
 	} else {
 		if _, err := p.Refund(ch.ProviderChargeId, 10*1000, paymentpb.Currency_USD, paymentpb.RefundReason_RequestedByCustomer); err != nil {
@@ -215,7 +306,7 @@ func TestProvider_Refund(t *testing.T) {
 			FirstName:   "Yaron",
 			Number:      "4111111111111111",
 		},
-	}); err != nil {
+	}, ""); err != nil {
 		t.Fatal(err)
 	} else {
 		if _, err := p.Refund(ch.ProviderChargeId, 10*1000, paymentpb.Currency_USD, paymentpb.RefundReason_Fraud); err != nil {
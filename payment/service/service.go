@@ -23,7 +23,10 @@
 package service
 
 import (
+	"fmt"
+	"github.com/digota/digota/idempotency"
 	"github.com/digota/digota/locker"
+	"github.com/digota/digota/outbox"
 	paymentInterface "github.com/digota/digota/payment"
 	"github.com/digota/digota/payment/paymentpb"
 	"github.com/digota/digota/payment/service/providers"
@@ -31,16 +34,22 @@ import (
 	"github.com/digota/digota/storage/object"
 	"github.com/digota/digota/util"
 	"github.com/digota/digota/validation"
+	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"time"
 )
 
-const ns = "charge"
+const (
+	ns = "charge"
+	// outbox entry kind of provider charges that are not saved yet
+	chargeEntry = "payment.charge"
+)
 
 func init() {
 	paymentInterface.RegisterService(&paymentService{})
+	outbox.Handle(chargeEntry, reconcileCharge)
 }
 
 type charges []*paymentpb.Charge
@@ -49,6 +58,7 @@ func (c *charges) GetNamespace() string { return ns }
 
 type charge struct {
 	paymentpb.Charge `bson:",inline"`
+	fence            int64
 }
 
 func (c *charge) GetNamespace() string { return ns }
@@ -59,6 +69,10 @@ func (c *charge) SetUpdated(t int64) { c.Updated = t }
 
 func (c *charge) SetCreated(t int64) { c.Created = t }
 
+func (c *charge) SetFence(t int64) { c.fence = t }
+
+func (c *charge) GetFence() int64 { return c.fence }
+
 type paymentService struct{}
 
 // Get implements the payment.pb/Get method.
@@ -75,7 +89,7 @@ func (p *paymentService) Get(ctx context.Context, req *paymentpb.GetRequest) (*p
 		},
 	}
 
-	unlock, err := locker.Handler().TryLock(c, locker.DefaultTimeout)
+	unlock, err := locker.Handler().TryLockContext(ctx, c, locker.DefaultTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -93,12 +107,18 @@ func (p *paymentService) List(ctx context.Context, req *paymentpb.ListRequest) (
 		return nil, err
 	}
 
+	var filters []object.Filter
+	if x := req.GetCustomerId(); x != "" {
+		filters = append(filters, object.Filter{Field: "customerid", Op: object.OpEq, Value: x})
+	}
+
 	slice := &charges{}
 
 	n, err := storage.Handler().List(slice, object.ListOpt{
-		Limit: req.GetLimit(),
-		Page:  req.GetPage(),
-		Sort:  object.SortNatural,
+		Limit:   req.GetLimit(),
+		Page:    req.GetPage(),
+		Sort:    object.SortNatural,
+		Filters: filters,
 	})
 
 	if err != nil {
@@ -110,28 +130,93 @@ func (p *paymentService) List(ctx context.Context, req *paymentpb.ListRequest) (
 }
 
 // Charge
+// Retries with the same idempotency key get the first charge instead of
+// charging the card again.
 func (p *paymentService) NewCharge(ctx context.Context, req *paymentpb.ChargeRequest) (*paymentpb.Charge, error) {
+	res, err := idempotency.Do(ctx, "payment.NewCharge", req.GetIdempotencyKey(), req, &paymentpb.Charge{}, func(ctx context.Context) (proto.Message, error) {
+		return p.newCharge(ctx, req, true)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*paymentpb.Charge), nil
+}
+
+// AuthorizeCharge holds the amount on the card without charging it, the
+// charge is later captured by CaptureCharge or released by VoidCharge.
+// Retries with the same idempotency key get the first authorization.
+func (p *paymentService) AuthorizeCharge(ctx context.Context, req *paymentpb.ChargeRequest) (*paymentpb.Charge, error) {
+	res, err := idempotency.Do(ctx, "payment.AuthorizeCharge", req.GetIdempotencyKey(), req, &paymentpb.Charge{}, func(ctx context.Context) (proto.Message, error) {
+		return p.newCharge(ctx, req, false)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*paymentpb.Charge), nil
+}
+
+// newCharge charges the card, or only authorizes the amount if !capture
+func (p *paymentService) newCharge(ctx context.Context, req *paymentpb.ChargeRequest, capture bool) (*paymentpb.Charge, error) {
 
 	if err := validation.Validate(req); err != nil {
 		return nil, err
 	}
 
+	if err := validation.Metadata(req.GetMetadata()); err != nil {
+		return nil, err
+	}
+
+	// charge either the card or the stored payment method
+	if (req.GetCard() == nil) == (req.GetPaymentMethod() == "") {
+		return nil, status.Error(codes.InvalidArgument, "Either card or payment method is required.")
+	}
+
 	provider := providers.Provider(req.GetPaymentProviderId())
 
-	// check if card type is supported with payment provider
-	if err := func() error {
-		for _, v := range provider.SupportedCards() {
-			if req.GetCard().GetType() == v {
-				return nil
-			}
+	// check if card type is supported with payment provider, stored
+	// payment methods were checked when they were saved
+	if req.GetCard() != nil {
+		if err := supportsCard(provider, req.GetCard().GetType()); err != nil {
+			return nil, err
 		}
-		return status.Error(codes.Internal, "Card type is not supported with payment provider.")
-	}(); err != nil {
+	}
+
+	// remember the provider charge till it is saved, the reconciler
+	// refunds it if it is not. the entry id is sent as the charge key so
+	// the reconciler finds the charge even if the provider answer is lost
+	e := &outbox.Entry{
+		Kind:       chargeEntry,
+		ProviderId: req.GetPaymentProviderId(),
+		Amount:     req.GetTotal(),
+		Currency:   req.GetCurrency(),
+		Authorized: !capture,
+	}
+	if err := outbox.Add(e); err != nil {
 		return nil, err
 	}
 
-	ch, err := provider.Charge(req)
+	var (
+		ch  *paymentpb.Charge
+		err error
+	)
+	if capture {
+		ch, err = provider.Charge(req, e.Id)
+	} else {
+		ch, err = provider.Authorize(req, e.Id)
+	}
 	if err != nil {
+		outbox.Done(e)
+		return nil, err
+	}
+
+	e.ProviderChargeId = ch.GetProviderChargeId()
+	// storage is failing, compensate the provider charge now rather than
+	// leave it to the reconciler
+	if err := outbox.Save(e); err != nil {
+		if err := compensate(e); err != nil {
+			return nil, status.Error(codes.DataLoss, fmt.Sprintf("could not save the provider charge {%s} and could not compensate it!", e.ProviderChargeId))
+		}
+		outbox.Done(e)
 		return nil, err
 	}
 
@@ -142,20 +227,84 @@ func (p *paymentService) NewCharge(ctx context.Context, req *paymentpb.ChargeReq
 	charge := &charge{
 		Charge: *ch,
 	}
+	charge.Metadata = req.GetMetadata()
+	charge.CustomerId = req.GetCustomerId()
+	if !capture {
+		charge.Status = paymentpb.Charge_Authorized
+		charge.AuthorizedAmount = req.GetTotal()
+		charge.ChargeAmount = 0
+		// paid once captured
+		charge.Paid = false
+	}
 
 	// critical operations wrapped util.Retry to keep trying when failing
 	if err := util.Retry(func() (err error) { return storage.Handler().Insert(charge) }); err != nil {
-		// if Insert failed => refund that amount instantly with the provider
-		if _, err := provider.Refund(ch.ProviderChargeId, uint64(req.GetTotal()), req.GetCurrency(), paymentpb.RefundReason_GeneralError); err != nil {
+		// if Insert failed => refund that amount or release the
+		// authorization instantly with the provider, the outbox
+		// entry is kept for the reconciler if that fails too
+		if err := compensate(e); err != nil {
 			return nil, err
 		}
+		outbox.Done(e)
 		return nil, status.Error(codes.Internal, "Something went wrong with the charge.")
 	}
 
+	outbox.Done(e)
+
 	return &charge.Charge, nil
 
 }
 
+// supportsCard returns an error if card type t is not supported with provider
+func supportsCard(provider providers.Interface, t paymentpb.CardType) error {
+	for _, v := range provider.SupportedCards() {
+		if t == v {
+			return nil
+		}
+	}
+	return status.Error(codes.Internal, "Card type is not supported with payment provider.")
+}
+
+// compensate refunds the provider charge of e or releases its authorization
+func compensate(e *outbox.Entry) error {
+	provider := providers.Provider(e.ProviderId)
+	if e.Authorized {
+		return provider.Void(e.ProviderChargeId)
+	}
+	_, err := provider.Refund(e.ProviderChargeId, e.Amount, e.Currency, paymentpb.RefundReason_GeneralError)
+	return err
+}
+
+// reconcileCharge compensates provider charges that were not saved, charges
+// that were saved after all are left as is
+func reconcileCharge(ctx context.Context, e *outbox.Entry) error {
+	// the provider answer was not saved, look the charge up by its key
+	if e.ProviderChargeId == "" {
+		id, err := providers.Provider(e.ProviderId).FindCharge(e.Id, e.Created)
+		if err != nil {
+			return err
+		}
+		// the provider never charged, there is nothing to compensate
+		if id == "" {
+			return nil
+		}
+		e.ProviderChargeId = id
+	}
+	slice := charges{}
+	if _, err := storage.Handler().List(&slice, object.ListOpt{
+		Limit: 1,
+		Filters: []object.Filter{
+			{Field: "providerchargeid", Op: object.OpEq, Value: e.ProviderChargeId},
+		},
+	}); err != nil {
+		return err
+	}
+	if len(slice) > 0 {
+		return nil
+	}
+	return compensate(e)
+}
+
 // Refund
 //
 //
@@ -173,7 +322,7 @@ func (p *paymentService) RefundCharge(ctx context.Context, req *paymentpb.Refund
 		},
 	}
 
-	unlock, err := locker.Handler().TryLock(c, time.Second)
+	unlock, err := locker.Handler().TryLockContext(ctx, c, time.Second)
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +332,7 @@ func (p *paymentService) RefundCharge(ctx context.Context, req *paymentpb.Refund
 		return nil, err
 	}
 
-	if !c.Paid || c.GetChargeAmount() <= 0 || req.GetAmount() > c.GetChargeAmount() || c.GetRefundAmount()+req.GetAmount() > c.GetChargeAmount() {
+	if !c.Paid || c.GetStatus() != paymentpb.Charge_Captured || c.GetChargeAmount() <= 0 || req.GetAmount() > c.GetChargeAmount() || c.GetRefundAmount()+req.GetAmount() > c.GetChargeAmount() {
 		return nil, status.Error(codes.Canceled, "Refund is unavailable for this charge.")
 	}
 
@@ -207,3 +356,128 @@ func (p *paymentService) RefundCharge(ctx context.Context, req *paymentpb.Refund
 	return &c.Charge, nil
 
 }
+
+// CaptureCharge charges an authorized charge, amount can be less than the
+// authorized amount and the rest of the authorization is released.
+func (p *paymentService) CaptureCharge(ctx context.Context, req *paymentpb.CaptureRequest) (*paymentpb.Charge, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	c := &charge{
+		Charge: paymentpb.Charge{
+			Id: req.GetId(),
+		},
+	}
+
+	unlock, err := locker.Handler().TryLockContext(ctx, c, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := storage.Handler().One(c); err != nil {
+		return nil, err
+	}
+
+	if c.GetStatus() != paymentpb.Charge_Authorized {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Charge %s is not authorized.", c.GetId()))
+	}
+
+	amount := req.GetAmount()
+	if amount == 0 {
+		amount = c.GetAuthorizedAmount()
+	}
+	if amount > c.GetAuthorizedAmount() {
+		return nil, status.Error(codes.InvalidArgument, "Capture amount is more than the authorized amount.")
+	}
+
+	if err := providers.Provider(c.ProviderId).Capture(c.ProviderChargeId, amount, c.GetCurrency()); err != nil {
+		return nil, err
+	}
+
+	c.Status = paymentpb.Charge_Captured
+	c.ChargeAmount = amount
+	c.Paid = true
+	// update charge
+	if err := util.Retry(func() (err error) { return storage.Handler().Update(c) }); err != nil {
+		return nil, status.Error(codes.DataLoss, "Storage could not update object.")
+	}
+
+	return &c.Charge, nil
+
+}
+
+// VoidCharge releases an authorized charge without charging it
+func (p *paymentService) VoidCharge(ctx context.Context, req *paymentpb.VoidRequest) (*paymentpb.Charge, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	c := &charge{
+		Charge: paymentpb.Charge{
+			Id: req.GetId(),
+		},
+	}
+
+	unlock, err := locker.Handler().TryLockContext(ctx, c, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := storage.Handler().One(c); err != nil {
+		return nil, err
+	}
+
+	if c.GetStatus() != paymentpb.Charge_Authorized {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("Charge %s is not authorized.", c.GetId()))
+	}
+
+	if err := providers.Provider(c.ProviderId).Void(c.ProviderChargeId); err != nil {
+		return nil, err
+	}
+
+	c.Status = paymentpb.Charge_Voided
+	// update charge
+	if err := util.Retry(func() (err error) { return storage.Handler().Update(c) }); err != nil {
+		return nil, status.Error(codes.DataLoss, "Storage could not update object.")
+	}
+
+	return &c.Charge, nil
+
+}
+
+// SavePaymentMethod stores the card with the payment provider, the returned
+// reference is charged later instead of the card
+func (p *paymentService) SavePaymentMethod(ctx context.Context, req *paymentpb.PaymentMethodRequest) (*paymentpb.PaymentMethod, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	provider := providers.Provider(req.GetPaymentProviderId())
+
+	if err := supportsCard(provider, req.GetCard().GetType()); err != nil {
+		return nil, err
+	}
+
+	ref, err := provider.SavePaymentMethod(req.GetCard(), req.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+
+	number := req.GetCard().GetNumber()
+
+	return &paymentpb.PaymentMethod{
+		ProviderId:  req.GetPaymentProviderId(),
+		Reference:   ref,
+		Type:        req.GetCard().GetType(),
+		Last4:       number[len(number)-4:],
+		ExpireMonth: req.GetCard().GetExpireMonth(),
+		ExpireYear:  req.GetCard().GetExpireYear(),
+	}, nil
+
+}
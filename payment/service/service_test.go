@@ -25,11 +25,14 @@ package service
 import (
 	"github.com/digota/digota/config"
 	"github.com/digota/digota/locker"
+	"github.com/digota/digota/outbox"
 	"github.com/digota/digota/payment/paymentpb"
 	"github.com/digota/digota/payment/service/providers"
 	"github.com/digota/digota/storage"
 	"github.com/satori/go.uuid"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"os"
 	"testing"
 	"time"
@@ -130,12 +133,16 @@ func TestService_Charge(t *testing.T) {
 		PaymentProviderId: paymentpb.PaymentProviderId_Stripe,
 	}
 
-	_, err := s.NewCharge(context.Background(), chReq)
+	ch, err := s.NewCharge(context.Background(), chReq)
 
 	if err != nil {
 		t.Fatal(err)
 	}
 
+	if ch.GetMetadata()["key"] != "val" {
+		t.Fatal(ch)
+	}
+
 	chReq.Email = "yarondigota.com"
 
 	if _, err := s.NewCharge(context.Background(), chReq); err == nil {
@@ -208,6 +215,204 @@ func TestService_Refund(t *testing.T) {
 
 }
 
+func authorizeRequest() *paymentpb.ChargeRequest {
+	return &paymentpb.ChargeRequest{
+		Total:     1000,
+		Currency:  paymentpb.Currency_USD,
+		Statement: "test statement",
+		Email:     "yaron@digota.com",
+		Card: &paymentpb.Card{
+			Type:        paymentpb.CardType_Visa,
+			CVC:         "123",
+			ExpireMonth: "12",
+			ExpireYear:  "2022",
+			LastName:    "Sumel",
+			FirstName:   "Yaron",
+			Number:      "4111111111111111",
+		},
+		PaymentProviderId: paymentpb.PaymentProviderId_Stripe,
+	}
+}
+
+func TestService_Capture(t *testing.T) {
+
+	s := &paymentService{}
+
+	ch, err := s.AuthorizeCharge(context.Background(), authorizeRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ch.GetStatus() != paymentpb.Charge_Authorized || ch.GetAuthorizedAmount() != 1000 || ch.GetChargeAmount() != 0 || ch.GetPaid() {
+		t.Fatal(ch)
+	}
+
+	// authorized charges can't be refunded
+	if _, err := s.RefundCharge(context.Background(), &paymentpb.RefundRequest{
+		Id:     ch.GetId(),
+		Amount: 100,
+		Reason: paymentpb.RefundReason_GeneralError,
+	}); status.Code(err) != codes.Canceled {
+		t.Fatal(err)
+	}
+
+	// more than authorized
+	if _, err := s.CaptureCharge(context.Background(), &paymentpb.CaptureRequest{Id: ch.GetId(), Amount: 1001}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+
+	captured, err := s.CaptureCharge(context.Background(), &paymentpb.CaptureRequest{Id: ch.GetId(), Amount: 800})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captured.GetStatus() != paymentpb.Charge_Captured || captured.GetChargeAmount() != 800 || !captured.GetPaid() {
+		t.Fatal(captured)
+	}
+
+	// captured twice
+	if _, err := s.CaptureCharge(context.Background(), &paymentpb.CaptureRequest{Id: ch.GetId()}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	// captured charges can't be voided
+	if _, err := s.VoidCharge(context.Background(), &paymentpb.VoidRequest{Id: ch.GetId()}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+}
+
+func TestService_Void(t *testing.T) {
+
+	s := &paymentService{}
+
+	ch, err := s.AuthorizeCharge(context.Background(), authorizeRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	voided, err := s.VoidCharge(context.Background(), &paymentpb.VoidRequest{Id: ch.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if voided.GetStatus() != paymentpb.Charge_Voided {
+		t.Fatal(voided)
+	}
+
+	// voided charges can't be captured
+	if _, err := s.CaptureCharge(context.Background(), &paymentpb.CaptureRequest{Id: ch.GetId()}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+}
+
+func TestService_SavePaymentMethod(t *testing.T) {
+
+	s := &paymentService{}
+
+	req := authorizeRequest()
+
+	pm, err := s.SavePaymentMethod(context.Background(), &paymentpb.PaymentMethodRequest{
+		Card:              req.GetCard(),
+		Email:             req.GetEmail(),
+		PaymentProviderId: req.GetPaymentProviderId(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pm.GetReference() == "" || pm.GetLast4() != "1111" || pm.GetType() != paymentpb.CardType_Visa || pm.GetExpireYear() != "2022" {
+		t.Fatal(pm)
+	}
+
+	// the stored payment method is charged instead of the card
+	req.Card, req.PaymentMethod = nil, pm.GetReference()
+	ch, err := s.NewCharge(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ch.GetPaid() || ch.GetChargeAmount() != req.GetTotal() {
+		t.Fatal(ch)
+	}
+
+	// either the card or the payment method
+	req.PaymentMethod = ""
+	if _, err := s.NewCharge(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+	req.Card, req.PaymentMethod = authorizeRequest().GetCard(), pm.GetReference()
+	if _, err := s.NewCharge(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+
+}
+
+func TestReconcileCharge(t *testing.T) {
+
+	s := &paymentService{}
+
+	ch, err := s.AuthorizeCharge(context.Background(), authorizeRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the provider answer was lost, the charge is found by the entry id
+	// and refunded, the refund fails and is retried
+	key := uuid.NewV4().String()
+	if _, err := providers.Provider(paymentpb.PaymentProviderId_Stripe).Charge(authorizeRequest(), key); err != nil {
+		t.Fatal(err)
+	}
+	if err := reconcileCharge(context.Background(), &outbox.Entry{
+		Id:         key,
+		Kind:       chargeEntry,
+		ProviderId: paymentpb.PaymentProviderId_Stripe,
+		Amount:     990099,
+		Currency:   paymentpb.Currency_USD,
+	}); err == nil {
+		t.FailNow()
+	}
+
+	// the provider never charged with the entry id
+	if err := reconcileCharge(context.Background(), &outbox.Entry{
+		Id:         uuid.NewV4().String(),
+		Kind:       chargeEntry,
+		ProviderId: paymentpb.PaymentProviderId_Stripe,
+		Amount:     990099,
+		Currency:   paymentpb.Currency_USD,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// saved after all
+	if err := reconcileCharge(context.Background(), &outbox.Entry{
+		Kind:             chargeEntry,
+		ProviderId:       paymentpb.PaymentProviderId_Stripe,
+		ProviderChargeId: ch.GetProviderChargeId(),
+		Authorized:       true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// not saved, the authorization is released
+	if err := reconcileCharge(context.Background(), &outbox.Entry{
+		Kind:             chargeEntry,
+		ProviderId:       paymentpb.PaymentProviderId_Stripe,
+		ProviderChargeId: uuid.NewV4().String(),
+		Authorized:       true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the refund fails and is retried
+	if err := reconcileCharge(context.Background(), &outbox.Entry{
+		Kind:             chargeEntry,
+		ProviderId:       paymentpb.PaymentProviderId_Stripe,
+		ProviderChargeId: uuid.NewV4().String(),
+		Amount:           990099,
+		Currency:         paymentpb.Currency_USD,
+	}); err == nil {
+		t.FailNow()
+	}
+
+}
+
 func TestPaymentService_Get(t *testing.T) {
 
 	s := &paymentService{}
@@ -67,5 +67,85 @@ DEL "product\productpb\product.pb.gw.go" || pause
 --gofast_out=plugins=grpc:../../../ ^
 product\productpb\product.proto || pause)
 
+:: admin
+DEL "admin\adminpb\admin.pb.go" || pause
+
+(protoc ^
+-I=. ^
+-I=../../../ ^
+-I=../../gogo/protobuf/protobuf ^
+--gofast_out=plugins=grpc:../../../ ^
+admin/adminpb/admin.proto || pause)
+
+:: promotion
+DEL "promotion\promotionpb\promotion.pb.go" || pause
+
+(protoc ^
+-I=. ^
+-I=../../../ ^
+-I=../../gogo/protobuf/protobuf ^
+--gofast_out=plugins=grpc:../../../ ^
+promotion/promotionpb/promotion.proto || pause)
+
+:: tax
+DEL "tax\taxpb\tax.pb.go" || pause
+
+(protoc ^
+-I=. ^
+-I=../../../ ^
+-I=../../gogo/protobuf/protobuf ^
+--gofast_out=plugins=grpc:../../../ ^
+tax/taxpb/tax.proto || pause)
+
+:: shipping
+DEL "shipping\shippingpb\shipping.pb.go" || pause
+
+(protoc ^
+-I=. ^
+-I=../../../ ^
+-I=../../gogo/protobuf/protobuf ^
+--gofast_out=plugins=grpc:../../../ ^
+shipping/shippingpb/shipping.proto || pause)
+
+:: invoice
+DEL "invoice\invoicepb\invoice.pb.go" || pause
+
+(protoc ^
+-I=. ^
+-I=../../../ ^
+-I=../../gogo/protobuf/protobuf ^
+--gofast_out=plugins=grpc:../../../ ^
+invoice/invoicepb/invoice.proto || pause)
+
+:: subscription
+DEL "subscription\subscriptionpb\subscription.pb.go" || pause
+
+(protoc ^
+-I=. ^
+-I=../../../ ^
+-I=../../gogo/protobuf/protobuf ^
+--gofast_out=plugins=grpc:../../../ ^
+subscription/subscriptionpb/subscription.proto || pause)
+
+:: cart
+DEL "cart\cartpb\cart.pb.go" || pause
+
+(protoc ^
+-I=. ^
+-I=../../../ ^
+-I=../../gogo/protobuf/protobuf ^
+--gofast_out=plugins=grpc:../../../ ^
+cart/cartpb/cart.proto || pause)
+
+:: customer
+DEL "customer\customerpb\customer.pb.go" || pause
+
+(protoc ^
+-I=. ^
+-I=../../../ ^
+-I=../../gogo/protobuf/protobuf ^
+--gofast_out=plugins=grpc:../../../ ^
+customer/customerpb/customer.proto || pause)
+
 :: pause
 exit
\ No newline at end of file
@@ -62,8 +62,10 @@ type Product struct {
 	Shippable   bool              `protobuf:"varint,8,opt,name=shippable,proto3" json:"shippable,omitempty"`
 	Url         string            `protobuf:"bytes,9,opt,name=url,proto3" json:"url,omitempty"`
 	Skus        []*skupb.Sku      `protobuf:"bytes,10,rep,name=skus" json:"skus,omitempty"`
-	Created     int64             `protobuf:"varint,998,opt,name=created,proto3" json:"created,omitempty"`
-	Updated     int64             `protobuf:"varint,999,opt,name=updated,proto3" json:"updated,omitempty"`
+	// tax category the product tax rates are matched by
+	TaxCategory string `protobuf:"bytes,11,opt,name=taxCategory,proto3" json:"taxCategory,omitempty"`
+	Created     int64  `protobuf:"varint,998,opt,name=created,proto3" json:"created,omitempty"`
+	Updated     int64  `protobuf:"varint,999,opt,name=updated,proto3" json:"updated,omitempty"`
 }
 
 func (m *Product) Reset()                    { *m = Product{} }
@@ -141,6 +143,13 @@ func (m *Product) GetSkus() []*skupb.Sku {
 	return nil
 }
 
+func (m *Product) GetTaxCategory() string {
+	if m != nil {
+		return m.TaxCategory
+	}
+	return ""
+}
+
 func (m *Product) GetCreated() int64 {
 	if m != nil {
 		return m.Created
@@ -188,6 +197,7 @@ type NewRequest struct {
 	Metadata    map[string]string `protobuf:"bytes,6,rep,name=metadata" json:"metadata,omitempty" validate:"" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Shippable   bool              `protobuf:"varint,7,opt,name=shippable,proto3" json:"shippable,omitempty" validate:""`
 	Url         string            `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty" validate:"omitempty,url"`
+	TaxCategory string            `protobuf:"bytes,9,opt,name=taxCategory,proto3" json:"taxCategory,omitempty"`
 }
 
 func (m *NewRequest) Reset()                    { *m = NewRequest{} }
@@ -251,6 +261,13 @@ func (m *NewRequest) GetUrl() string {
 	return ""
 }
 
+func (m *NewRequest) GetTaxCategory() string {
+	if m != nil {
+		return m.TaxCategory
+	}
+	return ""
+}
+
 type GetRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,uuid4"`
 }
@@ -293,6 +310,7 @@ type UpdateRequest struct {
 	Metadata    map[string]string `protobuf:"bytes,7,rep,name=metadata" json:"metadata,omitempty" validate:"" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Shippable   bool              `protobuf:"varint,8,opt,name=shippable,proto3" json:"shippable,omitempty" validate:""`
 	Url         string            `protobuf:"bytes,9,opt,name=url,proto3" json:"url,omitempty" validate:"omitempty,url"`
+	TaxCategory string            `protobuf:"bytes,10,opt,name=taxCategory,proto3" json:"taxCategory,omitempty"`
 }
 
 func (m *UpdateRequest) Reset()                    { *m = UpdateRequest{} }
@@ -363,6 +381,13 @@ func (m *UpdateRequest) GetUrl() string {
 	return ""
 }
 
+func (m *UpdateRequest) GetTaxCategory() string {
+	if m != nil {
+		return m.TaxCategory
+	}
+	return ""
+}
+
 type ListRequest struct {
 	Page  int64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -738,6 +763,12 @@ func (m *Product) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.TaxCategory) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.TaxCategory)))
+		i += copy(dAtA[i:], m.TaxCategory)
+	}
 	if m.Created != 0 {
 		dAtA[i] = 0xb0
 		i++
@@ -890,6 +921,12 @@ func (m *NewRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintProduct(dAtA, i, uint64(len(m.Url)))
 		i += copy(dAtA[i:], m.Url)
 	}
+	if len(m.TaxCategory) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.TaxCategory)))
+		i += copy(dAtA[i:], m.TaxCategory)
+	}
 	return i, nil
 }
 
@@ -1047,6 +1084,12 @@ func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintProduct(dAtA, i, uint64(len(m.Url)))
 		i += copy(dAtA[i:], m.Url)
 	}
+	if len(m.TaxCategory) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintProduct(dAtA, i, uint64(len(m.TaxCategory)))
+		i += copy(dAtA[i:], m.TaxCategory)
+	}
 	return i, nil
 }
 
@@ -1162,6 +1205,10 @@ func (m *Product) Size() (n int) {
 			n += 1 + l + sovProduct(uint64(l))
 		}
 	}
+	l = len(m.TaxCategory)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
 	if m.Created != 0 {
 		n += 2 + sovProduct(uint64(m.Created))
 	}
@@ -1227,6 +1274,10 @@ func (m *NewRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProduct(uint64(l))
 	}
+	l = len(m.TaxCategory)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
 	return n
 }
 
@@ -1295,6 +1346,10 @@ func (m *UpdateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProduct(uint64(l))
 	}
+	l = len(m.TaxCategory)
+	if l > 0 {
+		n += 1 + l + sovProduct(uint64(l))
+	}
 	return n
 }
 
@@ -1763,6 +1818,35 @@ func (m *Product) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCategory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxCategory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 998:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
@@ -2252,6 +2336,35 @@ func (m *NewRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCategory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxCategory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProduct(dAtA[iNdEx:])
@@ -2790,6 +2903,35 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxCategory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProduct
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProduct
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxCategory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProduct(dAtA[iNdEx:])
@@ -3007,59 +3149,60 @@ var (
 func init() { proto.RegisterFile("product/productpb/product.proto", fileDescriptorProduct) }
 
 var fileDescriptorProduct = []byte{
-	// 851 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0x5d, 0x4e, 0xeb, 0x46,
-	0x14, 0xc7, 0xe3, 0xd8, 0xf9, 0x3a, 0x11, 0x14, 0x4d, 0x5b, 0x30, 0x29, 0xc4, 0xa9, 0x2b, 0x55,
-	0x51, 0x15, 0x1c, 0x9a, 0x52, 0x4a, 0x81, 0xf4, 0x21, 0x2a, 0xe2, 0xa5, 0x45, 0xc8, 0x51, 0x5f,
-	0xfa, 0x52, 0xd9, 0xf1, 0xd4, 0x8c, 0xe2, 0xc4, 0xc6, 0x1e, 0x07, 0xb1, 0x87, 0x2e, 0xa0, 0x0b,
-	0xe8, 0x02, 0xba, 0x8c, 0x4a, 0x7d, 0xe9, 0x0a, 0xa2, 0x8a, 0x2b, 0xdd, 0xfb, 0x9e, 0x15, 0x5c,
-	0xcd, 0xd8, 0x71, 0x6c, 0x42, 0x04, 0x0a, 0xd2, 0x7d, 0x00, 0xcf, 0x99, 0x39, 0xff, 0x99, 0x33,
-	0x67, 0x7e, 0x73, 0x32, 0xa0, 0x78, 0xbe, 0x6b, 0x85, 0x03, 0xda, 0x8e, 0xbf, 0x9e, 0x39, 0x6f,
-	0x69, 0x9e, 0xef, 0x52, 0x17, 0x55, 0x92, 0x81, 0xda, 0x81, 0x4d, 0xe8, 0x4d, 0x68, 0x6a, 0x03,
-	0x77, 0xd4, 0xb6, 0x5d, 0xdb, 0x6d, 0x73, 0x0f, 0x33, 0xfc, 0x9d, 0x5b, 0xdc, 0xe0, 0xad, 0x48,
-	0x59, 0x6b, 0xa5, 0xdc, 0x2d, 0x62, 0xbb, 0xd4, 0x98, 0x7f, 0x82, 0x61, 0xc8, 0xfe, 0x3c, 0x93,
-	0xfd, 0x8f, 0xbc, 0xd5, 0x12, 0x14, 0x2e, 0x46, 0x1e, 0xbd, 0x57, 0xff, 0x16, 0xa1, 0x74, 0x1d,
-	0xad, 0x89, 0xea, 0x90, 0x27, 0x96, 0x2c, 0x34, 0x84, 0x66, 0xa5, 0xb7, 0x39, 0x9b, 0x2a, 0x60,
-	0x06, 0xee, 0xf8, 0x54, 0xfd, 0x8d, 0x58, 0xaa, 0x9e, 0x27, 0x16, 0x42, 0x20, 0x8d, 0x8d, 0x11,
-	0x96, 0xf3, 0xcc, 0x43, 0xe7, 0x6d, 0xb4, 0x0d, 0x45, 0x63, 0x40, 0xc9, 0x04, 0xcb, 0x62, 0x43,
-	0x68, 0x96, 0xf5, 0xd8, 0x42, 0x75, 0x00, 0x83, 0x52, 0x9f, 0x98, 0x21, 0xc5, 0x81, 0x2c, 0x35,
-	0xc4, 0x66, 0x45, 0x4f, 0xf5, 0xa0, 0x06, 0x54, 0x2d, 0x1c, 0x0c, 0x7c, 0xe2, 0x51, 0xe2, 0x8e,
-	0xe5, 0x02, 0x9f, 0x32, 0xdd, 0xc5, 0x66, 0x26, 0x23, 0xc3, 0xc6, 0x81, 0x5c, 0xe4, 0xea, 0xd8,
-	0x42, 0xe7, 0x50, 0x1e, 0x61, 0x6a, 0x58, 0x06, 0x35, 0xe4, 0x52, 0x43, 0x6c, 0x56, 0x3b, 0x0d,
-	0x2d, 0xc9, 0x9a, 0x16, 0xef, 0x45, 0xfb, 0x39, 0x76, 0xb9, 0x18, 0x53, 0xff, 0x5e, 0x4f, 0x14,
-	0x68, 0x0f, 0x2a, 0xc1, 0x0d, 0xf1, 0x3c, 0xc3, 0x74, 0xb0, 0x5c, 0xe6, 0x21, 0x2f, 0x3a, 0xd0,
-	0x16, 0x88, 0xa1, 0xef, 0xc8, 0x15, 0x1e, 0x0d, 0x6b, 0xa2, 0x3a, 0x48, 0xc1, 0x30, 0x0c, 0x64,
-	0xe0, 0x2b, 0x81, 0xc6, 0x13, 0xa9, 0xf5, 0x87, 0xa1, 0xce, 0xfb, 0xd1, 0x2e, 0x94, 0x06, 0x3e,
-	0x36, 0x28, 0xb6, 0xe4, 0xb7, 0xa5, 0x86, 0xd0, 0x14, 0xf5, 0xb9, 0xcd, 0x86, 0x42, 0xcf, 0xe2,
-	0x43, 0xef, 0xe2, 0xa1, 0xd8, 0xae, 0x9d, 0xc1, 0x46, 0x26, 0x40, 0xb6, 0xf0, 0x10, 0xdf, 0x47,
-	0xb9, 0xd7, 0x59, 0x13, 0x7d, 0x02, 0x85, 0x89, 0xe1, 0x84, 0xf3, 0x6c, 0x47, 0xc6, 0x69, 0xfe,
-	0x44, 0x50, 0xfb, 0x50, 0x8d, 0x77, 0xf9, 0x13, 0x09, 0x28, 0xd2, 0xa0, 0x1c, 0x6f, 0x3f, 0x90,
-	0x05, 0x1e, 0x25, 0x5a, 0xce, 0x87, 0x9e, 0xf8, 0xb0, 0x89, 0xa9, 0x4b, 0x0d, 0x87, 0x4f, 0x5c,
-	0xd0, 0x23, 0x43, 0xfd, 0x43, 0x02, 0xb8, 0xc2, 0x77, 0x3a, 0xbe, 0x0d, 0x71, 0x40, 0xd1, 0xd7,
-	0xf1, 0x51, 0x47, 0x30, 0xec, 0xcf, 0xa6, 0xca, 0xee, 0xc4, 0x70, 0x08, 0x8b, 0xfe, 0x54, 0xf5,
-	0xf1, 0x6d, 0x48, 0x7c, 0x6c, 0xb5, 0x6c, 0x8a, 0xbb, 0x87, 0x6a, 0x4c, 0x42, 0x3b, 0x21, 0x81,
-	0x4d, 0x5c, 0xee, 0xed, 0xcc, 0xa6, 0xca, 0xc7, 0xcb, 0x22, 0x35, 0x41, 0xe4, 0x3c, 0x83, 0x88,
-	0xc8, 0x0e, 0xb9, 0xb7, 0x37, 0x9b, 0x2a, 0xf2, 0x42, 0x64, 0x91, 0x09, 0x6e, 0x2d, 0x94, 0x69,
-	0x80, 0xba, 0x59, 0x80, 0x24, 0x1e, 0xe8, 0x67, 0xb3, 0xa9, 0xb2, 0xb3, 0x90, 0xdb, 0xb4, 0x7b,
-	0xd8, 0x72, 0x68, 0xb7, 0x73, 0xf8, 0xed, 0xb1, 0x9a, 0xa5, 0xab, 0x9d, 0xd0, 0x55, 0xe0, 0x0b,
-	0x3f, 0x8a, 0x96, 0x2f, 0x1c, 0xfa, 0x8e, 0x9a, 0x60, 0x77, 0x9d, 0xc2, 0xae, 0xc8, 0xd3, 0xfc,
-	0x45, 0x2a, 0xcd, 0x8b, 0xd4, 0x65, 0xc9, 0xeb, 0x7d, 0x34, 0x9b, 0x2a, 0xd5, 0xc5, 0xbc, 0x6a,
-	0x0a, 0xc5, 0x83, 0x34, 0x8a, 0x25, 0x9e, 0xb3, 0x25, 0xef, 0x14, 0x9b, 0x5a, 0xc4, 0x66, 0x99,
-	0x6f, 0xf4, 0x51, 0x9e, 0xdc, 0x11, 0xa1, 0x98, 0xdd, 0xe7, 0x28, 0x66, 0xe6, 0xf8, 0x3a, 0xc6,
-	0xce, 0x00, 0x2e, 0x31, 0x9d, 0xd3, 0x70, 0x90, 0x2a, 0x0c, 0xab, 0x58, 0x08, 0x43, 0x62, 0x1d,
-	0xf1, 0x3a, 0xa1, 0xfe, 0x00, 0x1b, 0x3f, 0x62, 0x07, 0x53, 0xbc, 0xa6, 0xfe, 0x5f, 0x09, 0x36,
-	0x7e, 0xe1, 0x37, 0x65, 0xbd, 0x09, 0x12, 0x7a, 0xf3, 0xcf, 0xd2, 0x7b, 0x34, 0xa7, 0xf7, 0xfb,
-	0x6c, 0x1d, 0xeb, 0x7d, 0x3e, 0x9b, 0x2a, 0xfb, 0x4f, 0x25, 0xf8, 0x39, 0x8e, 0xa5, 0xd7, 0x71,
-	0x5c, 0x58, 0x9b, 0xe3, 0xe2, 0xcb, 0x38, 0xee, 0x2f, 0x95, 0xcf, 0x2f, 0x53, 0x1c, 0x67, 0xd2,
-	0xbe, 0x26, 0xca, 0xe5, 0x97, 0xa2, 0x5c, 0xf9, 0x20, 0x28, 0x7f, 0x07, 0x55, 0x56, 0x27, 0xe7,
-	0x28, 0x21, 0x90, 0x3c, 0xc3, 0x8e, 0x2a, 0x9b, 0xa8, 0xf3, 0x36, 0x13, 0x3b, 0x64, 0x44, 0x28,
-	0x17, 0x8b, 0x7a, 0x64, 0x74, 0xfe, 0xca, 0xc3, 0x66, 0x5c, 0x3e, 0xfb, 0xd8, 0x9f, 0x90, 0x01,
-	0x46, 0x1d, 0x10, 0xaf, 0xf0, 0x1d, 0xfa, 0xf4, 0xc9, 0x9b, 0x5f, 0x7b, 0xa2, 0xee, 0xaa, 0x39,
-	0xa6, 0xb9, 0xc4, 0x34, 0xa3, 0x59, 0x5c, 0xad, 0x15, 0x9a, 0x13, 0x28, 0x46, 0x27, 0x81, 0xe4,
-	0x55, 0x87, 0xb3, 0x52, 0x29, 0xf1, 0x5f, 0x85, 0xed, 0xd4, 0x68, 0x6a, 0xfb, 0xb5, 0xed, 0x65,
-	0x15, 0x1b, 0x56, 0x73, 0xe8, 0x18, 0x8a, 0xd1, 0xad, 0xcd, 0xac, 0x99, 0xb9, 0xc8, 0xb5, 0xad,
-	0xd4, 0x48, 0xf4, 0x7e, 0xc8, 0xf5, 0xce, 0xff, 0x79, 0xa8, 0x0b, 0xff, 0x3d, 0xd4, 0x85, 0xff,
-	0x1f, 0xea, 0xc2, 0x9f, 0x6f, 0xea, 0xb9, 0x5f, 0xbf, 0x5a, 0xf9, 0x14, 0x59, 0x7a, 0xfe, 0x98,
-	0x45, 0xfe, 0x1e, 0xf9, 0xe6, 0x7d, 0x00, 0x00, 0x00, 0xff, 0xff, 0x88, 0x88, 0x64, 0xa2, 0x1a,
-	0x09, 0x00, 0x00,
+	// 871 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0xc7, 0xe3, 0xd8, 0xf9, 0x3a, 0xd1, 0x96, 0x6a, 0x80, 0xad, 0x1b, 0xda, 0xd8, 0x18, 0x09,
+	0x45, 0x28, 0xeb, 0x2c, 0xa1, 0x94, 0xb2, 0xdd, 0x70, 0x11, 0xa8, 0x7a, 0x03, 0x55, 0xe5, 0x88,
+	0x1b, 0x6e, 0xd0, 0x38, 0x1e, 0xdc, 0x51, 0x9c, 0xd8, 0xb5, 0xc7, 0x29, 0x79, 0x13, 0x1e, 0x80,
+	0x6b, 0x9e, 0x83, 0x4b, 0xc4, 0x03, 0x44, 0x68, 0x91, 0xe0, 0x8a, 0x9b, 0x3c, 0x01, 0x9a, 0xb1,
+	0xe3, 0x8f, 0x7a, 0xa3, 0x56, 0x69, 0x2f, 0x76, 0x3d, 0x67, 0xe6, 0xfc, 0x67, 0xce, 0x9c, 0xf9,
+	0xcd, 0xc9, 0x80, 0x16, 0x84, 0xbe, 0x13, 0xcf, 0xd9, 0x28, 0xfd, 0x06, 0xf6, 0xbe, 0x65, 0x06,
+	0xa1, 0xcf, 0x7c, 0xd4, 0xc9, 0x06, 0x7a, 0x67, 0x2e, 0x65, 0xcf, 0x62, 0xdb, 0x9c, 0xfb, 0xcb,
+	0x91, 0xeb, 0xbb, 0xfe, 0x48, 0x78, 0xd8, 0xf1, 0x4f, 0xc2, 0x12, 0x86, 0x68, 0x25, 0xca, 0xde,
+	0xb0, 0xe0, 0xee, 0x50, 0xd7, 0x67, 0x78, 0xff, 0x89, 0x16, 0x31, 0xff, 0x0b, 0x6c, 0xfe, 0x3f,
+	0xf1, 0x36, 0x5a, 0xd0, 0x78, 0xb4, 0x0c, 0xd8, 0xc6, 0xf8, 0x53, 0x86, 0xd6, 0xd3, 0x64, 0x4d,
+	0xd4, 0x87, 0x3a, 0x75, 0x54, 0x49, 0x97, 0x06, 0x9d, 0xe9, 0x8d, 0xdd, 0x56, 0x03, 0x3b, 0xf2,
+	0x57, 0x17, 0xc6, 0x8f, 0xd4, 0x31, 0xac, 0x3a, 0x75, 0x10, 0x02, 0x65, 0x85, 0x97, 0x44, 0xad,
+	0x73, 0x0f, 0x4b, 0xb4, 0xd1, 0x29, 0x34, 0xf1, 0x9c, 0xd1, 0x35, 0x51, 0x65, 0x5d, 0x1a, 0xb4,
+	0xad, 0xd4, 0x42, 0x7d, 0x00, 0xcc, 0x58, 0x48, 0xed, 0x98, 0x91, 0x48, 0x55, 0x74, 0x79, 0xd0,
+	0xb1, 0x0a, 0x3d, 0x48, 0x87, 0xae, 0x43, 0xa2, 0x79, 0x48, 0x03, 0x46, 0xfd, 0x95, 0xda, 0x10,
+	0x53, 0x16, 0xbb, 0xf8, 0xcc, 0x74, 0x89, 0x5d, 0x12, 0xa9, 0x4d, 0xa1, 0x4e, 0x2d, 0x74, 0x09,
+	0xed, 0x25, 0x61, 0xd8, 0xc1, 0x0c, 0xab, 0x2d, 0x5d, 0x1e, 0x74, 0xc7, 0xba, 0x99, 0x65, 0xcd,
+	0x4c, 0xf7, 0x62, 0x7e, 0x97, 0xba, 0x3c, 0x5a, 0xb1, 0x70, 0x63, 0x65, 0x0a, 0x74, 0x07, 0x3a,
+	0xd1, 0x33, 0x1a, 0x04, 0xd8, 0xf6, 0x88, 0xda, 0x16, 0x21, 0xe7, 0x1d, 0xe8, 0x26, 0xc8, 0x71,
+	0xe8, 0xa9, 0x1d, 0x11, 0x0d, 0x6f, 0xa2, 0x3e, 0x28, 0xd1, 0x22, 0x8e, 0x54, 0x10, 0x2b, 0x81,
+	0x29, 0x12, 0x69, 0xce, 0x16, 0xb1, 0x25, 0xfa, 0xf9, 0x3e, 0x18, 0xfe, 0xf9, 0x6b, 0xcc, 0x88,
+	0xeb, 0x87, 0x1b, 0xb5, 0x9b, 0xec, 0xa3, 0xd0, 0x85, 0x6e, 0x43, 0x6b, 0x1e, 0x12, 0xcc, 0x88,
+	0xa3, 0xfe, 0xd3, 0xd2, 0xa5, 0x81, 0x6c, 0xed, 0x6d, 0x3e, 0x14, 0x07, 0x8e, 0x18, 0xfa, 0x37,
+	0x1d, 0x4a, 0xed, 0xde, 0x43, 0x38, 0x29, 0x6d, 0x81, 0x87, 0xb6, 0x20, 0x9b, 0xe4, 0x74, 0x2c,
+	0xde, 0x44, 0xef, 0x41, 0x63, 0x8d, 0xbd, 0x78, 0x7f, 0x1e, 0x89, 0x71, 0x51, 0x7f, 0x20, 0x19,
+	0x33, 0xe8, 0xa6, 0x79, 0xf8, 0x96, 0x46, 0x0c, 0x99, 0xd0, 0x4e, 0x13, 0x14, 0xa9, 0x92, 0xd8,
+	0x07, 0xaa, 0x66, 0xcc, 0xca, 0x7c, 0xf8, 0xc4, 0xcc, 0x67, 0xd8, 0x13, 0x13, 0x37, 0xac, 0xc4,
+	0x30, 0x7e, 0x53, 0x00, 0x9e, 0x90, 0x17, 0x16, 0x79, 0x1e, 0x93, 0x88, 0xa1, 0x4f, 0x53, 0x18,
+	0x12, 0x5c, 0xee, 0xee, 0xb6, 0xda, 0xed, 0x35, 0xf6, 0x28, 0x8f, 0xfe, 0xc2, 0x08, 0xc9, 0xf3,
+	0x98, 0x86, 0xc4, 0x19, 0xba, 0x8c, 0x4c, 0xce, 0x8d, 0x94, 0x95, 0x51, 0xc6, 0x0a, 0x9f, 0xb8,
+	0x3d, 0xbd, 0xb5, 0xdb, 0x6a, 0xef, 0x56, 0x45, 0x46, 0x06, 0xd1, 0x65, 0x09, 0x22, 0x99, 0x63,
+	0x30, 0xbd, 0xb3, 0xdb, 0x6a, 0x6a, 0x2e, 0x72, 0xe8, 0x9a, 0x0c, 0x73, 0x65, 0x11, 0xb1, 0x49,
+	0x19, 0x31, 0x45, 0x04, 0xfa, 0xc1, 0x6e, 0xab, 0xdd, 0xca, 0xe5, 0x2e, 0x9b, 0x9c, 0x0f, 0x3d,
+	0x36, 0x19, 0x9f, 0x7f, 0x7e, 0xdf, 0x28, 0xf3, 0x37, 0xca, 0xf8, 0x6b, 0x88, 0x85, 0x5f, 0x8a,
+	0x56, 0x2c, 0x1c, 0x87, 0x9e, 0x91, 0x81, 0xf9, 0xb4, 0x00, 0x66, 0x53, 0xa4, 0xf9, 0xa3, 0x42,
+	0x9a, 0xf3, 0xd4, 0x95, 0xd9, 0x9c, 0xbe, 0xb3, 0xdb, 0x6a, 0xdd, 0x7c, 0x5e, 0xa3, 0x00, 0xeb,
+	0x59, 0x11, 0xd6, 0x96, 0xc8, 0x59, 0xc5, 0x3b, 0xf7, 0x40, 0x66, 0x42, 0x6f, 0x5b, 0x97, 0xaa,
+	0x79, 0xf2, 0x97, 0x94, 0x11, 0x7e, 0xe3, 0x93, 0x98, 0x05, 0xdb, 0x2f, 0xb1, 0xdb, 0xa9, 0xb0,
+	0xfb, 0x66, 0x14, 0x3e, 0x04, 0x78, 0x4c, 0xd8, 0x9e, 0x97, 0xb3, 0x42, 0x71, 0x39, 0x44, 0x4b,
+	0x1c, 0x53, 0xe7, 0x9e, 0xa8, 0x35, 0xc6, 0x57, 0x70, 0xf2, 0x0d, 0xf1, 0x08, 0x23, 0x47, 0xea,
+	0xff, 0x53, 0xe0, 0xe4, 0x7b, 0x71, 0x97, 0x8e, 0x9b, 0x20, 0xe3, 0xbb, 0xfe, 0x4a, 0xbe, 0xef,
+	0xed, 0xf9, 0xfe, 0xb2, 0x5c, 0x0b, 0xa7, 0x1f, 0xee, 0xb6, 0xda, 0xdd, 0xeb, 0x8e, 0xe0, 0x55,
+	0xa4, 0x2b, 0x6f, 0x46, 0x7a, 0xe3, 0x68, 0xd2, 0x9b, 0xaf, 0x47, 0xfa, 0xac, 0x52, 0x82, 0x3f,
+	0x2e, 0x90, 0x5e, 0x4a, 0xfb, 0x91, 0xb0, 0xb7, 0x5f, 0x17, 0xf6, 0xce, 0x91, 0xb0, 0xc3, 0x5b,
+	0x86, 0xfd, 0x0b, 0xe8, 0xf2, 0x5a, 0xbb, 0x87, 0x0d, 0x81, 0x12, 0x60, 0x37, 0xa9, 0x8e, 0xb2,
+	0x25, 0xda, 0x5c, 0xec, 0xd1, 0x25, 0x65, 0x42, 0x2c, 0x5b, 0x89, 0x31, 0xfe, 0xb5, 0x0e, 0x37,
+	0xd2, 0x12, 0x3c, 0x23, 0xe1, 0x9a, 0xce, 0x09, 0x1a, 0x83, 0xfc, 0x84, 0xbc, 0x40, 0xef, 0x5f,
+	0x5b, 0x3d, 0x7a, 0xd7, 0xd4, 0x6e, 0xa3, 0xc6, 0x35, 0x8f, 0x09, 0x2b, 0x69, 0xf2, 0xcb, 0x77,
+	0x40, 0xf3, 0x00, 0x9a, 0xc9, 0x59, 0x21, 0xf5, 0xd0, 0xf1, 0x1d, 0x54, 0x2a, 0xe2, 0x97, 0xe5,
+	0xb4, 0x30, 0x5a, 0xd8, 0x7e, 0xef, 0xb4, 0xaa, 0xe2, 0xc3, 0x46, 0x0d, 0xdd, 0x87, 0x66, 0x72,
+	0xaf, 0x4b, 0x6b, 0x96, 0xae, 0x7a, 0xef, 0x66, 0x61, 0x24, 0x79, 0xa5, 0xd4, 0xa6, 0x97, 0xbf,
+	0x5f, 0xf5, 0xa5, 0x3f, 0xae, 0xfa, 0xd2, 0x5f, 0x57, 0x7d, 0xe9, 0x97, 0xbf, 0xfb, 0xb5, 0x1f,
+	0x3e, 0x39, 0xf8, 0xe0, 0xa9, 0x3c, 0xb2, 0xec, 0xa6, 0x78, 0xf5, 0x7c, 0xf6, 0xff, 0x00, 0x76,
+	0x6d, 0xd5, 0xfa, 0x80, 0x09, 0x00, 0x00,
 }
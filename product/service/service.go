@@ -46,6 +46,7 @@ func (p *products) GetNamespace() string { return ns }
 
 type product struct {
 	productpb.Product `bson:",inline"`
+	fence             int64
 }
 
 func (p *product) GetNamespace() string { return ns }
@@ -56,6 +57,10 @@ func (p *product) SetCreated(t int64) { p.Created = t }
 
 func (p *product) SetUpdated(t int64) { p.Updated = t }
 
+func (p *product) SetFence(t int64) { p.fence = t }
+
+func (p *product) GetFence() int64 { return p.fence }
+
 type productService struct{}
 
 // New
@@ -75,6 +80,7 @@ func (s *productService) New(ctx context.Context, req *productpb.NewRequest) (*p
 			Metadata:    req.GetMetadata(),
 			Active:      req.GetActive(),
 			Url:         req.GetUrl(),
+			TaxCategory: req.GetTaxCategory(),
 		},
 	}
 
@@ -95,7 +101,7 @@ func (s *productService) Get(ctx context.Context, req *productpb.GetRequest) (*p
 		},
 	}
 
-	unlock, err := locker.Handler().TryLock(p, time.Second)
+	unlock, err := locker.Handler().TryLockContext(ctx, p, time.Second)
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +138,7 @@ func (s *productService) Update(ctx context.Context, req *productpb.UpdateReques
 		},
 	}
 
-	unlock, err := locker.Handler().TryLock(p, time.Second)
+	unlock, err := locker.Handler().TryLockContext(ctx, p, time.Second)
 	if err != nil {
 		return nil, err
 	}
@@ -171,6 +177,10 @@ func (s *productService) Update(ctx context.Context, req *productpb.UpdateReques
 		p.Url = x
 	}
 
+	if x := req.GetTaxCategory(); x != "" {
+		p.TaxCategory = x
+	}
+
 	return &p.Product, storage.Handler().Update(p)
 
 }
@@ -188,7 +198,7 @@ func (s *productService) Delete(ctx context.Context, req *productpb.DeleteReques
 		},
 	}
 
-	unlock, err := locker.Handler().TryLock(p, time.Second)
+	unlock, err := locker.Handler().TryLockContext(ctx, p, time.Second)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,88 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package promotion
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/digota/digota/promotion/promotionpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Normalize returns the form coupon codes are saved and looked up by
+func Normalize(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Valid returns FailedPrecondition error if p can't be used at now
+func Valid(p *promotionpb.Promotion, now time.Time) error {
+	switch {
+	case !p.GetActive():
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("Coupon %s is not active.", p.GetCode()))
+	case p.GetValidFrom() > 0 && now.Unix() < p.GetValidFrom():
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("Coupon %s is not valid yet.", p.GetCode()))
+	case p.GetValidUntil() > 0 && now.Unix() >= p.GetValidUntil():
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("Coupon %s has expired.", p.GetCode()))
+	case p.GetMaxRedemptions() > 0 && p.GetRedemptions() >= p.GetMaxRedemptions():
+		return status.Error(codes.FailedPrecondition, fmt.Sprintf("Coupon %s usage limit is reached.", p.GetCode()))
+	}
+	return nil
+}
+
+// Applies reports whether p applies to the sku of the product
+func Applies(p *promotionpb.Promotion, sku, product string) bool {
+	if len(p.GetSkus()) == 0 && len(p.GetProducts()) == 0 {
+		return true
+	}
+	for _, v := range p.GetSkus() {
+		if v == sku {
+			return true
+		}
+	}
+	for _, v := range p.GetProducts() {
+		if v == product {
+			return true
+		}
+	}
+	return false
+}
+
+// Discount returns the amount p takes off amount
+func Discount(p *promotionpb.Promotion, amount int64) int64 {
+	if amount <= 0 {
+		return 0
+	}
+	switch p.GetType() {
+	case promotionpb.Promotion_Percentage:
+		return amount * p.GetValue() / 100
+	case promotionpb.Promotion_Fixed:
+		if p.GetValue() < amount {
+			return p.GetValue()
+		}
+		return amount
+	}
+	return 0
+}
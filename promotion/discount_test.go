@@ -0,0 +1,109 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package promotion
+
+import (
+	"testing"
+	"time"
+
+	"github.com/digota/digota/promotion/promotionpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNormalize(t *testing.T) {
+	if c := Normalize(" summer10 "); c != "SUMMER10" {
+		t.Fatal(c)
+	}
+}
+
+func TestValid(t *testing.T) {
+	now := time.Now()
+	p := &promotionpb.Promotion{
+		Code:           "SUMMER10",
+		Active:         true,
+		ValidFrom:      now.Add(-time.Hour).Unix(),
+		ValidUntil:     now.Add(time.Hour).Unix(),
+		MaxRedemptions: 2,
+		Redemptions:    1,
+	}
+	if err := Valid(p, now); err != nil {
+		t.Fatal(err)
+	}
+	// not valid yet
+	if err := Valid(p, now.Add(-time.Hour*2)); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+	// expired
+	if err := Valid(p, now.Add(time.Hour*2)); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+	// usage limit
+	p.Redemptions = 2
+	if err := Valid(p, now); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+	// inactive
+	p.Redemptions = 0
+	p.Active = false
+	if err := Valid(p, now); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+}
+
+func TestApplies(t *testing.T) {
+	p := &promotionpb.Promotion{}
+	if !Applies(p, "sku", "product") {
+		t.Fatal()
+	}
+	p.Skus = []string{"sku"}
+	if !Applies(p, "sku", "other") || Applies(p, "other", "product") {
+		t.Fatal()
+	}
+	p.Products = []string{"product"}
+	if !Applies(p, "other", "product") || Applies(p, "other", "other") {
+		t.Fatal()
+	}
+}
+
+func TestDiscount(t *testing.T) {
+	p := &promotionpb.Promotion{
+		Type:  promotionpb.Promotion_Percentage,
+		Value: 10,
+	}
+	if d := Discount(p, 1050); d != 105 {
+		t.Fatal(d)
+	}
+	p.Type = promotionpb.Promotion_Fixed
+	p.Value = 500
+	if d := Discount(p, 1050); d != 500 {
+		t.Fatal(d)
+	}
+	// never more than the amount
+	if d := Discount(p, 300); d != 300 {
+		t.Fatal(d)
+	}
+	if d := Discount(p, 0); d != 0 {
+		t.Fatal(d)
+	}
+}
@@ -0,0 +1,91 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package promotion
+
+import (
+	"github.com/digota/digota/promotion/promotionpb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"regexp"
+)
+
+const baseMethod = "^(.promotionpb.PromotionService/)"
+
+var service Interface
+
+// Interface defines the functionality of the promotion service
+type Interface interface {
+	promotionpb.PromotionServiceServer
+	GetByCode(ctx context.Context, req *GetByCodeRequest) (*promotionpb.Promotion, error)
+	Redeem(ctx context.Context, req *RedeemRequest) (*promotionpb.Promotion, error)
+}
+
+// GetByCodeRequest request for getting promotion by its coupon code
+type GetByCodeRequest struct {
+	Code string `validate:"required"`
+}
+
+// RedeemRequest request for counting a promotion redemption, Release
+// takes back a redemption of an order that could not be paid
+type RedeemRequest struct {
+	Id      string `validate:"uuid4"`
+	Release bool
+}
+
+// RegisterService register p as the service provider
+func RegisterService(p Interface) {
+	if service != nil {
+		panic("PromotionService is already registered")
+	}
+	service = p
+}
+
+// Service return the registered service
+func Service() Interface {
+	if service == nil {
+		panic("PromotionService is not registered")
+	}
+	return service
+}
+
+// RegisterPromotionServer register service to the grpc server
+func RegisterPromotionServer(server *grpc.Server) {
+	promotionpb.RegisterPromotionServiceServer(server, Service())
+}
+
+// ReadMethods returns regexp slice of readable methods, mostly used by the acl
+func ReadMethods() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		regexp.MustCompile(baseMethod + "Get"),
+		regexp.MustCompile(baseMethod + "List"),
+	}
+}
+
+// WriteMethods returns regexp slice of writable methods, mostly used by the acl
+func WriteMethods() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		regexp.MustCompile(baseMethod + "New"),
+		regexp.MustCompile(baseMethod + "Update"),
+		regexp.MustCompile(baseMethod + "Delete"),
+	}
+}
@@ -0,0 +1,3293 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: promotion/promotionpb/promotion.proto
+
+/*
+	Package promotionpb is a generated protocol buffer package.
+
+	It is generated from these files:
+		promotion/promotionpb/promotion.proto
+
+	It has these top-level messages:
+		Empty
+		Promotion
+		PromotionList
+		NewRequest
+		GetRequest
+		DeleteRequest
+		UpdateRequest
+		ListRequest
+*/
+package promotionpb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import paymentpb "github.com/digota/digota/payment/paymentpb"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Promotion_Type int32
+
+const (
+	Promotion_Percentage Promotion_Type = 0
+	Promotion_Fixed      Promotion_Type = 1
+)
+
+var Promotion_Type_name = map[int32]string{
+	0: "Percentage",
+	1: "Fixed",
+}
+var Promotion_Type_value = map[string]int32{
+	"Percentage": 0,
+	"Fixed":      1,
+}
+
+func (x Promotion_Type) String() string {
+	return proto.EnumName(Promotion_Type_name, int32(x))
+}
+func (Promotion_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptorPromotion, []int{1, 0} }
+
+type Empty struct {
+}
+
+func (m *Empty) Reset()                    { *m = Empty{} }
+func (m *Empty) String() string            { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()               {}
+func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptorPromotion, []int{0} }
+
+type Promotion struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
+	// coupon code, unique and case insensitive
+	Code   string         `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Active bool           `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	Type   Promotion_Type `protobuf:"varint,4,opt,name=type,proto3,enum=promotionpb.Promotion_Type" json:"type,omitempty"`
+	// percents off for percentage promotions, amount off for fixed ones
+	Value int64 `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	// currency of fixed promotions
+	Currency paymentpb.Currency `protobuf:"varint,6,opt,name=currency,proto3,enum=paymentpb.Currency" json:"currency,omitempty"`
+	// skus and products the promotion applies to, all of them if empty
+	Skus     []string `protobuf:"bytes,7,rep,name=skus" json:"skus,omitempty"`
+	Products []string `protobuf:"bytes,8,rep,name=products" json:"products,omitempty"`
+	// min order amount
+	MinimumAmount int64 `protobuf:"varint,9,opt,name=minimumAmount,proto3" json:"minimumAmount,omitempty"`
+	// max number of paid orders using the promotion, unlimited if zero
+	MaxRedemptions int64 `protobuf:"varint,10,opt,name=maxRedemptions,proto3" json:"maxRedemptions,omitempty"`
+	Redemptions    int64 `protobuf:"varint,11,opt,name=redemptions,proto3" json:"redemptions,omitempty"`
+	// unix time validity window, open if zero
+	ValidFrom  int64             `protobuf:"varint,12,opt,name=validFrom,proto3" json:"validFrom,omitempty"`
+	ValidUntil int64             `protobuf:"varint,13,opt,name=validUntil,proto3" json:"validUntil,omitempty"`
+	Metadata   map[string]string `protobuf:"bytes,14,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Created    int64             `protobuf:"varint,998,opt,name=created,proto3" json:"created,omitempty"`
+	Updated    int64             `protobuf:"varint,999,opt,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (m *Promotion) Reset()                    { *m = Promotion{} }
+func (m *Promotion) String() string            { return proto.CompactTextString(m) }
+func (*Promotion) ProtoMessage()               {}
+func (*Promotion) Descriptor() ([]byte, []int) { return fileDescriptorPromotion, []int{1} }
+
+func (m *Promotion) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Promotion) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Promotion) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *Promotion) GetType() Promotion_Type {
+	if m != nil {
+		return m.Type
+	}
+	return Promotion_Percentage
+}
+
+func (m *Promotion) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *Promotion) GetCurrency() paymentpb.Currency {
+	if m != nil {
+		return m.Currency
+	}
+	return paymentpb.Currency_CUR_RESERVED
+}
+
+func (m *Promotion) GetSkus() []string {
+	if m != nil {
+		return m.Skus
+	}
+	return nil
+}
+
+func (m *Promotion) GetProducts() []string {
+	if m != nil {
+		return m.Products
+	}
+	return nil
+}
+
+func (m *Promotion) GetMinimumAmount() int64 {
+	if m != nil {
+		return m.MinimumAmount
+	}
+	return 0
+}
+
+func (m *Promotion) GetMaxRedemptions() int64 {
+	if m != nil {
+		return m.MaxRedemptions
+	}
+	return 0
+}
+
+func (m *Promotion) GetRedemptions() int64 {
+	if m != nil {
+		return m.Redemptions
+	}
+	return 0
+}
+
+func (m *Promotion) GetValidFrom() int64 {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return 0
+}
+
+func (m *Promotion) GetValidUntil() int64 {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return 0
+}
+
+func (m *Promotion) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Promotion) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *Promotion) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
+}
+
+type PromotionList struct {
+	Promotions []*Promotion `protobuf:"bytes,1,rep,name=promotions" json:"promotions,omitempty"`
+	Total      int32        `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *PromotionList) Reset()                    { *m = PromotionList{} }
+func (m *PromotionList) String() string            { return proto.CompactTextString(m) }
+func (*PromotionList) ProtoMessage()               {}
+func (*PromotionList) Descriptor() ([]byte, []int) { return fileDescriptorPromotion, []int{2} }
+
+func (m *PromotionList) GetPromotions() []*Promotion {
+	if m != nil {
+		return m.Promotions
+	}
+	return nil
+}
+
+func (m *PromotionList) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type NewRequest struct {
+	Code           string             `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty" validate:"required,gte=3,lte=64"`
+	Active         bool               `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	Type           Promotion_Type     `protobuf:"varint,3,opt,name=type,proto3,enum=promotionpb.Promotion_Type" json:"type,omitempty" validate:"omitempty,gte=0,lte=1"`
+	Value          int64              `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty" validate:"required,gt=0"`
+	Currency       paymentpb.Currency `protobuf:"varint,5,opt,name=currency,proto3,enum=paymentpb.Currency" json:"currency,omitempty" validate:"omitempty,gte=1,lte=128"`
+	Skus           []string           `protobuf:"bytes,6,rep,name=skus" json:"skus,omitempty" validate:"dive,uuid4"`
+	Products       []string           `protobuf:"bytes,7,rep,name=products" json:"products,omitempty" validate:"dive,uuid4"`
+	MinimumAmount  int64              `protobuf:"varint,8,opt,name=minimumAmount,proto3" json:"minimumAmount,omitempty" validate:"omitempty,gte=0"`
+	MaxRedemptions int64              `protobuf:"varint,9,opt,name=maxRedemptions,proto3" json:"maxRedemptions,omitempty" validate:"omitempty,gte=0"`
+	ValidFrom      int64              `protobuf:"varint,10,opt,name=validFrom,proto3" json:"validFrom,omitempty" validate:"omitempty,gte=0"`
+	ValidUntil     int64              `protobuf:"varint,11,opt,name=validUntil,proto3" json:"validUntil,omitempty" validate:"omitempty,gte=0"`
+	Metadata       map[string]string  `protobuf:"bytes,12,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *NewRequest) Reset()                    { *m = NewRequest{} }
+func (m *NewRequest) String() string            { return proto.CompactTextString(m) }
+func (*NewRequest) ProtoMessage()               {}
+func (*NewRequest) Descriptor() ([]byte, []int) { return fileDescriptorPromotion, []int{3} }
+
+func (m *NewRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *NewRequest) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *NewRequest) GetType() Promotion_Type {
+	if m != nil {
+		return m.Type
+	}
+	return Promotion_Percentage
+}
+
+func (m *NewRequest) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *NewRequest) GetCurrency() paymentpb.Currency {
+	if m != nil {
+		return m.Currency
+	}
+	return paymentpb.Currency_CUR_RESERVED
+}
+
+func (m *NewRequest) GetSkus() []string {
+	if m != nil {
+		return m.Skus
+	}
+	return nil
+}
+
+func (m *NewRequest) GetProducts() []string {
+	if m != nil {
+		return m.Products
+	}
+	return nil
+}
+
+func (m *NewRequest) GetMinimumAmount() int64 {
+	if m != nil {
+		return m.MinimumAmount
+	}
+	return 0
+}
+
+func (m *NewRequest) GetMaxRedemptions() int64 {
+	if m != nil {
+		return m.MaxRedemptions
+	}
+	return 0
+}
+
+func (m *NewRequest) GetValidFrom() int64 {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return 0
+}
+
+func (m *NewRequest) GetValidUntil() int64 {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return 0
+}
+
+func (m *NewRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type GetRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,uuid4"`
+}
+
+func (m *GetRequest) Reset()                    { *m = GetRequest{} }
+func (m *GetRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()               {}
+func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptorPromotion, []int{4} }
+
+func (m *GetRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type DeleteRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,uuid4"`
+}
+
+func (m *DeleteRequest) Reset()                    { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()               {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorPromotion, []int{5} }
+
+func (m *DeleteRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type UpdateRequest struct {
+	Id             string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,uuid4"`
+	Active         bool              `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	Value          int64             `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty" validate:"omitempty,gt=0"`
+	Skus           []string          `protobuf:"bytes,4,rep,name=skus" json:"skus,omitempty" validate:"dive,uuid4"`
+	Products       []string          `protobuf:"bytes,5,rep,name=products" json:"products,omitempty" validate:"dive,uuid4"`
+	MinimumAmount  int64             `protobuf:"varint,6,opt,name=minimumAmount,proto3" json:"minimumAmount,omitempty" validate:"omitempty,gte=0"`
+	MaxRedemptions int64             `protobuf:"varint,7,opt,name=maxRedemptions,proto3" json:"maxRedemptions,omitempty" validate:"omitempty,gte=0"`
+	ValidFrom      int64             `protobuf:"varint,8,opt,name=validFrom,proto3" json:"validFrom,omitempty" validate:"omitempty,gte=0"`
+	ValidUntil     int64             `protobuf:"varint,9,opt,name=validUntil,proto3" json:"validUntil,omitempty" validate:"omitempty,gte=0"`
+	Metadata       map[string]string `protobuf:"bytes,10,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *UpdateRequest) Reset()                    { *m = UpdateRequest{} }
+func (m *UpdateRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateRequest) ProtoMessage()               {}
+func (*UpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorPromotion, []int{6} }
+
+func (m *UpdateRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateRequest) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *UpdateRequest) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *UpdateRequest) GetSkus() []string {
+	if m != nil {
+		return m.Skus
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetProducts() []string {
+	if m != nil {
+		return m.Products
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetMinimumAmount() int64 {
+	if m != nil {
+		return m.MinimumAmount
+	}
+	return 0
+}
+
+func (m *UpdateRequest) GetMaxRedemptions() int64 {
+	if m != nil {
+		return m.MaxRedemptions
+	}
+	return 0
+}
+
+func (m *UpdateRequest) GetValidFrom() int64 {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return 0
+}
+
+func (m *UpdateRequest) GetValidUntil() int64 {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return 0
+}
+
+func (m *UpdateRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type ListRequest struct {
+	Page  int64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *ListRequest) Reset()                    { *m = ListRequest{} }
+func (m *ListRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListRequest) ProtoMessage()               {}
+func (*ListRequest) Descriptor() ([]byte, []int) { return fileDescriptorPromotion, []int{7} }
+
+func (m *ListRequest) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *ListRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Empty)(nil), "promotionpb.Empty")
+	proto.RegisterType((*Promotion)(nil), "promotionpb.Promotion")
+	proto.RegisterType((*PromotionList)(nil), "promotionpb.PromotionList")
+	proto.RegisterType((*NewRequest)(nil), "promotionpb.NewRequest")
+	proto.RegisterType((*GetRequest)(nil), "promotionpb.GetRequest")
+	proto.RegisterType((*DeleteRequest)(nil), "promotionpb.DeleteRequest")
+	proto.RegisterType((*UpdateRequest)(nil), "promotionpb.UpdateRequest")
+	proto.RegisterType((*ListRequest)(nil), "promotionpb.ListRequest")
+	proto.RegisterEnum("promotionpb.Promotion_Type", Promotion_Type_name, Promotion_Type_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for PromotionService service
+
+type PromotionServiceClient interface {
+	New(ctx context.Context, in *NewRequest, opts ...grpc.CallOption) (*Promotion, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Promotion, error)
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*Promotion, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*Empty, error)
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*PromotionList, error)
+}
+
+type promotionServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPromotionServiceClient(cc *grpc.ClientConn) PromotionServiceClient {
+	return &promotionServiceClient{cc}
+}
+
+func (c *promotionServiceClient) New(ctx context.Context, in *NewRequest, opts ...grpc.CallOption) (*Promotion, error) {
+	out := new(Promotion)
+	err := grpc.Invoke(ctx, "/promotionpb.PromotionService/New", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Promotion, error) {
+	out := new(Promotion)
+	err := grpc.Invoke(ctx, "/promotionpb.PromotionService/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*Promotion, error) {
+	out := new(Promotion)
+	err := grpc.Invoke(ctx, "/promotionpb.PromotionService/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/promotionpb.PromotionService/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*PromotionList, error) {
+	out := new(PromotionList)
+	err := grpc.Invoke(ctx, "/promotionpb.PromotionService/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for PromotionService service
+
+type PromotionServiceServer interface {
+	New(context.Context, *NewRequest) (*Promotion, error)
+	Get(context.Context, *GetRequest) (*Promotion, error)
+	Update(context.Context, *UpdateRequest) (*Promotion, error)
+	Delete(context.Context, *DeleteRequest) (*Empty, error)
+	List(context.Context, *ListRequest) (*PromotionList, error)
+}
+
+func RegisterPromotionServiceServer(s *grpc.Server, srv PromotionServiceServer) {
+	s.RegisterService(&_PromotionService_serviceDesc, srv)
+}
+
+func _PromotionService_New_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).New(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/promotionpb.PromotionService/New",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).New(ctx, req.(*NewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/promotionpb.PromotionService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/promotionpb.PromotionService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/promotionpb.PromotionService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/promotionpb.PromotionService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PromotionService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "promotionpb.PromotionService",
+	HandlerType: (*PromotionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "New",
+			Handler:    _PromotionService_New_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _PromotionService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _PromotionService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _PromotionService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _PromotionService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "promotion/promotionpb/promotion.proto",
+}
+
+func (m *Empty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *Promotion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Promotion) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Code) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(len(m.Code)))
+		i += copy(dAtA[i:], m.Code)
+	}
+	if m.Active {
+		dAtA[i] = 0x18
+		i++
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.Type))
+	}
+	if m.Value != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.Value))
+	}
+	if m.Currency != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.Currency))
+	}
+	if len(m.Skus) > 0 {
+		for _, s := range m.Skus {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Products) > 0 {
+		for _, s := range m.Products {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.MinimumAmount != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.MinimumAmount))
+	}
+	if m.MaxRedemptions != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.MaxRedemptions))
+	}
+	if m.Redemptions != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.Redemptions))
+	}
+	if m.ValidFrom != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.ValidFrom))
+	}
+	if m.ValidUntil != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.ValidUntil))
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x72
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovPromotion(uint64(len(k))) + 1 + len(v) + sovPromotion(uint64(len(v)))
+			i = encodeVarintPromotion(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPromotion(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPromotion(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.Created != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.Created))
+	}
+	if m.Updated != 0 {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.Updated))
+	}
+	return i, nil
+}
+
+func (m *PromotionList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PromotionList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Promotions) > 0 {
+		for _, msg := range m.Promotions {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPromotion(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Total != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.Total))
+	}
+	return i, nil
+}
+
+func (m *NewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NewRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(len(m.Code)))
+		i += copy(dAtA[i:], m.Code)
+	}
+	if m.Active {
+		dAtA[i] = 0x10
+		i++
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.Type))
+	}
+	if m.Value != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.Value))
+	}
+	if m.Currency != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.Currency))
+	}
+	if len(m.Skus) > 0 {
+		for _, s := range m.Skus {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Products) > 0 {
+		for _, s := range m.Products {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.MinimumAmount != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.MinimumAmount))
+	}
+	if m.MaxRedemptions != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.MaxRedemptions))
+	}
+	if m.ValidFrom != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.ValidFrom))
+	}
+	if m.ValidUntil != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.ValidUntil))
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x62
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovPromotion(uint64(len(k))) + 1 + len(v) + sovPromotion(uint64(len(v)))
+			i = encodeVarintPromotion(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPromotion(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPromotion(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Active {
+		dAtA[i] = 0x10
+		i++
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Value != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.Value))
+	}
+	if len(m.Skus) > 0 {
+		for _, s := range m.Skus {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Products) > 0 {
+		for _, s := range m.Products {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.MinimumAmount != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.MinimumAmount))
+	}
+	if m.MaxRedemptions != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.MaxRedemptions))
+	}
+	if m.ValidFrom != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.ValidFrom))
+	}
+	if m.ValidUntil != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.ValidUntil))
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x52
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovPromotion(uint64(len(k))) + 1 + len(v) + sovPromotion(uint64(len(v)))
+			i = encodeVarintPromotion(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPromotion(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPromotion(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *ListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Page != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPromotion(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func encodeFixed64Promotion(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Promotion(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintPromotion(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Empty) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Promotion) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPromotion(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovPromotion(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.Type != 0 {
+		n += 1 + sovPromotion(uint64(m.Type))
+	}
+	if m.Value != 0 {
+		n += 1 + sovPromotion(uint64(m.Value))
+	}
+	if m.Currency != 0 {
+		n += 1 + sovPromotion(uint64(m.Currency))
+	}
+	if len(m.Skus) > 0 {
+		for _, s := range m.Skus {
+			l = len(s)
+			n += 1 + l + sovPromotion(uint64(l))
+		}
+	}
+	if len(m.Products) > 0 {
+		for _, s := range m.Products {
+			l = len(s)
+			n += 1 + l + sovPromotion(uint64(l))
+		}
+	}
+	if m.MinimumAmount != 0 {
+		n += 1 + sovPromotion(uint64(m.MinimumAmount))
+	}
+	if m.MaxRedemptions != 0 {
+		n += 1 + sovPromotion(uint64(m.MaxRedemptions))
+	}
+	if m.Redemptions != 0 {
+		n += 1 + sovPromotion(uint64(m.Redemptions))
+	}
+	if m.ValidFrom != 0 {
+		n += 1 + sovPromotion(uint64(m.ValidFrom))
+	}
+	if m.ValidUntil != 0 {
+		n += 1 + sovPromotion(uint64(m.ValidUntil))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPromotion(uint64(len(k))) + 1 + len(v) + sovPromotion(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPromotion(uint64(mapEntrySize))
+		}
+	}
+	if m.Created != 0 {
+		n += 2 + sovPromotion(uint64(m.Created))
+	}
+	if m.Updated != 0 {
+		n += 2 + sovPromotion(uint64(m.Updated))
+	}
+	return n
+}
+
+func (m *PromotionList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Promotions) > 0 {
+		for _, e := range m.Promotions {
+			l = e.Size()
+			n += 1 + l + sovPromotion(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 1 + sovPromotion(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *NewRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovPromotion(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.Type != 0 {
+		n += 1 + sovPromotion(uint64(m.Type))
+	}
+	if m.Value != 0 {
+		n += 1 + sovPromotion(uint64(m.Value))
+	}
+	if m.Currency != 0 {
+		n += 1 + sovPromotion(uint64(m.Currency))
+	}
+	if len(m.Skus) > 0 {
+		for _, s := range m.Skus {
+			l = len(s)
+			n += 1 + l + sovPromotion(uint64(l))
+		}
+	}
+	if len(m.Products) > 0 {
+		for _, s := range m.Products {
+			l = len(s)
+			n += 1 + l + sovPromotion(uint64(l))
+		}
+	}
+	if m.MinimumAmount != 0 {
+		n += 1 + sovPromotion(uint64(m.MinimumAmount))
+	}
+	if m.MaxRedemptions != 0 {
+		n += 1 + sovPromotion(uint64(m.MaxRedemptions))
+	}
+	if m.ValidFrom != 0 {
+		n += 1 + sovPromotion(uint64(m.ValidFrom))
+	}
+	if m.ValidUntil != 0 {
+		n += 1 + sovPromotion(uint64(m.ValidUntil))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPromotion(uint64(len(k))) + 1 + len(v) + sovPromotion(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPromotion(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPromotion(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPromotion(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovPromotion(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.Value != 0 {
+		n += 1 + sovPromotion(uint64(m.Value))
+	}
+	if len(m.Skus) > 0 {
+		for _, s := range m.Skus {
+			l = len(s)
+			n += 1 + l + sovPromotion(uint64(l))
+		}
+	}
+	if len(m.Products) > 0 {
+		for _, s := range m.Products {
+			l = len(s)
+			n += 1 + l + sovPromotion(uint64(l))
+		}
+	}
+	if m.MinimumAmount != 0 {
+		n += 1 + sovPromotion(uint64(m.MinimumAmount))
+	}
+	if m.MaxRedemptions != 0 {
+		n += 1 + sovPromotion(uint64(m.MaxRedemptions))
+	}
+	if m.ValidFrom != 0 {
+		n += 1 + sovPromotion(uint64(m.ValidFrom))
+	}
+	if m.ValidUntil != 0 {
+		n += 1 + sovPromotion(uint64(m.ValidUntil))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPromotion(uint64(len(k))) + 1 + len(v) + sovPromotion(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPromotion(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ListRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovPromotion(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovPromotion(uint64(m.Limit))
+	}
+	return n
+}
+
+func sovPromotion(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPromotion(x uint64) (n int) {
+	return sovPromotion(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Empty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPromotion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPromotion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Promotion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPromotion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Promotion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Promotion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (Promotion_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			m.Currency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Currency |= (paymentpb.Currency(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Skus = append(m.Skus, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Products", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Products = append(m.Products, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumAmount", wireType)
+			}
+			m.MinimumAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinimumAmount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptions", wireType)
+			}
+			m.MaxRedemptions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRedemptions |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptions", wireType)
+			}
+			m.Redemptions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Redemptions |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			m.ValidFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidFrom |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			m.ValidUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntil |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPromotion
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPromotion
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthPromotion
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Metadata[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Metadata[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 998:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 999:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			m.Updated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updated |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPromotion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPromotion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Promotions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Promotions = append(m.Promotions, &Promotion{})
+			if err := m.Promotions[len(m.Promotions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPromotion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPromotion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (Promotion_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			m.Currency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Currency |= (paymentpb.Currency(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Skus = append(m.Skus, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Products", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Products = append(m.Products, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumAmount", wireType)
+			}
+			m.MinimumAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinimumAmount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptions", wireType)
+			}
+			m.MaxRedemptions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRedemptions |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			m.ValidFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidFrom |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			m.ValidUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntil |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPromotion
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPromotion
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthPromotion
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Metadata[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Metadata[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPromotion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPromotion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPromotion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPromotion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPromotion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPromotion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Skus = append(m.Skus, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Products", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Products = append(m.Products, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumAmount", wireType)
+			}
+			m.MinimumAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinimumAmount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptions", wireType)
+			}
+			m.MaxRedemptions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRedemptions |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			m.ValidFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidFrom |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			m.ValidUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntil |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPromotion
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPromotion
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthPromotion
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Metadata[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Metadata[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPromotion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPromotion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPromotion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPromotion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPromotion(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPromotion
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPromotion
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthPromotion
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowPromotion
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipPromotion(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthPromotion = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPromotion   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("promotion/promotionpb/promotion.proto", fileDescriptorPromotion) }
+
+var fileDescriptorPromotion = []byte{
+	// 982 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0x5e, 0xc7, 0x71, 0x7e, 0x4e, 0x9a, 0x68, 0x35, 0x40, 0xf1, 0x9a, 0x92, 0x04, 0x6b, 0x5b,
+	0xe5, 0x62, 0x37, 0x69, 0xb3, 0x50, 0xa2, 0x2d, 0xbb, 0x6a, 0xc3, 0x76, 0x7b, 0x03, 0x55, 0x65,
+	0x58, 0x21, 0x21, 0x21, 0xe4, 0xd8, 0x43, 0x18, 0x35, 0xfe, 0xa9, 0x33, 0x4e, 0x9b, 0x3b, 0x6e,
+	0x78, 0x07, 0x1e, 0x84, 0x27, 0xe0, 0x8a, 0x4b, 0x9e, 0x20, 0x42, 0x8b, 0x04, 0xf7, 0x79, 0x02,
+	0x34, 0xe3, 0x9f, 0x8c, 0xd3, 0xb8, 0xdd, 0x36, 0x70, 0xe5, 0x73, 0xe6, 0x9c, 0x6f, 0xe6, 0xcc,
+	0x99, 0xef, 0xf3, 0x0c, 0xdc, 0xf4, 0x03, 0xcf, 0xf1, 0x28, 0xf1, 0xdc, 0x5e, 0x6a, 0xf9, 0xa3,
+	0x95, 0xdd, 0xf5, 0x03, 0x8f, 0x7a, 0xa8, 0x26, 0x04, 0xb5, 0xc3, 0x31, 0xa1, 0x3f, 0x86, 0xa3,
+	0xae, 0xe5, 0x39, 0xbd, 0xb1, 0x37, 0xf6, 0x7a, 0x3c, 0x67, 0x14, 0xfe, 0xc0, 0x3d, 0xee, 0x70,
+	0x2b, 0xc2, 0x6a, 0x03, 0x21, 0xdd, 0x26, 0x63, 0x8f, 0x9a, 0xc9, 0xc7, 0x37, 0xe7, 0x0e, 0x76,
+	0x69, 0xf2, 0xf5, 0x47, 0x89, 0x15, 0x21, 0xf5, 0x32, 0x28, 0x0f, 0x1d, 0x9f, 0xce, 0xf5, 0x9f,
+	0x15, 0xa8, 0x3e, 0x49, 0x2a, 0x40, 0x4d, 0x28, 0x10, 0x5b, 0x95, 0xda, 0x52, 0xa7, 0x3a, 0x6c,
+	0x2c, 0x17, 0x2d, 0x18, 0x4d, 0x3d, 0xf7, 0x58, 0xff, 0x9e, 0xd8, 0xba, 0x51, 0x20, 0x36, 0x42,
+	0x50, 0xb4, 0x3c, 0x1b, 0xab, 0x05, 0x96, 0x61, 0x70, 0x1b, 0x5d, 0x87, 0x92, 0x69, 0x51, 0x32,
+	0xc3, 0xaa, 0xdc, 0x96, 0x3a, 0x15, 0x23, 0xf6, 0x50, 0x0f, 0x8a, 0x74, 0xee, 0x63, 0xb5, 0xd8,
+	0x96, 0x3a, 0x8d, 0xfe, 0x07, 0x5d, 0x61, 0x9f, 0xdd, 0x74, 0xc5, 0xee, 0xd7, 0x73, 0x1f, 0x1b,
+	0x3c, 0x11, 0xbd, 0x0b, 0xca, 0xcc, 0x9c, 0x84, 0x58, 0x55, 0xda, 0x52, 0x47, 0x36, 0x22, 0x07,
+	0xf5, 0xa0, 0x62, 0x85, 0x41, 0x80, 0x5d, 0x6b, 0xae, 0x96, 0xf8, 0x54, 0xef, 0x74, 0xd3, 0x5d,
+	0x75, 0x3f, 0x8f, 0x43, 0x46, 0x9a, 0xc4, 0x6a, 0x9c, 0x3e, 0x0d, 0xa7, 0x6a, 0xb9, 0x2d, 0xb3,
+	0x1a, 0x99, 0x8d, 0x34, 0xa8, 0xf8, 0x81, 0x67, 0x87, 0x16, 0x9d, 0xaa, 0x15, 0x3e, 0x9e, 0xfa,
+	0x68, 0x1f, 0xea, 0x0e, 0x71, 0x89, 0x13, 0x3a, 0x0f, 0x1c, 0x2f, 0x74, 0xa9, 0x5a, 0xe5, 0xcb,
+	0x67, 0x07, 0xd1, 0x2d, 0x68, 0x38, 0xe6, 0x0b, 0x03, 0xdb, 0xd8, 0xf1, 0x59, 0xe1, 0x53, 0x15,
+	0x78, 0xda, 0xda, 0x28, 0x6a, 0x43, 0x2d, 0x10, 0x92, 0x6a, 0x3c, 0x49, 0x1c, 0x42, 0x37, 0xa0,
+	0x3a, 0x33, 0x27, 0xc4, 0x3e, 0x0f, 0x3c, 0x47, 0xbd, 0xc6, 0xe3, 0xab, 0x01, 0xd4, 0x04, 0xe0,
+	0xce, 0x85, 0x4b, 0xc9, 0x44, 0xad, 0xf3, 0xb0, 0x30, 0x82, 0xee, 0x43, 0xc5, 0xc1, 0xd4, 0xb4,
+	0x4d, 0x6a, 0xaa, 0x8d, 0xb6, 0xdc, 0xa9, 0xf5, 0xf7, 0x73, 0x3a, 0xfb, 0x65, 0x9c, 0xf6, 0xd0,
+	0xa5, 0xc1, 0xdc, 0x48, 0x51, 0x68, 0x0f, 0xca, 0x56, 0x80, 0x4d, 0x8a, 0x6d, 0xf5, 0xef, 0x32,
+	0x9f, 0x3f, 0xf1, 0x59, 0x28, 0xf4, 0x6d, 0x1e, 0xfa, 0x27, 0x0e, 0xc5, 0xbe, 0x76, 0x0f, 0xea,
+	0x99, 0x09, 0xd1, 0x2e, 0xc8, 0x4f, 0xf1, 0x3c, 0xe2, 0x8a, 0xc1, 0xcc, 0xd5, 0xf9, 0x45, 0xec,
+	0x88, 0x9c, 0xe3, 0xc2, 0x40, 0xd2, 0x3f, 0x82, 0x22, 0x3b, 0x67, 0xd4, 0x00, 0x78, 0x82, 0x03,
+	0x0b, 0xbb, 0xd4, 0x1c, 0xe3, 0xdd, 0x1d, 0x54, 0x05, 0xe5, 0x9c, 0xbc, 0xc0, 0xf6, 0xae, 0xa4,
+	0x7f, 0x07, 0xf5, 0xb4, 0xf4, 0x2f, 0xc8, 0x94, 0xa2, 0xbb, 0x00, 0xe9, 0xbe, 0xa6, 0xaa, 0xc4,
+	0xb7, 0x7a, 0x7d, 0xf3, 0x56, 0x0d, 0x21, 0x93, 0x55, 0x41, 0x3d, 0x6a, 0x4e, 0x78, 0x15, 0x8a,
+	0x11, 0x39, 0xfa, 0xaf, 0x25, 0x80, 0xc7, 0xf8, 0xb9, 0x81, 0x9f, 0x85, 0x78, 0x4a, 0xd1, 0x20,
+	0xe6, 0x71, 0xc4, 0xf4, 0xfd, 0xe5, 0xa2, 0xd5, 0xe6, 0x3d, 0x36, 0x29, 0x3e, 0xd6, 0x03, 0xfc,
+	0x2c, 0x24, 0x01, 0xb6, 0x0f, 0xc6, 0x14, 0x9f, 0x1c, 0x1d, 0x4c, 0x28, 0x3e, 0xb9, 0xfb, 0xb1,
+	0xfe, 0x12, 0xdb, 0x0b, 0x19, 0xb6, 0x5f, 0xc4, 0x6c, 0x97, 0x5f, 0xcb, 0xf6, 0xf5, 0xe5, 0x3c,
+	0x87, 0x50, 0x46, 0x8e, 0x39, 0x5f, 0xef, 0x36, 0x5f, 0xef, 0x8e, 0x1e, 0x6b, 0xa2, 0x9f, 0xf4,
+	0x94, 0xa9, 0x48, 0x1e, 0xde, 0x58, 0x2e, 0x5a, 0xea, 0xc6, 0x4a, 0x4f, 0x6e, 0xeb, 0x89, 0x62,
+	0xbe, 0x11, 0x14, 0xa3, 0xe4, 0x2a, 0x66, 0x78, 0x6b, 0xb9, 0x68, 0xe9, 0x79, 0x65, 0xdc, 0x89,
+	0xca, 0xe8, 0x0f, 0x74, 0x41, 0x59, 0x87, 0xb1, 0xb2, 0x4a, 0x4c, 0x41, 0xc3, 0xbd, 0xe5, 0xa2,
+	0xf5, 0xde, 0x0a, 0x6f, 0x93, 0x19, 0x3e, 0x08, 0x43, 0x62, 0xb3, 0x56, 0x71, 0xd1, 0x7d, 0x22,
+	0x88, 0xae, 0xfc, 0x3a, 0xc8, 0x4a, 0x8f, 0x67, 0xeb, 0x7a, 0xac, 0xf0, 0xad, 0x37, 0x97, 0x8b,
+	0x96, 0x96, 0xdb, 0x35, 0x7d, 0x5d, 0xaf, 0xe7, 0x2f, 0xe9, 0xb5, 0x7a, 0xa5, 0x69, 0xd6, 0xf5,
+	0xfc, 0x99, 0xa8, 0x56, 0xb8, 0xd2, 0x14, 0x2b, 0x00, 0x3a, 0xcd, 0xa8, 0xb9, 0x76, 0x25, 0xb8,
+	0xa8, 0xf6, 0x07, 0x82, 0xda, 0xaf, 0x71, 0x09, 0xdc, 0xcc, 0x30, 0x6b, 0x45, 0xe9, 0x3c, 0xb9,
+	0x6f, 0x27, 0xdc, 0x7b, 0x00, 0x8f, 0x30, 0x4d, 0x54, 0x73, 0x28, 0xdc, 0x0e, 0x1f, 0x2e, 0x17,
+	0xad, 0xbd, 0x0d, 0x4c, 0x8c, 0x8f, 0xb3, 0x40, 0x6c, 0xfd, 0x14, 0xea, 0x67, 0x78, 0x82, 0x29,
+	0x7e, 0x4b, 0xfc, 0x4f, 0x0a, 0xd4, 0x2f, 0xf8, 0xef, 0xe7, 0xed, 0x26, 0xc8, 0xd5, 0xea, 0x51,
+	0xb2, 0x5f, 0x99, 0x1f, 0xc8, 0xda, 0x4c, 0xe2, 0x81, 0x08, 0xaa, 0x4a, 0xc8, 0x5f, 0x7c, 0x73,
+	0xf2, 0x2b, 0x5b, 0x90, 0xbf, 0xf4, 0xdf, 0x90, 0xbf, 0xbc, 0x3d, 0xf9, 0x2b, 0xdb, 0x91, 0xbf,
+	0xfa, 0xc6, 0xe4, 0x3f, 0x13, 0xc8, 0x0f, 0x9c, 0xfc, 0x9d, 0x0c, 0xf9, 0x33, 0xdc, 0xf8, 0x7f,
+	0xf8, 0xff, 0x29, 0xd4, 0xd8, 0x65, 0x94, 0xf0, 0x0f, 0x41, 0xd1, 0x37, 0xc7, 0xd1, 0xb5, 0x21,
+	0x1b, 0xdc, 0x66, 0xe0, 0x09, 0x71, 0x08, 0xe5, 0x60, 0xd9, 0x88, 0x9c, 0xfe, 0x6f, 0x05, 0xd8,
+	0x4d, 0x7f, 0xfb, 0x5f, 0xe1, 0x60, 0x46, 0x2c, 0x8c, 0x06, 0x20, 0x3f, 0xc6, 0xcf, 0xd1, 0xfb,
+	0x39, 0x12, 0xd6, 0x72, 0xae, 0x37, 0x7d, 0x87, 0x21, 0x1f, 0x61, 0xba, 0x86, 0x5c, 0x29, 0xf3,
+	0x15, 0xc8, 0x53, 0x28, 0x45, 0x7d, 0x42, 0x5a, 0x7e, 0xf3, 0x5e, 0x81, 0x3f, 0x86, 0x52, 0x24,
+	0xe2, 0x35, 0x7c, 0x46, 0xd9, 0x1a, 0xca, 0xc4, 0xa2, 0x97, 0x25, 0x5b, 0xbb, 0xc8, 0xaf, 0x72,
+	0x35, 0x13, 0x15, 0x1a, 0xaa, 0x69, 0x9b, 0xd7, 0x65, 0x29, 0xfa, 0xce, 0xf0, 0xfe, 0xef, 0x97,
+	0x4d, 0xe9, 0x8f, 0xcb, 0xa6, 0xf4, 0xe7, 0x65, 0x53, 0xfa, 0xe5, 0xaf, 0xe6, 0xce, 0xb7, 0xdd,
+	0xfc, 0x07, 0xef, 0xa6, 0xc7, 0xf6, 0xa8, 0xc4, 0x5f, 0xbb, 0x47, 0xff, 0x0e, 0x00, 0x03, 0x0f,
+	0xe5, 0x9e, 0x8c, 0x0b, 0x00, 0x00,
+}
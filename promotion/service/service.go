@@ -0,0 +1,336 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"time"
+
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/payment/paymentpb"
+	promotionInterface "github.com/digota/digota/promotion"
+	"github.com/digota/digota/promotion/promotionpb"
+	"github.com/digota/digota/storage"
+	"github.com/digota/digota/storage/object"
+	"github.com/digota/digota/validation"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const ns = "promotion"
+
+func init() {
+	promotionInterface.RegisterService(&promotionService{})
+}
+
+type promotions []*promotionpb.Promotion
+
+func (p *promotions) GetNamespace() string { return ns }
+
+type promotion struct {
+	promotionpb.Promotion `bson:",inline"`
+	fence                 int64
+}
+
+func (p *promotion) GetNamespace() string { return ns }
+
+func (p *promotion) SetId(id string) { p.Id = id }
+
+func (p *promotion) SetCreated(t int64) { p.Created = t }
+
+func (p *promotion) SetUpdated(t int64) { p.Updated = t }
+
+func (p *promotion) SetFence(t int64) { p.fence = t }
+
+func (p *promotion) GetFence() int64 { return p.fence }
+
+// couponCode is the lock object of a code, codes are locked while
+// checked for uniqueness
+type couponCode string
+
+func (c couponCode) GetNamespace() string { return ns }
+
+func (c couponCode) GetId() string { return "code-" + string(c) }
+
+// check returns InvalidArgument error if p values don't fit its type
+func check(p *promotionpb.Promotion) error {
+	if p.GetType() == promotionpb.Promotion_Percentage && p.GetValue() > 100 {
+		return status.Error(codes.InvalidArgument, "Percentage value can't be greater than 100.")
+	}
+	if p.GetType() == promotionpb.Promotion_Fixed && p.GetCurrency() == paymentpb.Currency_CUR_RESERVED {
+		return status.Error(codes.InvalidArgument, "Fixed promotion requires currency.")
+	}
+	if p.GetValidFrom() > 0 && p.GetValidUntil() > 0 && p.GetValidUntil() <= p.GetValidFrom() {
+		return status.Error(codes.InvalidArgument, "Promotion validUntil must be after validFrom.")
+	}
+	return nil
+}
+
+// findByCode returns the promotion of code or NotFound error
+func findByCode(code string) (*promotionpb.Promotion, error) {
+	slice := promotions{}
+	if _, err := storage.Handler().List(&slice, object.ListOpt{
+		Limit:   1,
+		Filters: []object.Filter{{Field: "code", Op: object.OpEq, Value: code}},
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(slice) == 0 {
+		return nil, status.Error(codes.NotFound, "Coupon "+code+" not found.")
+	}
+	return slice[0], nil
+}
+
+type promotionService struct{}
+
+// New
+func (s *promotionService) New(ctx context.Context, req *promotionpb.NewRequest) (*promotionpb.Promotion, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	p := &promotion{
+		Promotion: promotionpb.Promotion{
+			Code:           promotionInterface.Normalize(req.GetCode()),
+			Active:         req.GetActive(),
+			Type:           req.GetType(),
+			Value:          req.GetValue(),
+			Currency:       req.GetCurrency(),
+			Skus:           req.GetSkus(),
+			Products:       req.GetProducts(),
+			MinimumAmount:  req.GetMinimumAmount(),
+			MaxRedemptions: req.GetMaxRedemptions(),
+			ValidFrom:      req.GetValidFrom(),
+			ValidUntil:     req.GetValidUntil(),
+			Metadata:       req.GetMetadata(),
+		},
+	}
+
+	if err := check(&p.Promotion); err != nil {
+		return nil, err
+	}
+
+	// codes are unique
+	unlock, err := locker.Handler().TryLockContext(ctx, couponCode(p.Code), time.Second)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if _, err := findByCode(p.Code); status.Code(err) != codes.NotFound {
+		if err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.AlreadyExists, "Coupon "+p.Code+" already exists.")
+	}
+
+	return &p.Promotion, storage.Handler().Insert(p)
+
+}
+
+// Get
+func (s *promotionService) Get(ctx context.Context, req *promotionpb.GetRequest) (*promotionpb.Promotion, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	p := &promotion{
+		Promotion: promotionpb.Promotion{
+			Id: req.GetId(),
+		},
+	}
+
+	unlock, err := locker.Handler().TryLockContext(ctx, p, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return &p.Promotion, storage.Handler().One(p)
+
+}
+
+// Update
+func (s *promotionService) Update(ctx context.Context, req *promotionpb.UpdateRequest) (*promotionpb.Promotion, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	p := &promotion{
+		Promotion: promotionpb.Promotion{
+			Id: req.GetId(),
+		},
+	}
+
+	unlock, err := locker.Handler().TryLockContext(ctx, p, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := storage.Handler().One(p); err != nil {
+		return nil, err
+	}
+
+	// update fields and keep the rest the same
+
+	p.Active = req.GetActive()
+
+	if x := req.GetValue(); x != 0 {
+		p.Value = x
+	}
+
+	if x := req.GetSkus(); x != nil {
+		p.Skus = x
+	}
+
+	if x := req.GetProducts(); x != nil {
+		p.Products = x
+	}
+
+	if x := req.GetMinimumAmount(); x != 0 {
+		p.MinimumAmount = x
+	}
+
+	if x := req.GetMaxRedemptions(); x != 0 {
+		p.MaxRedemptions = x
+	}
+
+	if x := req.GetValidFrom(); x != 0 {
+		p.ValidFrom = x
+	}
+
+	if x := req.GetValidUntil(); x != 0 {
+		p.ValidUntil = x
+	}
+
+	if x := req.GetMetadata(); x != nil {
+		p.Metadata = x
+	}
+
+	if err := check(&p.Promotion); err != nil {
+		return nil, err
+	}
+
+	return &p.Promotion, storage.Handler().Update(p)
+
+}
+
+// Delete
+func (s *promotionService) Delete(ctx context.Context, req *promotionpb.DeleteRequest) (*promotionpb.Empty, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	p := &promotion{
+		Promotion: promotionpb.Promotion{
+			Id: req.GetId(),
+		},
+	}
+
+	unlock, err := locker.Handler().TryLockContext(ctx, p, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return &promotionpb.Empty{}, storage.Handler().Remove(p)
+
+}
+
+// List
+func (s *promotionService) List(ctx context.Context, req *promotionpb.ListRequest) (*promotionpb.PromotionList, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	slice := &promotions{}
+
+	n, err := storage.Handler().List(slice, object.ListOpt{
+		Limit: req.GetLimit(),
+		Page:  req.GetPage(),
+		Sort:  object.SortNatural,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &promotionpb.PromotionList{Promotions: *slice, Total: int32(n)}, nil
+
+}
+
+// GetByCode returns the promotion of the coupon code
+func (s *promotionService) GetByCode(ctx context.Context, req *promotionInterface.GetByCodeRequest) (*promotionpb.Promotion, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	return findByCode(promotionInterface.Normalize(req.Code))
+
+}
+
+// Redeem counts a redemption of a valid promotion, or takes it back
+// if released
+func (s *promotionService) Redeem(ctx context.Context, req *promotionInterface.RedeemRequest) (*promotionpb.Promotion, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	p := &promotion{
+		Promotion: promotionpb.Promotion{
+			Id: req.Id,
+		},
+	}
+
+	unlock, err := locker.Handler().TryLockContext(ctx, p, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := storage.Handler().One(p); err != nil {
+		return nil, err
+	}
+
+	if req.Release {
+		if p.Redemptions > 0 {
+			p.Redemptions--
+		}
+	} else {
+		if err := promotionInterface.Valid(&p.Promotion, time.Now()); err != nil {
+			return nil, err
+		}
+		p.Redemptions++
+	}
+
+	return &p.Promotion, storage.Handler().Update(p)
+
+}
@@ -0,0 +1,276 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/payment/paymentpb"
+	promotionInterface "github.com/digota/digota/promotion"
+	"github.com/digota/digota/promotion/promotionpb"
+	"github.com/digota/digota/storage"
+	"github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"os"
+	"testing"
+	"time"
+)
+
+var service = &promotionService{}
+var db = "testing-promotion-" + uuid.NewV4().String()
+
+func TestMain(m *testing.M) {
+	// storage
+	if err := storage.New(config.Storage{
+		Address:  []string{"localhost"},
+		Handler:  "mongodb",
+		Database: db,
+	}); err != nil {
+		panic(err)
+	}
+
+	// in-memory locker
+	locker.New(config.Locker{})
+
+	retCode := m.Run()
+	storage.Handler().DropDatabase(db)
+	// teardown
+	os.Exit(retCode)
+}
+
+func newCode() string {
+	return "TEST-" + uuid.NewV4().String()[:8]
+}
+
+func TestPromotions_GetNamespace(t *testing.T) {
+	p := promotions{}
+	if p.GetNamespace() != "promotion" {
+		t.Fatal()
+	}
+}
+
+func TestPromotion_GetNamespace(t *testing.T) {
+	p := promotion{}
+	if p.GetNamespace() != "promotion" {
+		t.Fatal()
+	}
+}
+
+func TestPromotion_SetId(t *testing.T) {
+	p := promotion{}
+	uid := uuid.NewV4().String()
+	p.SetId(uid)
+	if p.GetId() != uid {
+		t.Fatal()
+	}
+}
+
+func TestPromotion_SetCreated(t *testing.T) {
+	p := promotion{}
+	ti := time.Now().Unix()
+	p.SetCreated(ti)
+	if p.Created != ti {
+		t.Fatal()
+	}
+}
+
+func TestCheck(t *testing.T) {
+	if err := check(&promotionpb.Promotion{Value: 101}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+	if err := check(&promotionpb.Promotion{Type: promotionpb.Promotion_Fixed, Value: 100}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+	if err := check(&promotionpb.Promotion{Value: 10, ValidFrom: 10, ValidUntil: 5}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+	if err := check(&promotionpb.Promotion{Type: promotionpb.Promotion_Fixed, Value: 100, Currency: paymentpb.Currency_USD}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPromotionService_New(t *testing.T) {
+
+	code := newCode()
+
+	// ok
+	p, err := service.New(context.Background(), &promotionpb.NewRequest{
+		Code:   code,
+		Active: true,
+		Type:   promotionpb.Promotion_Percentage,
+		Value:  10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.GetCode() != promotionInterface.Normalize(code) {
+		t.Fatal(p)
+	}
+
+	// code already exists
+	if _, err := service.New(context.Background(), &promotionpb.NewRequest{
+		Code:  code,
+		Value: 10,
+	}); status.Code(err) != codes.AlreadyExists {
+		t.Fatal(err)
+	}
+
+	// validation fail
+	if _, err := service.New(context.Background(), &promotionpb.NewRequest{
+		Code: newCode(),
+	}); err == nil {
+		t.Fatal()
+	}
+
+	// fixed without currency
+	if _, err := service.New(context.Background(), &promotionpb.NewRequest{
+		Code:  newCode(),
+		Type:  promotionpb.Promotion_Fixed,
+		Value: 10,
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+
+}
+
+func TestPromotionService_GetByCode(t *testing.T) {
+
+	code := newCode()
+
+	p, err := service.New(context.Background(), &promotionpb.NewRequest{
+		Code:   code,
+		Active: true,
+		Value:  10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// case insensitive
+	p1, err := service.GetByCode(context.Background(), &promotionInterface.GetByCodeRequest{Code: " " + p.GetCode() + " "})
+	if err != nil || p1.GetId() != p.GetId() {
+		t.Fatal(err)
+	}
+
+	// not found
+	if _, err := service.GetByCode(context.Background(), &promotionInterface.GetByCodeRequest{Code: newCode()}); status.Code(err) != codes.NotFound {
+		t.Fatal(err)
+	}
+
+}
+
+func TestPromotionService_Update(t *testing.T) {
+
+	p, err := service.New(context.Background(), &promotionpb.NewRequest{
+		Code:   newCode(),
+		Active: true,
+		Value:  10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p1, err := service.Update(context.Background(), &promotionpb.UpdateRequest{
+		Id:     p.GetId(),
+		Active: true,
+		Value:  20,
+	})
+	if err != nil || p1.GetValue() != 20 || p1.GetCode() != p.GetCode() {
+		t.Fatal(err)
+	}
+
+	// percentage over 100
+	if _, err := service.Update(context.Background(), &promotionpb.UpdateRequest{
+		Id:    p.GetId(),
+		Value: 200,
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+
+}
+
+func TestPromotionService_Redeem(t *testing.T) {
+
+	p, err := service.New(context.Background(), &promotionpb.NewRequest{
+		Code:           newCode(),
+		Active:         true,
+		Value:          10,
+		MaxRedemptions: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p1, err := service.Redeem(context.Background(), &promotionInterface.RedeemRequest{Id: p.GetId()}); err != nil || p1.GetRedemptions() != 1 {
+		t.Fatal(err)
+	}
+
+	// limit reached
+	if _, err := service.Redeem(context.Background(), &promotionInterface.RedeemRequest{Id: p.GetId()}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	// release
+	if p1, err := service.Redeem(context.Background(), &promotionInterface.RedeemRequest{Id: p.GetId(), Release: true}); err != nil || p1.GetRedemptions() != 0 {
+		t.Fatal(err)
+	}
+
+}
+
+func TestPromotionService_Delete(t *testing.T) {
+
+	p, err := service.New(context.Background(), &promotionpb.NewRequest{
+		Code:  newCode(),
+		Value: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.Delete(context.Background(), &promotionpb.DeleteRequest{Id: p.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.Get(context.Background(), &promotionpb.GetRequest{Id: p.GetId()}); err == nil {
+		t.Fatal()
+	}
+
+}
+
+func TestPromotionService_List(t *testing.T) {
+
+	if _, err := service.New(context.Background(), &promotionpb.NewRequest{
+		Code:  newCode(),
+		Value: 10,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := service.List(context.Background(), &promotionpb.ListRequest{Limit: 10})
+	if err != nil || l.GetTotal() == 0 {
+		t.Fatal(err)
+	}
+
+}
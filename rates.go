@@ -0,0 +1,121 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/digota/digota/admin/adminpb"
+	"golang.org/x/net/context"
+	"gopkg.in/urfave/cli.v1"
+)
+
+// ratesCommand shows and replaces the exchange rates table of all the nodes
+var ratesCommand = cli.Command{
+	Name:  "rates",
+	Usage: "Manage the exchange rates of the nodes",
+	Subcommands: []cli.Command{
+		{
+			Name:   "get",
+			Usage:  "Show the exchange rates table",
+			Flags:  adminFlags,
+			Action: getRates,
+		},
+		{
+			Name:      "set",
+			Usage:     "Replace the exchange rates table",
+			ArgsUsage: "<base> <currency:rate>...",
+			Flags:     adminFlags,
+			Action:    setRates,
+		},
+	},
+}
+
+func getRates(c *cli.Context) error {
+	client, conn, err := newAdminClient(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	rates, err := client.GetExchangeRates(context.Background(), &adminpb.GetExchangeRatesRequest{})
+	if err != nil {
+		return cli.NewExitError(err.Error(), 1)
+	}
+	return printRates(rates)
+}
+
+func setRates(c *cli.Context) error {
+	if c.NArg() < 1 {
+		return cli.NewExitError("Usage: rates set <base> <currency:rate>...", 1)
+	}
+	req := &adminpb.SetExchangeRatesRequest{
+		Base:  c.Args().First(),
+		Rates: make(map[string]float64),
+	}
+	for _, v := range c.Args().Tail() {
+		kv := strings.SplitN(v, ":", 2)
+		if len(kv) != 2 {
+			return cli.NewExitError(fmt.Sprintf("Invalid rate %q", v), 1)
+		}
+		rate, err := strconv.ParseFloat(kv[1], 64)
+		if err != nil {
+			return cli.NewExitError(fmt.Sprintf("Invalid rate %q", v), 1)
+		}
+		req.Rates[kv[0]] = rate
+	}
+	client, conn, err := newAdminClient(c)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	rates, err := client.SetExchangeRates(context.Background(), req)
+	if err != nil {
+		return cli.NewExitError(err.Error(), 1)
+	}
+	return printRates(rates)
+}
+
+func printRates(rates *adminpb.ExchangeRates) error {
+	if rates.GetBase() == "" {
+		fmt.Println("No exchange rates")
+		return nil
+	}
+	var codes []string
+	for k := range rates.GetRates() {
+		codes = append(codes, k)
+	}
+	sort.Strings(codes)
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "BASE %s\tUPDATED %s\n", rates.GetBase(), time.Unix(rates.GetUpdated(), 0).Format(time.RFC3339))
+	fmt.Fprintln(w, "CURRENCY\tRATE")
+	for _, k := range codes {
+		fmt.Fprintf(w, "%s\t%v\n", k, rates.GetRates()[k])
+	}
+	return w.Flush()
+}
@@ -0,0 +1,106 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sdk
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func retryableErr(c codes.Code, d time.Duration) error {
+	s, _ := status.New(c, "retry").WithDetails(ptypes.DurationProto(d))
+	return s.Err()
+}
+
+func TestRetryAfter(t *testing.T) {
+	if d, ok := RetryAfter(retryableErr(codes.Aborted, time.Second)); !ok || d != time.Second {
+		t.Fatal(d)
+	}
+	if d, ok := RetryAfter(retryableErr(codes.Unavailable, time.Millisecond)); !ok || d != time.Millisecond {
+		t.Fatal(d)
+	}
+	// not retryable code
+	if _, ok := RetryAfter(retryableErr(codes.Internal, time.Second)); ok {
+		t.Fatal()
+	}
+	// no delay
+	if _, ok := RetryAfter(status.Error(codes.Unavailable, "")); ok {
+		t.Fatal()
+	}
+	if _, ok := RetryAfter(errors.New("")); ok {
+		t.Fatal()
+	}
+	if _, ok := RetryAfter(nil); ok {
+		t.Fatal()
+	}
+}
+
+func TestUnaryRetryInterceptor(t *testing.T) {
+	calls := 0
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		if calls < 3 {
+			return retryableErr(codes.Aborted, time.Millisecond)
+		}
+		return nil
+	}
+
+	if err := UnaryRetryInterceptor(3)(context.Background(), "", nil, nil, nil, invoker); err != nil || calls != 3 {
+		t.Fatal(err, calls)
+	}
+
+	// out of retries
+	calls = 0
+	if err := UnaryRetryInterceptor(1)(context.Background(), "", nil, nil, nil, invoker); status.Code(err) != codes.Aborted || calls != 2 {
+		t.Fatal(err, calls)
+	}
+
+	// not retryable
+	calls = 0
+	internal := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		return status.Error(codes.Internal, "")
+	}
+	if err := UnaryRetryInterceptor(3)(context.Background(), "", nil, nil, nil, internal); status.Code(err) != codes.Internal || calls != 1 {
+		t.Fatal(err, calls)
+	}
+
+	// ctx done while waiting
+	calls = 0
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	slow := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		return retryableErr(codes.Unavailable, time.Minute)
+	}
+	if err := UnaryRetryInterceptor(3)(ctx, "", nil, nil, nil, slow); status.Code(err) != codes.Unavailable || calls != 1 {
+		t.Fatal(err, calls)
+	}
+}
@@ -32,10 +32,14 @@ import (
 	"time"
 )
 
-// ClientOpt additional connection information
+// ClientOpt additional connection information, calls failing on lock
+// contention or unavailability are retried MaxRetries times unless NoRetry
+// is set, zero MaxRetries means DefaultMaxRetries.
 type ClientOpt struct {
 	Crt, Key, ServerName, CaCrt   string
 	InsecureSkipVerify, WithBlock bool
+	NoRetry                       bool
+	MaxRetries                    int
 }
 
 // NewClient creates new grpc connection to the addr using the ClientOpt
@@ -76,6 +80,14 @@ func NewClient(addr string, opt *ClientOpt) (*grpc.ClientConn, error) {
 		grpc.WithTransportCredentials(credentials.NewTLS(tlsConf)),
 	}
 
+	if !opt.NoRetry {
+		retries := opt.MaxRetries
+		if retries == 0 {
+			retries = DefaultMaxRetries
+		}
+		dailOpts = append(dailOpts, grpc.WithUnaryInterceptor(UnaryRetryInterceptor(retries)))
+	}
+
 	if opt.WithBlock {
 		dailOpts = append(dailOpts, grpc.WithBlock(), grpc.WithTimeout(5*time.Second))
 	}
@@ -24,14 +24,30 @@ package server
 
 // load services first
 import (
+	// register admin service
+	_ "github.com/digota/digota/admin/service"
+	// register cart service
+	_ "github.com/digota/digota/cart/service"
+	// register customer service
+	_ "github.com/digota/digota/customer/service"
+	// register invoice service
+	_ "github.com/digota/digota/invoice/service"
 	// register order service
 	_ "github.com/digota/digota/order/service"
 	// register payment service
 	_ "github.com/digota/digota/payment/service"
 	// register product service
 	_ "github.com/digota/digota/product/service"
+	// register promotion service
+	_ "github.com/digota/digota/promotion/service"
+	// register shipping service
+	_ "github.com/digota/digota/shipping/service"
 	// register sku service
 	_ "github.com/digota/digota/sku/service"
+	// register subscription service
+	_ "github.com/digota/digota/subscription/service"
+	// register tax service
+	_ "github.com/digota/digota/tax/service"
 )
 
 import (
@@ -44,18 +60,29 @@ import (
 	"syscall"
 
 	"github.com/digota/digota/acl"
+	"github.com/digota/digota/admin"
+	"github.com/digota/digota/cart"
 	"github.com/digota/digota/client"
 	"github.com/digota/digota/config"
+	"github.com/digota/digota/customer"
+	"github.com/digota/digota/exchange"
+	"github.com/digota/digota/idempotency"
+	"github.com/digota/digota/invoice"
 	"github.com/digota/digota/locker"
 	"github.com/digota/digota/middleware/authentication"
 	"github.com/digota/digota/middleware/logger"
 	"github.com/digota/digota/middleware/recovery"
 	"github.com/digota/digota/order"
+	"github.com/digota/digota/outbox"
 	"github.com/digota/digota/payment"
 	"github.com/digota/digota/payment/service/providers"
 	"github.com/digota/digota/product"
+	"github.com/digota/digota/promotion"
+	"github.com/digota/digota/shipping"
 	"github.com/digota/digota/sku"
 	"github.com/digota/digota/storage"
+	"github.com/digota/digota/subscription"
+	"github.com/digota/digota/tax"
 	"github.com/digota/digota/util"
 	"github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
@@ -90,6 +117,31 @@ func New(conf *config.AppConfig) *server {
 		log.Fatalf("Could not create locker handler => %s", err.Error())
 	}
 
+	// load exchange rates table
+	if err := exchange.New(conf.Exchange); err != nil {
+		log.Fatalf("Could not load exchange rates => %s", err.Error())
+	}
+
+	// idempotency keys replay window
+	idempotency.New(conf.Idempotency)
+
+	// invoice numbering and templates
+	if err := invoice.New(conf.Invoice); err != nil {
+		log.Fatalf("Could not load invoice templates => %s", err.Error())
+	}
+
+	// start order expiry sweeper
+	order.New(conf.Order)
+
+	// start pending payments reconciler
+	outbox.New(conf.Outbox)
+
+	// start subscription renewal scheduler
+	subscription.New(conf.Subscription)
+
+	// start abandoned carts sweeper
+	cart.New(conf.Cart)
+
 	// load ca clients
 	client.New(conf.Clients)
 	providers.New(conf.Payment)
@@ -185,6 +237,14 @@ func registerServices(s *grpc.Server) {
 	order.RegisterOrderServer(s)
 	payment.RegisterPaymentServer(s)
 	sku.RegisterSkuServer(s)
+	promotion.RegisterPromotionServer(s)
+	shipping.RegisterShippingServer(s)
+	invoice.RegisterInvoiceServer(s)
+	subscription.RegisterSubscriptionServer(s)
+	cart.RegisterCartServer(s)
+	customer.RegisterCustomerServer(s)
+	tax.RegisterTaxServer(s)
+	admin.RegisterAdminServer(s)
 	reflection.Register(s)
 }
 
@@ -0,0 +1,89 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package shipping
+
+import (
+	"strings"
+
+	"github.com/digota/digota/shipping/shippingpb"
+	"github.com/digota/digota/sku/skupb"
+)
+
+// Ships reports whether r ships to the country and postal code
+func Ships(r *shippingpb.ShippingRate, country, postalCode string) bool {
+	if len(r.GetCountries()) > 0 {
+		var ok bool
+		for _, v := range r.GetCountries() {
+			if strings.EqualFold(v, country) {
+				ok = true
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	if len(r.GetPostalPrefixes()) > 0 {
+		postalCode = strings.ToUpper(strings.Replace(postalCode, " ", "", -1))
+		for _, v := range r.GetPostalPrefixes() {
+			if strings.HasPrefix(postalCode, strings.ToUpper(strings.Replace(v, " ", "", -1))) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// Weight returns the chargeable weight of a package by r, the greater of its
+// weight and dimensional weight. packages without dimensions weigh nothing.
+func Weight(r *shippingpb.ShippingRate, d *skupb.PackageDimensions) float64 {
+	w := d.GetWeight()
+	if r.GetDimDivisor() > 0 {
+		if dim := d.GetHeight() * d.GetLength() * d.GetWidth() / r.GetDimDivisor(); dim > w {
+			w = dim
+		}
+	}
+	return w
+}
+
+// Price returns the price of shipping weight by r for order items amount
+// of subtotal, false if the weight is over the rate brackets
+func Price(r *shippingpb.ShippingRate, weight float64, subtotal int64) (int64, bool) {
+	var (
+		amount int64
+		found  bool
+	)
+	for _, b := range r.GetBrackets() {
+		if weight <= b.GetMaxWeight() {
+			amount, found = b.GetAmount(), true
+			break
+		}
+	}
+	if !found {
+		return 0, false
+	}
+	if r.GetFreeAbove() > 0 && subtotal >= r.GetFreeAbove() {
+		return 0, true
+	}
+	return amount, true
+}
@@ -0,0 +1,93 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package shipping
+
+import (
+	"testing"
+
+	"github.com/digota/digota/shipping/shippingpb"
+	"github.com/digota/digota/sku/skupb"
+)
+
+func TestShips(t *testing.T) {
+	r := &shippingpb.ShippingRate{}
+	if !Ships(r, "US", "12345") {
+		t.Fatal()
+	}
+	r.Countries = []string{"US", "CA"}
+	if !Ships(r, "us", "12345") || Ships(r, "IL", "12345") {
+		t.Fatal()
+	}
+	r.PostalPrefixes = []string{"123", "9"}
+	if !Ships(r, "US", "12345") || !Ships(r, "US", "90001") || Ships(r, "US", "45678") {
+		t.Fatal()
+	}
+}
+
+func TestWeight(t *testing.T) {
+	d := &skupb.PackageDimensions{Height: 10, Length: 20, Width: 30, Weight: 1}
+	r := &shippingpb.ShippingRate{}
+	if w := Weight(r, d); w != 1 {
+		t.Fatal(w)
+	}
+	// dimensional weight 6000 / 5000
+	r.DimDivisor = 5000
+	if w := Weight(r, d); w != 1.2 {
+		t.Fatal(w)
+	}
+	// heavier than its volume
+	d.Weight = 2
+	if w := Weight(r, d); w != 2 {
+		t.Fatal(w)
+	}
+	if w := Weight(r, nil); w != 0 {
+		t.Fatal(w)
+	}
+}
+
+func TestPrice(t *testing.T) {
+	r := &shippingpb.ShippingRate{
+		Brackets: []*shippingpb.WeightBracket{
+			{MaxWeight: 1, Amount: 500},
+			{MaxWeight: 5, Amount: 1000},
+		},
+	}
+	if a, ok := Price(r, 0.5, 0); !ok || a != 500 {
+		t.Fatal(a)
+	}
+	if a, ok := Price(r, 3, 0); !ok || a != 1000 {
+		t.Fatal(a)
+	}
+	// too heavy
+	if _, ok := Price(r, 6, 0); ok {
+		t.Fatal()
+	}
+	// free shipping
+	r.FreeAbove = 5000
+	if a, ok := Price(r, 3, 5000); !ok || a != 0 {
+		t.Fatal(a)
+	}
+	if a, ok := Price(r, 3, 4999); !ok || a != 1000 {
+		t.Fatal(a)
+	}
+}
@@ -0,0 +1,254 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/digota/digota/locker"
+	shippingInterface "github.com/digota/digota/shipping"
+	"github.com/digota/digota/shipping/shippingpb"
+	"github.com/digota/digota/storage"
+	"github.com/digota/digota/storage/object"
+	"github.com/digota/digota/validation"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const ns = "shipping"
+
+func init() {
+	shippingInterface.RegisterService(&shippingService{})
+}
+
+type rates []*shippingpb.ShippingRate
+
+func (r *rates) GetNamespace() string { return ns }
+
+type rate struct {
+	shippingpb.ShippingRate `bson:",inline"`
+	fence                   int64
+}
+
+func (r *rate) GetNamespace() string { return ns }
+
+func (r *rate) SetId(id string) { r.Id = id }
+
+func (r *rate) SetCreated(t int64) { r.Created = t }
+
+func (r *rate) SetUpdated(t int64) { r.Updated = t }
+
+func (r *rate) SetFence(t int64) { r.fence = t }
+
+func (r *rate) GetFence() int64 { return r.fence }
+
+// sortBrackets sorts brackets by weight, the first bracket a weight fits in
+// is its price
+func sortBrackets(brackets []*shippingpb.WeightBracket) {
+	sort.Slice(brackets, func(i, j int) bool {
+		return brackets[i].GetMaxWeight() < brackets[j].GetMaxWeight()
+	})
+}
+
+type shippingService struct{}
+
+// New
+func (s *shippingService) New(ctx context.Context, req *shippingpb.NewRequest) (*shippingpb.ShippingRate, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	r := &rate{
+		ShippingRate: shippingpb.ShippingRate{
+			Name:           req.GetName(),
+			Carrier:        req.GetCarrier(),
+			Active:         req.GetActive(),
+			Countries:      req.GetCountries(),
+			PostalPrefixes: req.GetPostalPrefixes(),
+			Currency:       req.GetCurrency(),
+			DimDivisor:     req.GetDimDivisor(),
+			Brackets:       req.GetBrackets(),
+			FreeAbove:      req.GetFreeAbove(),
+		},
+	}
+
+	sortBrackets(r.Brackets)
+
+	return &r.ShippingRate, storage.Handler().Insert(r)
+
+}
+
+// Get
+func (s *shippingService) Get(ctx context.Context, req *shippingpb.GetRequest) (*shippingpb.ShippingRate, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	r := &rate{
+		ShippingRate: shippingpb.ShippingRate{
+			Id: req.GetId(),
+		},
+	}
+
+	unlock, err := locker.Handler().TryLockContext(ctx, r, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return &r.ShippingRate, storage.Handler().One(r)
+
+}
+
+// Update
+func (s *shippingService) Update(ctx context.Context, req *shippingpb.UpdateRequest) (*shippingpb.ShippingRate, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	r := &rate{
+		ShippingRate: shippingpb.ShippingRate{
+			Id: req.GetId(),
+		},
+	}
+
+	unlock, err := locker.Handler().TryLockContext(ctx, r, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := storage.Handler().One(r); err != nil {
+		return nil, err
+	}
+
+	// update fields and keep the rest the same
+
+	r.Active = req.GetActive()
+
+	if x := req.GetName(); x != "" {
+		r.Name = x
+	}
+
+	if x := req.GetCarrier(); x != "" {
+		r.Carrier = x
+	}
+
+	if x := req.GetCountries(); x != nil {
+		r.Countries = x
+	}
+
+	if x := req.GetPostalPrefixes(); x != nil {
+		r.PostalPrefixes = x
+	}
+
+	if x := req.GetDimDivisor(); x != 0 {
+		r.DimDivisor = x
+	}
+
+	if x := req.GetBrackets(); x != nil {
+		sortBrackets(x)
+		r.Brackets = x
+	}
+
+	if x := req.GetFreeAbove(); x != 0 {
+		r.FreeAbove = x
+	}
+
+	return &r.ShippingRate, storage.Handler().Update(r)
+
+}
+
+// Delete
+func (s *shippingService) Delete(ctx context.Context, req *shippingpb.DeleteRequest) (*shippingpb.Empty, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	r := &rate{
+		ShippingRate: shippingpb.ShippingRate{
+			Id: req.GetId(),
+		},
+	}
+
+	unlock, err := locker.Handler().TryLockContext(ctx, r, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return &shippingpb.Empty{}, storage.Handler().Remove(r)
+
+}
+
+// List
+func (s *shippingService) List(ctx context.Context, req *shippingpb.ListRequest) (*shippingpb.ShippingRateList, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	slice := &rates{}
+
+	n, err := storage.Handler().List(slice, object.ListOpt{
+		Limit: req.GetLimit(),
+		Page:  req.GetPage(),
+		Sort:  object.SortNatural,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &shippingpb.ShippingRateList{Rates: *slice, Total: int32(n)}, nil
+
+}
+
+// Rates returns the active rates of the currency
+func (s *shippingService) Rates(ctx context.Context, req *shippingInterface.RatesRequest) ([]*shippingpb.ShippingRate, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	slice := rates{}
+
+	if _, err := storage.Handler().List(&slice, object.ListOpt{
+		Sort: object.SortCreatedAsc,
+		Filters: []object.Filter{
+			{Field: "currency", Op: object.OpEq, Value: req.Currency},
+			{Field: "active", Op: object.OpEq, Value: true},
+		},
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return slice, nil
+
+}
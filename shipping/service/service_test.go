@@ -0,0 +1,201 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/payment/paymentpb"
+	shippingInterface "github.com/digota/digota/shipping"
+	"github.com/digota/digota/shipping/shippingpb"
+	"github.com/digota/digota/storage"
+	"github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+	"os"
+	"testing"
+	"time"
+)
+
+var service = &shippingService{}
+var db = "testing-shipping-" + uuid.NewV4().String()
+
+func TestMain(m *testing.M) {
+	// storage
+	if err := storage.New(config.Storage{
+		Address:  []string{"localhost"},
+		Handler:  "mongodb",
+		Database: db,
+	}); err != nil {
+		panic(err)
+	}
+
+	// in-memory locker
+	locker.New(config.Locker{})
+
+	retCode := m.Run()
+	storage.Handler().DropDatabase(db)
+	// teardown
+	os.Exit(retCode)
+}
+
+func newRequest() *shippingpb.NewRequest {
+	return &shippingpb.NewRequest{
+		Name:      "Ground",
+		Carrier:   "UPS",
+		Active:    true,
+		Countries: []string{"US"},
+		Currency:  paymentpb.Currency_USD,
+		Brackets: []*shippingpb.WeightBracket{
+			{MaxWeight: 5, Amount: 1000},
+			{MaxWeight: 1, Amount: 500},
+		},
+	}
+}
+
+func TestRates_GetNamespace(t *testing.T) {
+	r := rates{}
+	if r.GetNamespace() != "shipping" {
+		t.Fatal()
+	}
+}
+
+func TestRate_GetNamespace(t *testing.T) {
+	r := rate{}
+	if r.GetNamespace() != "shipping" {
+		t.Fatal()
+	}
+}
+
+func TestRate_SetId(t *testing.T) {
+	r := rate{}
+	uid := uuid.NewV4().String()
+	r.SetId(uid)
+	if r.GetId() != uid {
+		t.Fatal()
+	}
+}
+
+func TestRate_SetCreated(t *testing.T) {
+	r := rate{}
+	ti := time.Now().Unix()
+	r.SetCreated(ti)
+	if r.Created != ti {
+		t.Fatal()
+	}
+}
+
+func TestShippingService_New(t *testing.T) {
+
+	// ok
+	r, err := service.New(context.Background(), newRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// brackets are sorted
+	if r.GetBrackets()[0].GetMaxWeight() != 1 {
+		t.Fatal(r)
+	}
+
+	// validation fail
+	if _, err := service.New(context.Background(), &shippingpb.NewRequest{
+		Name: "Ground",
+	}); err == nil {
+		t.Fatal()
+	}
+
+}
+
+func TestShippingService_Update(t *testing.T) {
+
+	r, err := service.New(context.Background(), newRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r1, err := service.Update(context.Background(), &shippingpb.UpdateRequest{
+		Id:        r.GetId(),
+		Active:    true,
+		FreeAbove: 5000,
+	})
+	if err != nil || r1.GetFreeAbove() != 5000 || r1.GetName() != "Ground" {
+		t.Fatal(err)
+	}
+
+}
+
+func TestShippingService_Rates(t *testing.T) {
+
+	req := newRequest()
+	req.Currency = paymentpb.Currency_ILS
+
+	r, err := service.New(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := service.Rates(context.Background(), &shippingInterface.RatesRequest{Currency: paymentpb.Currency_ILS})
+	if err != nil || len(l) == 0 {
+		t.Fatal(err)
+	}
+	for _, v := range l {
+		if v.GetCurrency() != paymentpb.Currency_ILS || !v.GetActive() {
+			t.Fatal(v)
+		}
+	}
+
+	// inactive
+	if _, err := service.Update(context.Background(), &shippingpb.UpdateRequest{Id: r.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	l1, err := service.Rates(context.Background(), &shippingInterface.RatesRequest{Currency: paymentpb.Currency_ILS})
+	if err != nil || len(l1) != len(l)-1 {
+		t.Fatal(err)
+	}
+
+}
+
+func TestShippingService_Delete(t *testing.T) {
+
+	r, err := service.New(context.Background(), newRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.Delete(context.Background(), &shippingpb.DeleteRequest{Id: r.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.Get(context.Background(), &shippingpb.GetRequest{Id: r.GetId()}); err == nil {
+		t.Fatal()
+	}
+
+}
+
+func TestShippingService_List(t *testing.T) {
+
+	l, err := service.List(context.Background(), &shippingpb.ListRequest{Limit: 10})
+	if err != nil || l.GetTotal() == 0 {
+		t.Fatal(err)
+	}
+
+}
@@ -0,0 +1,2908 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: shipping/shippingpb/shipping.proto
+
+/*
+	Package shippingpb is a generated protocol buffer package.
+
+	It is generated from these files:
+		shipping/shippingpb/shipping.proto
+
+	It has these top-level messages:
+		Empty
+		ShippingRate
+		WeightBracket
+		ShippingRateList
+		NewRequest
+		GetRequest
+		DeleteRequest
+		UpdateRequest
+		ListRequest
+*/
+package shippingpb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import paymentpb "github.com/digota/digota/payment/paymentpb"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Empty struct {
+}
+
+func (m *Empty) Reset()                    { *m = Empty{} }
+func (m *Empty) String() string            { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()               {}
+func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptorShipping, []int{0} }
+
+type ShippingRate struct {
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Carrier string `protobuf:"bytes,3,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	Active  bool   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	// zone the rate ships to, countries and postal prefixes narrow it down
+	// if set
+	Countries      []string           `protobuf:"bytes,5,rep,name=countries" json:"countries,omitempty"`
+	PostalPrefixes []string           `protobuf:"bytes,6,rep,name=postalPrefixes" json:"postalPrefixes,omitempty"`
+	Currency       paymentpb.Currency `protobuf:"varint,7,opt,name=currency,proto3,enum=paymentpb.Currency" json:"currency,omitempty"`
+	// package volume divided by the divisor is its dimensional weight,
+	// packages are charged by the greater of their weight and dimensional
+	// weight. dimensions are ignored if zero
+	DimDivisor float64 `protobuf:"fixed64,8,opt,name=dimDivisor,proto3" json:"dimDivisor,omitempty"`
+	// price by the order chargeable weight, the first bracket the weight fits
+	// in is used
+	Brackets []*WeightBracket `protobuf:"bytes,9,rep,name=brackets" json:"brackets,omitempty"`
+	// shipping is free for orders with items amount of at least freeAbove,
+	// never free if zero
+	FreeAbove int64 `protobuf:"varint,10,opt,name=freeAbove,proto3" json:"freeAbove,omitempty"`
+	Created   int64 `protobuf:"varint,998,opt,name=created,proto3" json:"created,omitempty"`
+	Updated   int64 `protobuf:"varint,999,opt,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (m *ShippingRate) Reset()                    { *m = ShippingRate{} }
+func (m *ShippingRate) String() string            { return proto.CompactTextString(m) }
+func (*ShippingRate) ProtoMessage()               {}
+func (*ShippingRate) Descriptor() ([]byte, []int) { return fileDescriptorShipping, []int{1} }
+
+func (m *ShippingRate) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ShippingRate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ShippingRate) GetCarrier() string {
+	if m != nil {
+		return m.Carrier
+	}
+	return ""
+}
+
+func (m *ShippingRate) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *ShippingRate) GetCountries() []string {
+	if m != nil {
+		return m.Countries
+	}
+	return nil
+}
+
+func (m *ShippingRate) GetPostalPrefixes() []string {
+	if m != nil {
+		return m.PostalPrefixes
+	}
+	return nil
+}
+
+func (m *ShippingRate) GetCurrency() paymentpb.Currency {
+	if m != nil {
+		return m.Currency
+	}
+	return paymentpb.Currency_CUR_RESERVED
+}
+
+func (m *ShippingRate) GetDimDivisor() float64 {
+	if m != nil {
+		return m.DimDivisor
+	}
+	return 0
+}
+
+func (m *ShippingRate) GetBrackets() []*WeightBracket {
+	if m != nil {
+		return m.Brackets
+	}
+	return nil
+}
+
+func (m *ShippingRate) GetFreeAbove() int64 {
+	if m != nil {
+		return m.FreeAbove
+	}
+	return 0
+}
+
+func (m *ShippingRate) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *ShippingRate) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
+}
+
+type WeightBracket struct {
+	MaxWeight float64 `protobuf:"fixed64,1,opt,name=maxWeight,proto3" json:"maxWeight,omitempty" validate:"required,gt=0"`
+	Amount    int64   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty" validate:"omitempty,gte=0"`
+}
+
+func (m *WeightBracket) Reset()                    { *m = WeightBracket{} }
+func (m *WeightBracket) String() string            { return proto.CompactTextString(m) }
+func (*WeightBracket) ProtoMessage()               {}
+func (*WeightBracket) Descriptor() ([]byte, []int) { return fileDescriptorShipping, []int{2} }
+
+func (m *WeightBracket) GetMaxWeight() float64 {
+	if m != nil {
+		return m.MaxWeight
+	}
+	return 0
+}
+
+func (m *WeightBracket) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type ShippingRateList struct {
+	Rates []*ShippingRate `protobuf:"bytes,1,rep,name=rates" json:"rates,omitempty"`
+	Total int32           `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *ShippingRateList) Reset()                    { *m = ShippingRateList{} }
+func (m *ShippingRateList) String() string            { return proto.CompactTextString(m) }
+func (*ShippingRateList) ProtoMessage()               {}
+func (*ShippingRateList) Descriptor() ([]byte, []int) { return fileDescriptorShipping, []int{3} }
+
+func (m *ShippingRateList) GetRates() []*ShippingRate {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+func (m *ShippingRateList) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type NewRequest struct {
+	Name           string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" validate:"required,gte=1,lte=64"`
+	Carrier        string             `protobuf:"bytes,2,opt,name=carrier,proto3" json:"carrier,omitempty" validate:"required,gte=1,lte=64"`
+	Active         bool               `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	Countries      []string           `protobuf:"bytes,4,rep,name=countries" json:"countries,omitempty" validate:"dive,required"`
+	PostalPrefixes []string           `protobuf:"bytes,5,rep,name=postalPrefixes" json:"postalPrefixes,omitempty" validate:"dive,required"`
+	Currency       paymentpb.Currency `protobuf:"varint,6,opt,name=currency,proto3,enum=paymentpb.Currency" json:"currency,omitempty" validate:"required,gte=1,lte=128"`
+	DimDivisor     float64            `protobuf:"fixed64,7,opt,name=dimDivisor,proto3" json:"dimDivisor,omitempty" validate:"omitempty,gt=0"`
+	Brackets       []*WeightBracket   `protobuf:"bytes,8,rep,name=brackets" json:"brackets,omitempty" validate:"required,dive,required"`
+	FreeAbove      int64              `protobuf:"varint,9,opt,name=freeAbove,proto3" json:"freeAbove,omitempty" validate:"omitempty,gte=0"`
+}
+
+func (m *NewRequest) Reset()                    { *m = NewRequest{} }
+func (m *NewRequest) String() string            { return proto.CompactTextString(m) }
+func (*NewRequest) ProtoMessage()               {}
+func (*NewRequest) Descriptor() ([]byte, []int) { return fileDescriptorShipping, []int{4} }
+
+func (m *NewRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NewRequest) GetCarrier() string {
+	if m != nil {
+		return m.Carrier
+	}
+	return ""
+}
+
+func (m *NewRequest) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *NewRequest) GetCountries() []string {
+	if m != nil {
+		return m.Countries
+	}
+	return nil
+}
+
+func (m *NewRequest) GetPostalPrefixes() []string {
+	if m != nil {
+		return m.PostalPrefixes
+	}
+	return nil
+}
+
+func (m *NewRequest) GetCurrency() paymentpb.Currency {
+	if m != nil {
+		return m.Currency
+	}
+	return paymentpb.Currency_CUR_RESERVED
+}
+
+func (m *NewRequest) GetDimDivisor() float64 {
+	if m != nil {
+		return m.DimDivisor
+	}
+	return 0
+}
+
+func (m *NewRequest) GetBrackets() []*WeightBracket {
+	if m != nil {
+		return m.Brackets
+	}
+	return nil
+}
+
+func (m *NewRequest) GetFreeAbove() int64 {
+	if m != nil {
+		return m.FreeAbove
+	}
+	return 0
+}
+
+type GetRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,uuid4"`
+}
+
+func (m *GetRequest) Reset()                    { *m = GetRequest{} }
+func (m *GetRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()               {}
+func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptorShipping, []int{5} }
+
+func (m *GetRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type DeleteRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,uuid4"`
+}
+
+func (m *DeleteRequest) Reset()                    { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()               {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorShipping, []int{6} }
+
+func (m *DeleteRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type UpdateRequest struct {
+	Id             string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,uuid4"`
+	Name           string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" validate:"omitempty,gte=1,lte=64"`
+	Carrier        string           `protobuf:"bytes,3,opt,name=carrier,proto3" json:"carrier,omitempty" validate:"omitempty,gte=1,lte=64"`
+	Active         bool             `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Countries      []string         `protobuf:"bytes,5,rep,name=countries" json:"countries,omitempty" validate:"dive,required"`
+	PostalPrefixes []string         `protobuf:"bytes,6,rep,name=postalPrefixes" json:"postalPrefixes,omitempty" validate:"dive,required"`
+	DimDivisor     float64          `protobuf:"fixed64,7,opt,name=dimDivisor,proto3" json:"dimDivisor,omitempty" validate:"omitempty,gt=0"`
+	Brackets       []*WeightBracket `protobuf:"bytes,8,rep,name=brackets" json:"brackets,omitempty" validate:"dive,required"`
+	FreeAbove      int64            `protobuf:"varint,9,opt,name=freeAbove,proto3" json:"freeAbove,omitempty" validate:"omitempty,gte=0"`
+}
+
+func (m *UpdateRequest) Reset()                    { *m = UpdateRequest{} }
+func (m *UpdateRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateRequest) ProtoMessage()               {}
+func (*UpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorShipping, []int{7} }
+
+func (m *UpdateRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateRequest) GetCarrier() string {
+	if m != nil {
+		return m.Carrier
+	}
+	return ""
+}
+
+func (m *UpdateRequest) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *UpdateRequest) GetCountries() []string {
+	if m != nil {
+		return m.Countries
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetPostalPrefixes() []string {
+	if m != nil {
+		return m.PostalPrefixes
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetDimDivisor() float64 {
+	if m != nil {
+		return m.DimDivisor
+	}
+	return 0
+}
+
+func (m *UpdateRequest) GetBrackets() []*WeightBracket {
+	if m != nil {
+		return m.Brackets
+	}
+	return nil
+}
+
+func (m *UpdateRequest) GetFreeAbove() int64 {
+	if m != nil {
+		return m.FreeAbove
+	}
+	return 0
+}
+
+type ListRequest struct {
+	Page  int64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *ListRequest) Reset()                    { *m = ListRequest{} }
+func (m *ListRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListRequest) ProtoMessage()               {}
+func (*ListRequest) Descriptor() ([]byte, []int) { return fileDescriptorShipping, []int{8} }
+
+func (m *ListRequest) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *ListRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Empty)(nil), "shippingpb.Empty")
+	proto.RegisterType((*ShippingRate)(nil), "shippingpb.ShippingRate")
+	proto.RegisterType((*WeightBracket)(nil), "shippingpb.WeightBracket")
+	proto.RegisterType((*ShippingRateList)(nil), "shippingpb.ShippingRateList")
+	proto.RegisterType((*NewRequest)(nil), "shippingpb.NewRequest")
+	proto.RegisterType((*GetRequest)(nil), "shippingpb.GetRequest")
+	proto.RegisterType((*DeleteRequest)(nil), "shippingpb.DeleteRequest")
+	proto.RegisterType((*UpdateRequest)(nil), "shippingpb.UpdateRequest")
+	proto.RegisterType((*ListRequest)(nil), "shippingpb.ListRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ShippingService service
+
+type ShippingServiceClient interface {
+	New(ctx context.Context, in *NewRequest, opts ...grpc.CallOption) (*ShippingRate, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*ShippingRate, error)
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*ShippingRate, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*Empty, error)
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ShippingRateList, error)
+}
+
+type shippingServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewShippingServiceClient(cc *grpc.ClientConn) ShippingServiceClient {
+	return &shippingServiceClient{cc}
+}
+
+func (c *shippingServiceClient) New(ctx context.Context, in *NewRequest, opts ...grpc.CallOption) (*ShippingRate, error) {
+	out := new(ShippingRate)
+	err := grpc.Invoke(ctx, "/shippingpb.ShippingService/New", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*ShippingRate, error) {
+	out := new(ShippingRate)
+	err := grpc.Invoke(ctx, "/shippingpb.ShippingService/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*ShippingRate, error) {
+	out := new(ShippingRate)
+	err := grpc.Invoke(ctx, "/shippingpb.ShippingService/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/shippingpb.ShippingService/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shippingServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ShippingRateList, error) {
+	out := new(ShippingRateList)
+	err := grpc.Invoke(ctx, "/shippingpb.ShippingService/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ShippingService service
+
+type ShippingServiceServer interface {
+	New(context.Context, *NewRequest) (*ShippingRate, error)
+	Get(context.Context, *GetRequest) (*ShippingRate, error)
+	Update(context.Context, *UpdateRequest) (*ShippingRate, error)
+	Delete(context.Context, *DeleteRequest) (*Empty, error)
+	List(context.Context, *ListRequest) (*ShippingRateList, error)
+}
+
+func RegisterShippingServiceServer(s *grpc.Server, srv ShippingServiceServer) {
+	s.RegisterService(&_ShippingService_serviceDesc, srv)
+}
+
+func _ShippingService_New_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).New(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shippingpb.ShippingService/New",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).New(ctx, req.(*NewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShippingService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shippingpb.ShippingService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShippingService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shippingpb.ShippingService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShippingService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shippingpb.ShippingService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShippingService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shippingpb.ShippingService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ShippingService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "shippingpb.ShippingService",
+	HandlerType: (*ShippingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "New",
+			Handler:    _ShippingService_New_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ShippingService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ShippingService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ShippingService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ShippingService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "shipping/shippingpb/shipping.proto",
+}
+
+func (m *Empty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ShippingRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShippingRate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Carrier) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(len(m.Carrier)))
+		i += copy(dAtA[i:], m.Carrier)
+	}
+	if m.Active {
+		dAtA[i] = 0x20
+		i++
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Countries) > 0 {
+		for _, s := range m.Countries {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.PostalPrefixes) > 0 {
+		for _, s := range m.PostalPrefixes {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Currency != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(m.Currency))
+	}
+	if m.DimDivisor != 0 {
+		dAtA[i] = 0x41
+		i++
+		i = encodeFixed64Shipping(dAtA, i, uint64(math.Float64bits(float64(m.DimDivisor))))
+	}
+	if len(m.Brackets) > 0 {
+		for _, msg := range m.Brackets {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintShipping(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.FreeAbove != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(m.FreeAbove))
+	}
+	if m.Created != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(m.Created))
+	}
+	if m.Updated != 0 {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(m.Updated))
+	}
+	return i, nil
+}
+
+func (m *WeightBracket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightBracket) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MaxWeight != 0 {
+		dAtA[i] = 0x9
+		i++
+		i = encodeFixed64Shipping(dAtA, i, uint64(math.Float64bits(float64(m.MaxWeight))))
+	}
+	if m.Amount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(m.Amount))
+	}
+	return i, nil
+}
+
+func (m *ShippingRateList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShippingRateList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rates) > 0 {
+		for _, msg := range m.Rates {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintShipping(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Total != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(m.Total))
+	}
+	return i, nil
+}
+
+func (m *NewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NewRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Carrier) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(len(m.Carrier)))
+		i += copy(dAtA[i:], m.Carrier)
+	}
+	if m.Active {
+		dAtA[i] = 0x18
+		i++
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Countries) > 0 {
+		for _, s := range m.Countries {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.PostalPrefixes) > 0 {
+		for _, s := range m.PostalPrefixes {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Currency != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(m.Currency))
+	}
+	if m.DimDivisor != 0 {
+		dAtA[i] = 0x39
+		i++
+		i = encodeFixed64Shipping(dAtA, i, uint64(math.Float64bits(float64(m.DimDivisor))))
+	}
+	if len(m.Brackets) > 0 {
+		for _, msg := range m.Brackets {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintShipping(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.FreeAbove != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(m.FreeAbove))
+	}
+	return i, nil
+}
+
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Carrier) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(len(m.Carrier)))
+		i += copy(dAtA[i:], m.Carrier)
+	}
+	if m.Active {
+		dAtA[i] = 0x20
+		i++
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Countries) > 0 {
+		for _, s := range m.Countries {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.PostalPrefixes) > 0 {
+		for _, s := range m.PostalPrefixes {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.DimDivisor != 0 {
+		dAtA[i] = 0x39
+		i++
+		i = encodeFixed64Shipping(dAtA, i, uint64(math.Float64bits(float64(m.DimDivisor))))
+	}
+	if len(m.Brackets) > 0 {
+		for _, msg := range m.Brackets {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintShipping(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.FreeAbove != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(m.FreeAbove))
+	}
+	return i, nil
+}
+
+func (m *ListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Page != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintShipping(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func encodeFixed64Shipping(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Shipping(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintShipping(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Empty) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ShippingRate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovShipping(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovShipping(uint64(l))
+	}
+	l = len(m.Carrier)
+	if l > 0 {
+		n += 1 + l + sovShipping(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	if len(m.Countries) > 0 {
+		for _, s := range m.Countries {
+			l = len(s)
+			n += 1 + l + sovShipping(uint64(l))
+		}
+	}
+	if len(m.PostalPrefixes) > 0 {
+		for _, s := range m.PostalPrefixes {
+			l = len(s)
+			n += 1 + l + sovShipping(uint64(l))
+		}
+	}
+	if m.Currency != 0 {
+		n += 1 + sovShipping(uint64(m.Currency))
+	}
+	if m.DimDivisor != 0 {
+		n += 9
+	}
+	if len(m.Brackets) > 0 {
+		for _, e := range m.Brackets {
+			l = e.Size()
+			n += 1 + l + sovShipping(uint64(l))
+		}
+	}
+	if m.FreeAbove != 0 {
+		n += 1 + sovShipping(uint64(m.FreeAbove))
+	}
+	if m.Created != 0 {
+		n += 2 + sovShipping(uint64(m.Created))
+	}
+	if m.Updated != 0 {
+		n += 2 + sovShipping(uint64(m.Updated))
+	}
+	return n
+}
+
+func (m *WeightBracket) Size() (n int) {
+	var l int
+	_ = l
+	if m.MaxWeight != 0 {
+		n += 9
+	}
+	if m.Amount != 0 {
+		n += 1 + sovShipping(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *ShippingRateList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Rates) > 0 {
+		for _, e := range m.Rates {
+			l = e.Size()
+			n += 1 + l + sovShipping(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 1 + sovShipping(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *NewRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovShipping(uint64(l))
+	}
+	l = len(m.Carrier)
+	if l > 0 {
+		n += 1 + l + sovShipping(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	if len(m.Countries) > 0 {
+		for _, s := range m.Countries {
+			l = len(s)
+			n += 1 + l + sovShipping(uint64(l))
+		}
+	}
+	if len(m.PostalPrefixes) > 0 {
+		for _, s := range m.PostalPrefixes {
+			l = len(s)
+			n += 1 + l + sovShipping(uint64(l))
+		}
+	}
+	if m.Currency != 0 {
+		n += 1 + sovShipping(uint64(m.Currency))
+	}
+	if m.DimDivisor != 0 {
+		n += 9
+	}
+	if len(m.Brackets) > 0 {
+		for _, e := range m.Brackets {
+			l = e.Size()
+			n += 1 + l + sovShipping(uint64(l))
+		}
+	}
+	if m.FreeAbove != 0 {
+		n += 1 + sovShipping(uint64(m.FreeAbove))
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovShipping(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovShipping(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovShipping(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovShipping(uint64(l))
+	}
+	l = len(m.Carrier)
+	if l > 0 {
+		n += 1 + l + sovShipping(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	if len(m.Countries) > 0 {
+		for _, s := range m.Countries {
+			l = len(s)
+			n += 1 + l + sovShipping(uint64(l))
+		}
+	}
+	if len(m.PostalPrefixes) > 0 {
+		for _, s := range m.PostalPrefixes {
+			l = len(s)
+			n += 1 + l + sovShipping(uint64(l))
+		}
+	}
+	if m.DimDivisor != 0 {
+		n += 9
+	}
+	if len(m.Brackets) > 0 {
+		for _, e := range m.Brackets {
+			l = e.Size()
+			n += 1 + l + sovShipping(uint64(l))
+		}
+	}
+	if m.FreeAbove != 0 {
+		n += 1 + sovShipping(uint64(m.FreeAbove))
+	}
+	return n
+}
+
+func (m *ListRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovShipping(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovShipping(uint64(m.Limit))
+	}
+	return n
+}
+
+func sovShipping(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozShipping(x uint64) (n int) {
+	return sovShipping(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Empty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShipping
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShipping(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShipping
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShippingRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShipping
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShippingRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShippingRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Carrier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Carrier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Countries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Countries = append(m.Countries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostalPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostalPrefixes = append(m.PostalPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			m.Currency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Currency |= (paymentpb.Currency(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DimDivisor", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.DimDivisor = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Brackets = append(m.Brackets, &WeightBracket{})
+			if err := m.Brackets[len(m.Brackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeAbove", wireType)
+			}
+			m.FreeAbove = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeAbove |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 998:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 999:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			m.Updated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updated |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShipping(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShipping
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightBracket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShipping
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightBracket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightBracket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWeight", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.MaxWeight = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShipping(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShipping
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShippingRateList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShipping
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShippingRateList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShippingRateList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rates = append(m.Rates, &ShippingRate{})
+			if err := m.Rates[len(m.Rates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShipping(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShipping
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShipping
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Carrier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Carrier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Countries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Countries = append(m.Countries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostalPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostalPrefixes = append(m.PostalPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			m.Currency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Currency |= (paymentpb.Currency(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DimDivisor", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.DimDivisor = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Brackets = append(m.Brackets, &WeightBracket{})
+			if err := m.Brackets[len(m.Brackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeAbove", wireType)
+			}
+			m.FreeAbove = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeAbove |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShipping(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShipping
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShipping
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShipping(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShipping
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShipping
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShipping(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShipping
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShipping
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Carrier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Carrier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Countries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Countries = append(m.Countries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostalPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostalPrefixes = append(m.PostalPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DimDivisor", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.DimDivisor = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthShipping
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Brackets = append(m.Brackets, &WeightBracket{})
+			if err := m.Brackets[len(m.Brackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeAbove", wireType)
+			}
+			m.FreeAbove = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreeAbove |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShipping(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShipping
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowShipping
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipShipping(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthShipping
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipShipping(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowShipping
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowShipping
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthShipping
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowShipping
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipShipping(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthShipping = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowShipping   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("shipping/shippingpb/shipping.proto", fileDescriptorShipping) }
+
+var fileDescriptorShipping = []byte{
+	// 873 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xc7, 0xeb, 0x38, 0x9f, 0x67, 0x69, 0x81, 0x01, 0x2d, 0x6e, 0x54, 0x92, 0x30, 0x02, 0x94,
+	0x8b, 0x6c, 0xca, 0x96, 0x65, 0xe9, 0x06, 0xda, 0x15, 0xa1, 0x68, 0x6f, 0xd0, 0x0a, 0xb9, 0xe2,
+	0x43, 0x08, 0x09, 0x39, 0xf6, 0xa9, 0x3b, 0x22, 0x8e, 0xbd, 0xe3, 0x71, 0x76, 0x7b, 0xcd, 0x3b,
+	0x20, 0x9e, 0x80, 0x17, 0xe1, 0x86, 0x4b, 0x24, 0xee, 0x23, 0x54, 0x24, 0xb8, 0xcf, 0x13, 0x20,
+	0x8f, 0xed, 0x78, 0xec, 0x6d, 0xba, 0xdd, 0xee, 0x5e, 0x65, 0xce, 0x9c, 0x73, 0x66, 0xe6, 0x8c,
+	0x7f, 0xff, 0x93, 0x01, 0x1a, 0x9e, 0xb2, 0x20, 0x60, 0x33, 0x77, 0x37, 0x1b, 0x04, 0x93, 0xd5,
+	0x70, 0x18, 0x70, 0x5f, 0xf8, 0x04, 0x72, 0x57, 0xfb, 0x96, 0xcb, 0xc4, 0x69, 0x34, 0x19, 0xda,
+	0xbe, 0xb7, 0xeb, 0xfa, 0xae, 0xbf, 0x2b, 0x43, 0x26, 0xd1, 0x89, 0xb4, 0xa4, 0x21, 0x47, 0x49,
+	0x6a, 0x7b, 0x5f, 0x09, 0x77, 0x98, 0xeb, 0x0b, 0x2b, 0xfb, 0x09, 0xac, 0x33, 0x0f, 0x67, 0x22,
+	0xfb, 0x0d, 0x26, 0xd9, 0x28, 0xc9, 0xa4, 0x0d, 0xa8, 0x7d, 0xe1, 0x05, 0xe2, 0x8c, 0xfe, 0xa2,
+	0xc3, 0x2b, 0xc7, 0xe9, 0x01, 0x4c, 0x4b, 0x20, 0xe9, 0x40, 0x85, 0x39, 0x86, 0xd6, 0xd3, 0xfa,
+	0xad, 0xf1, 0xd6, 0x72, 0xd1, 0x85, 0x49, 0xe8, 0xcf, 0x46, 0xf4, 0x47, 0xe6, 0x50, 0xb3, 0xc2,
+	0x1c, 0x42, 0xa0, 0x3a, 0xb3, 0x3c, 0x34, 0x2a, 0x71, 0x84, 0x29, 0xc7, 0xc4, 0x80, 0x86, 0x6d,
+	0x71, 0xce, 0x90, 0x1b, 0xba, 0x9c, 0xce, 0x4c, 0x72, 0x13, 0xea, 0x96, 0x2d, 0xd8, 0x1c, 0x8d,
+	0x6a, 0x4f, 0xeb, 0x37, 0xcd, 0xd4, 0x22, 0x3b, 0xd0, 0xb2, 0xfd, 0x68, 0x26, 0x38, 0xc3, 0xd0,
+	0xa8, 0xf5, 0xf4, 0x7e, 0xcb, 0xcc, 0x27, 0xc8, 0xfb, 0xb0, 0x15, 0xf8, 0xa1, 0xb0, 0xa6, 0x5f,
+	0x71, 0x3c, 0x61, 0x4f, 0x30, 0x34, 0xea, 0x32, 0xa4, 0x34, 0x4b, 0x76, 0xa1, 0x69, 0x47, 0x9c,
+	0xe3, 0xcc, 0x3e, 0x33, 0x1a, 0x3d, 0xad, 0xbf, 0xb5, 0xf7, 0xc6, 0x70, 0x55, 0xf1, 0xf0, 0xf3,
+	0xd4, 0x65, 0xae, 0x82, 0x48, 0x07, 0xc0, 0x61, 0xde, 0x11, 0x9b, 0xb3, 0xd0, 0xe7, 0x46, 0xb3,
+	0xa7, 0xf5, 0x35, 0x53, 0x99, 0x21, 0x1f, 0x41, 0x73, 0xc2, 0x2d, 0xfb, 0x27, 0x14, 0xa1, 0xd1,
+	0xea, 0xe9, 0xfd, 0x1b, 0x7b, 0xdb, 0xc3, 0xfc, 0xf3, 0x0c, 0xbf, 0x45, 0xe6, 0x9e, 0x8a, 0x71,
+	0x12, 0x61, 0xae, 0x42, 0xe3, 0x6a, 0x4e, 0x38, 0xe2, 0x67, 0x13, 0x7f, 0x8e, 0x06, 0xf4, 0xb4,
+	0xbe, 0x6e, 0xe6, 0x13, 0x64, 0x1b, 0x1a, 0x36, 0x47, 0x4b, 0xa0, 0x63, 0xfc, 0xdb, 0x90, 0xce,
+	0xcc, 0x8e, 0x5d, 0x51, 0xe0, 0x48, 0xd7, 0x7f, 0xa9, 0x2b, 0xb5, 0xe9, 0xcf, 0x1a, 0x6c, 0x16,
+	0xf6, 0x23, 0x23, 0x68, 0x79, 0xd6, 0x93, 0x64, 0x4e, 0x7e, 0x20, 0x6d, 0xbc, 0xb3, 0x5c, 0x74,
+	0x8d, 0xb9, 0x35, 0x65, 0x71, 0xce, 0x88, 0x72, 0x7c, 0x14, 0x31, 0x8e, 0xce, 0xc0, 0x15, 0x07,
+	0x1f, 0x50, 0x33, 0x0f, 0x27, 0x77, 0xa1, 0x6e, 0x79, 0xf1, 0xfd, 0xca, 0xef, 0xa6, 0x8f, 0x3b,
+	0xcb, 0x45, 0xb7, 0x9d, 0x27, 0xfa, 0x1e, 0x13, 0x18, 0xf3, 0x30, 0x70, 0x05, 0xc6, 0xa9, 0x69,
+	0x34, 0xfd, 0x0e, 0x5e, 0x53, 0xe9, 0xf8, 0x92, 0x85, 0x82, 0x0c, 0xa1, 0xc6, 0x2d, 0x81, 0xa1,
+	0xa1, 0xc9, 0x1b, 0x32, 0xd4, 0x1b, 0x52, 0x83, 0xcd, 0x24, 0x8c, 0xbc, 0x09, 0x35, 0xe1, 0x0b,
+	0x6b, 0x2a, 0xb7, 0xae, 0x99, 0x89, 0x41, 0xff, 0xaa, 0x02, 0x3c, 0xc4, 0xc7, 0x26, 0x3e, 0x8a,
+	0x30, 0x14, 0x64, 0x3f, 0xc5, 0x2a, 0x01, 0xef, 0xdd, 0xe5, 0xa2, 0xdb, 0xbb, 0xb0, 0x2e, 0x3c,
+	0xb8, 0x3d, 0x98, 0x0a, 0x3c, 0xb8, 0x7b, 0x87, 0xa6, 0xf0, 0x1d, 0xe6, 0xf0, 0x55, 0x9e, 0x23,
+	0xf9, 0x02, 0x44, 0xf5, 0x02, 0xa2, 0x23, 0x15, 0xd1, 0x6a, 0xcc, 0x5f, 0xf9, 0xba, 0x1d, 0x36,
+	0xc7, 0x41, 0xb6, 0x3c, 0x55, 0x01, 0x3e, 0x7a, 0x0a, 0xe0, 0xda, 0x15, 0x16, 0x28, 0xe3, 0xfd,
+	0x8d, 0x82, 0x77, 0x7d, 0x2d, 0xde, 0xe3, 0xf7, 0x96, 0x8b, 0xee, 0x3b, 0x97, 0xd6, 0x7b, 0x7b,
+	0x6f, 0x9f, 0x2a, 0x2a, 0x38, 0x28, 0xa8, 0xa0, 0x21, 0x49, 0x7a, 0x7b, 0xb9, 0xe8, 0x6e, 0x5f,
+	0x0c, 0x44, 0xcc, 0x83, 0x2a, 0x92, 0x1f, 0x14, 0x91, 0x34, 0x9f, 0x21, 0x92, 0xb5, 0x87, 0x2b,
+	0x95, 0x9e, 0x6b, 0xe9, 0x53, 0x55, 0x4b, 0xad, 0x2b, 0xc1, 0x9a, 0x27, 0xd0, 0x4f, 0x00, 0x1e,
+	0xa0, 0xc8, 0xa0, 0xba, 0xa5, 0xf4, 0xb2, 0x52, 0x81, 0xab, 0x83, 0x44, 0x11, 0x73, 0xee, 0xc8,
+	0xd6, 0x46, 0x0f, 0x61, 0xf3, 0x08, 0xa7, 0x28, 0xf0, 0x9a, 0xf9, 0xbf, 0x55, 0x61, 0xf3, 0x6b,
+	0x29, 0xdf, 0xeb, 0x2d, 0x40, 0xee, 0xa9, 0xbd, 0xb5, 0x7c, 0x75, 0xc5, 0xb2, 0xcb, 0x2a, 0xb8,
+	0x5f, 0x6a, 0xc1, 0x57, 0xcd, 0x7e, 0x66, 0xa7, 0x1e, 0x3d, 0xd5, 0xa9, 0x5f, 0x44, 0x06, 0xf5,
+	0x6b, 0xc8, 0xe0, 0x05, 0x71, 0x3d, 0x7e, 0x1e, 0x5c, 0x2f, 0x3f, 0xd9, 0xcb, 0xa2, 0xf4, 0x63,
+	0xb8, 0x11, 0x77, 0xd2, 0x8c, 0x12, 0x02, 0xd5, 0xc0, 0x72, 0x93, 0xde, 0xa7, 0x9b, 0x72, 0x1c,
+	0x37, 0xcd, 0x29, 0xf3, 0x58, 0xda, 0xaf, 0xcd, 0xc4, 0xd8, 0xfb, 0xbd, 0x02, 0xaf, 0x66, 0x2d,
+	0xf6, 0x18, 0xf9, 0x9c, 0xd9, 0x48, 0xee, 0x81, 0xfe, 0x10, 0x1f, 0x93, 0x9b, 0x6a, 0x51, 0x79,
+	0x63, 0x6d, 0xaf, 0x6d, 0xcf, 0x74, 0x23, 0x4e, 0x7d, 0x80, 0xa2, 0x98, 0x9a, 0xcb, 0xe7, 0xd2,
+	0xd4, 0xfb, 0x50, 0x4f, 0x50, 0x27, 0x85, 0xdb, 0x2c, 0xe0, 0x7f, 0xe9, 0x02, 0xfb, 0x50, 0x4f,
+	0xc4, 0x56, 0x5c, 0xa0, 0x20, 0xc0, 0xf6, 0xeb, 0xaa, 0x2b, 0x79, 0xb0, 0xc4, 0x5b, 0x57, 0xe5,
+	0xff, 0xd0, 0x5b, 0xaa, 0x53, 0xb9, 0xcf, 0xf6, 0xce, 0xba, 0x6d, 0xe3, 0x20, 0xba, 0x31, 0x3e,
+	0xfc, 0xe3, 0xbc, 0xa3, 0xfd, 0x79, 0xde, 0xd1, 0xfe, 0x3e, 0xef, 0x68, 0xbf, 0xfe, 0xd3, 0xd9,
+	0xf8, 0x7e, 0xb0, 0xf6, 0x21, 0x75, 0xc1, 0x03, 0x6e, 0x52, 0x97, 0x6f, 0xa8, 0x0f, 0xff, 0x1f,
+	0x00, 0x55, 0x3f, 0x97, 0xf4, 0xde, 0x09, 0x00, 0x00,
+}
@@ -0,0 +1,60 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sku
+
+import (
+	"time"
+
+	"github.com/digota/digota/sku/skupb"
+)
+
+// Available returns the quantity of inv that is not held by the unexpired
+// reservations of orders other than order
+func Available(inv *skupb.Inventory, order string, now time.Time) int64 {
+	available := inv.GetQuantity()
+	for _, r := range inv.GetReservations() {
+		if r.GetOrder() != order && r.GetExpires() > now.Unix() {
+			available -= r.GetQuantity()
+		}
+	}
+	return available
+}
+
+// Reserve replaces the reservation of order in inv with quantity till
+// expires, zero quantity releases it. expired reservations are dropped.
+func Reserve(inv *skupb.Inventory, order string, quantity int64, expires int64, now time.Time) {
+	var reservations []*skupb.Reservation
+	for _, r := range inv.GetReservations() {
+		if r.GetOrder() != order && r.GetExpires() > now.Unix() {
+			reservations = append(reservations, r)
+		}
+	}
+	if quantity > 0 {
+		reservations = append(reservations, &skupb.Reservation{
+			Order:    order,
+			Quantity: quantity,
+			Expires:  expires,
+		})
+	}
+	inv.Reservations = reservations
+}
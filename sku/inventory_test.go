@@ -0,0 +1,86 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sku
+
+import (
+	"testing"
+	"time"
+
+	"github.com/digota/digota/sku/skupb"
+)
+
+func TestAvailable(t *testing.T) {
+	now := time.Now()
+	inv := &skupb.Inventory{
+		Type:     skupb.Inventory_Finite,
+		Quantity: 10,
+		Reservations: []*skupb.Reservation{
+			{Order: "1", Quantity: 2, Expires: now.Add(time.Minute).Unix()},
+			{Order: "2", Quantity: 3, Expires: now.Add(time.Minute).Unix()},
+			// expired
+			{Order: "3", Quantity: 4, Expires: now.Add(-time.Minute).Unix()},
+		},
+	}
+	if n := Available(inv, "", now); n != 5 {
+		t.Fatal(n)
+	}
+	// own reservation is available
+	if n := Available(inv, "1", now); n != 7 {
+		t.Fatal(n)
+	}
+	if n := Available(nil, "", now); n != 0 {
+		t.Fatal(n)
+	}
+}
+
+func TestReserve(t *testing.T) {
+	now := time.Now()
+	expires := now.Add(time.Minute).Unix()
+	inv := &skupb.Inventory{
+		Type:     skupb.Inventory_Finite,
+		Quantity: 10,
+		Reservations: []*skupb.Reservation{
+			{Order: "1", Quantity: 2, Expires: expires},
+			// expired
+			{Order: "3", Quantity: 4, Expires: now.Add(-time.Minute).Unix()},
+		},
+	}
+
+	// replace
+	Reserve(inv, "1", 5, expires, now)
+	if len(inv.Reservations) != 1 || inv.Reservations[0].Quantity != 5 || inv.Reservations[0].Order != "1" {
+		t.Fatal(inv.Reservations)
+	}
+
+	// add
+	Reserve(inv, "2", 1, expires, now)
+	if Available(inv, "", now) != 4 {
+		t.Fatal(inv.Reservations)
+	}
+
+	// release
+	Reserve(inv, "1", 0, 0, now)
+	if len(inv.Reservations) != 1 || inv.Reservations[0].Order != "2" {
+		t.Fatal(inv.Reservations)
+	}
+}
@@ -49,6 +49,7 @@ func (s *skus) GetNamespace() string { return ns }
 
 type sku struct {
 	skupb.Sku `bson:",inline"`
+	fence     int64
 }
 
 func (s *sku) GetNamespace() string { return ns }
@@ -59,6 +60,10 @@ func (s *sku) SetCreated(t int64) { s.Created = t }
 
 func (s *sku) SetUpdated(t int64) { s.Updated = t }
 
+func (s *sku) SetFence(t int64) { s.fence = t }
+
+func (s *sku) GetFence() int64 { return s.fence }
+
 // service implementations
 
 type skuService struct{}
@@ -98,6 +103,11 @@ func (s *skuService) New(ctx context.Context, req *skupb.NewRequest) (*skupb.Sku
 		},
 	}
 
+	// reservations are owned by orders
+	if item.Inventory != nil {
+		item.Inventory.Reservations = nil
+	}
+
 	return &item.Sku, storage.Handler().Insert(item)
 
 }
@@ -117,7 +127,7 @@ func (s *skuService) Get(ctx context.Context, req *skupb.GetRequest) (*skupb.Sku
 	}
 
 	// acquire lock
-	unlock, err := locker.Handler().TryLock(item, time.Second)
+	unlock, err := locker.Handler().TryLockContext(ctx, item, time.Second)
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +153,7 @@ func (s *skuService) Update(ctx context.Context, req *skupb.UpdateRequest) (*sku
 	}
 
 	// acquire lock
-	unlock, err := locker.Handler().TryLock(item, time.Second)
+	unlock, err := locker.Handler().TryLockContext(ctx, item, time.Second)
 	if err != nil {
 		return nil, err
 	}
@@ -213,6 +223,11 @@ func (s *skuService) Update(ctx context.Context, req *skupb.UpdateRequest) (*sku
 	}
 
 	if x := req.GetInventory(); x != nil {
+		// keep the reservations of unpaid orders, clients can't set them
+		x.Reservations = item.GetInventory().GetReservations()
+		if len(x.Reservations) == 0 {
+			x.Reservations = nil
+		}
 		item.Inventory = x
 	}
 
@@ -232,7 +247,7 @@ func (s *skuService) Delete(ctx context.Context, req *skupb.DeleteRequest) (*sku
 		},
 	}
 
-	unlock, err := locker.Handler().TryLock(item, time.Second)
+	unlock, err := locker.Handler().TryLockContext(ctx, item, time.Second)
 	if err != nil {
 		return nil, err
 	}
@@ -258,12 +273,13 @@ func (s *skuService) GetWithInventoryLock(ctx context.Context, req *skuInterface
 	//	Sku: item,
 	//}
 
-	unlock, err := locker.Handler().TryLock(item, req.Duration)
+	unlock, err := locker.Handler().TryLockContext(ctx, item, req.Duration)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
 	if err := storage.Handler().One(item); err != nil {
+		unlock()
 		return nil, nil, nil, err
 	}
 
@@ -385,6 +385,8 @@ func TestSKUService_Update(t *testing.T) {
 		Inventory: &skupb.Inventory{
 			Type:     skupb.Inventory_Finite,
 			Quantity: 20,
+			// reservations are not taken from clients
+			Reservations: []*skupb.Reservation{{Order: "fake", Quantity: 20, Expires: time.Now().Add(time.Hour).Unix()}},
 		},
 		Image: "http://image.com/image.png",
 	})
@@ -496,7 +498,7 @@ func TestSKUService_Delete(t *testing.T) {
 
 	func() {
 		// lock fail
-		unlock, err := locker.Handler().Lock(&sku{skupb.Sku{Id: sku0.GetId()}})
+		unlock, err := locker.Handler().Lock(&sku{Sku: skupb.Sku{Id: sku0.GetId()}})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -577,7 +579,7 @@ func TestSKUService_GetWithInventoryLock(t *testing.T) {
 
 	func() {
 		// lock fail
-		unlock, err := locker.Handler().Lock(&sku{skupb.Sku{Id: skuItem.GetId()}})
+		unlock, err := locker.Handler().Lock(&sku{Sku: skupb.Sku{Id: skuItem.GetId()}})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -11,6 +11,7 @@
 		Empty
 		Sku
 		Inventory
+		Reservation
 		PackageDimensions
 		NewRequest
 		GetRequest
@@ -94,7 +95,7 @@ var ListRequest_Sort_value = map[string]int32{
 func (x ListRequest_Sort) String() string {
 	return proto.EnumName(ListRequest_Sort_name, int32(x))
 }
-func (ListRequest_Sort) EnumDescriptor() ([]byte, []int) { return fileDescriptorSku, []int{9, 0} }
+func (ListRequest_Sort) EnumDescriptor() ([]byte, []int) { return fileDescriptorSku, []int{10, 0} }
 
 type Empty struct {
 }
@@ -219,6 +220,8 @@ func (m *Sku) GetUpdated() int64 {
 type Inventory struct {
 	Quantity int64          `protobuf:"varint,1,opt,name=quantity,proto3" json:"quantity,omitempty" validate:"omitempty,gte=0"`
 	Type     Inventory_Type `protobuf:"varint,2,opt,name=type,proto3,enum=skupb.Inventory_Type" json:"type,omitempty" validate:"omitempty,required,gte=0,lte=1"`
+	// stock held by unpaid orders, managed by the order service
+	Reservations []*Reservation `protobuf:"bytes,3,rep,name=reservations" json:"reservations,omitempty"`
 }
 
 func (m *Inventory) Reset()                    { *m = Inventory{} }
@@ -240,6 +243,45 @@ func (m *Inventory) GetType() Inventory_Type {
 	return Inventory_Infinite
 }
 
+func (m *Inventory) GetReservations() []*Reservation {
+	if m != nil {
+		return m.Reservations
+	}
+	return nil
+}
+
+type Reservation struct {
+	Order    string `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Quantity int64  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Expires  int64  `protobuf:"varint,3,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (m *Reservation) Reset()                    { *m = Reservation{} }
+func (m *Reservation) String() string            { return proto.CompactTextString(m) }
+func (*Reservation) ProtoMessage()               {}
+func (*Reservation) Descriptor() ([]byte, []int) { return fileDescriptorSku, []int{3} }
+
+func (m *Reservation) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+func (m *Reservation) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *Reservation) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
 type PackageDimensions struct {
 	Height float64 `protobuf:"fixed64,1,opt,name=height,proto3" json:"height,omitempty" validate:"required,gt=0"`
 	Length float64 `protobuf:"fixed64,2,opt,name=length,proto3" json:"length,omitempty" validate:"required,gt=0"`
@@ -250,7 +292,7 @@ type PackageDimensions struct {
 func (m *PackageDimensions) Reset()                    { *m = PackageDimensions{} }
 func (m *PackageDimensions) String() string            { return proto.CompactTextString(m) }
 func (*PackageDimensions) ProtoMessage()               {}
-func (*PackageDimensions) Descriptor() ([]byte, []int) { return fileDescriptorSku, []int{3} }
+func (*PackageDimensions) Descriptor() ([]byte, []int) { return fileDescriptorSku, []int{4} }
 
 func (m *PackageDimensions) GetHeight() float64 {
 	if m != nil {
@@ -296,7 +338,7 @@ type NewRequest struct {
 func (m *NewRequest) Reset()                    { *m = NewRequest{} }
 func (m *NewRequest) String() string            { return proto.CompactTextString(m) }
 func (*NewRequest) ProtoMessage()               {}
-func (*NewRequest) Descriptor() ([]byte, []int) { return fileDescriptorSku, []int{4} }
+func (*NewRequest) Descriptor() ([]byte, []int) { return fileDescriptorSku, []int{5} }
 
 func (m *NewRequest) GetName() string {
 	if m != nil {
@@ -375,7 +417,7 @@ type GetRequest struct {
 func (m *GetRequest) Reset()                    { *m = GetRequest{} }
 func (m *GetRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()               {}
-func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptorSku, []int{5} }
+func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptorSku, []int{6} }
 
 func (m *GetRequest) GetId() string {
 	if m != nil {
@@ -391,7 +433,7 @@ type DeleteRequest struct {
 func (m *DeleteRequest) Reset()                    { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()               {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorSku, []int{6} }
+func (*DeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorSku, []int{7} }
 
 func (m *DeleteRequest) GetId() string {
 	if m != nil {
@@ -417,7 +459,7 @@ type UpdateRequest struct {
 func (m *UpdateRequest) Reset()                    { *m = UpdateRequest{} }
 func (m *UpdateRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateRequest) ProtoMessage()               {}
-func (*UpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorSku, []int{7} }
+func (*UpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorSku, []int{8} }
 
 func (m *UpdateRequest) GetId() string {
 	if m != nil {
@@ -504,7 +546,7 @@ type SkuList struct {
 func (m *SkuList) Reset()                    { *m = SkuList{} }
 func (m *SkuList) String() string            { return proto.CompactTextString(m) }
 func (*SkuList) ProtoMessage()               {}
-func (*SkuList) Descriptor() ([]byte, []int) { return fileDescriptorSku, []int{8} }
+func (*SkuList) Descriptor() ([]byte, []int) { return fileDescriptorSku, []int{9} }
 
 func (m *SkuList) GetOrders() []*Sku {
 	if m != nil {
@@ -529,7 +571,7 @@ type ListRequest struct {
 func (m *ListRequest) Reset()                    { *m = ListRequest{} }
 func (m *ListRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListRequest) ProtoMessage()               {}
-func (*ListRequest) Descriptor() ([]byte, []int) { return fileDescriptorSku, []int{9} }
+func (*ListRequest) Descriptor() ([]byte, []int) { return fileDescriptorSku, []int{10} }
 
 func (m *ListRequest) GetPage() int64 {
 	if m != nil {
@@ -556,6 +598,7 @@ func init() {
 	proto.RegisterType((*Empty)(nil), "skupb.Empty")
 	proto.RegisterType((*Sku)(nil), "skupb.Sku")
 	proto.RegisterType((*Inventory)(nil), "skupb.Inventory")
+	proto.RegisterType((*Reservation)(nil), "skupb.Reservation")
 	proto.RegisterType((*PackageDimensions)(nil), "skupb.PackageDimensions")
 	proto.RegisterType((*NewRequest)(nil), "skupb.NewRequest")
 	proto.RegisterType((*GetRequest)(nil), "skupb.GetRequest")
@@ -944,6 +987,52 @@ func (m *Inventory) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintSku(dAtA, i, uint64(m.Type))
 	}
+	if len(m.Reservations) > 0 {
+		for _, msg := range m.Reservations {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintSku(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Reservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reservation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Order) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSku(dAtA, i, uint64(len(m.Order)))
+		i += copy(dAtA[i:], m.Order)
+	}
+	if m.Quantity != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSku(dAtA, i, uint64(m.Quantity))
+	}
+	if m.Expires != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintSku(dAtA, i, uint64(m.Expires))
+	}
 	return i, nil
 }
 
@@ -1430,6 +1519,28 @@ func (m *Inventory) Size() (n int) {
 	if m.Type != 0 {
 		n += 1 + sovSku(uint64(m.Type))
 	}
+	if len(m.Reservations) > 0 {
+		for _, e := range m.Reservations {
+			l = e.Size()
+			n += 1 + l + sovSku(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Reservation) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Order)
+	if l > 0 {
+		n += 1 + l + sovSku(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovSku(uint64(m.Quantity))
+	}
+	if m.Expires != 0 {
+		n += 1 + sovSku(uint64(m.Expires))
+	}
 	return n
 }
 
@@ -2297,6 +2408,154 @@ func (m *Inventory) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reservations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSku
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSku
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reservations = append(m.Reservations, &Reservation{})
+			if err := m.Reservations[len(m.Reservations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSku(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSku
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Reservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSku
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSku
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSku
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Order = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSku
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			m.Expires = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSku
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expires |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSku(dAtA[iNdEx:])
@@ -3928,80 +4187,84 @@ var (
 func init() { proto.RegisterFile("sku/skupb/sku.proto", fileDescriptorSku) }
 
 var fileDescriptorSku = []byte{
-	// 1194 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xdd, 0x8e, 0xda, 0x46,
-	0x14, 0x5e, 0x83, 0xf9, 0x3b, 0x24, 0x84, 0x4c, 0xd2, 0xc6, 0x41, 0x2d, 0xd0, 0x69, 0x95, 0x52,
-	0x35, 0x81, 0x84, 0xd0, 0x2a, 0xda, 0xed, 0x46, 0x5a, 0x76, 0x93, 0x28, 0x52, 0xb2, 0xaa, 0x4c,
-	0x23, 0x55, 0xb9, 0x69, 0x0d, 0x9e, 0xb0, 0x23, 0xc0, 0xf6, 0xda, 0x63, 0x56, 0xbc, 0x49, 0xde,
-	0xa5, 0x97, 0x55, 0xa5, 0x5e, 0xf6, 0x09, 0x50, 0xb5, 0x95, 0xfa, 0x73, 0xcb, 0x13, 0x54, 0x33,
-	0x63, 0x1b, 0xf3, 0xb7, 0x24, 0x51, 0xab, 0xf6, 0x06, 0x3c, 0x33, 0xe7, 0x3b, 0x67, 0xce, 0x99,
-	0xf3, 0x7d, 0x1e, 0xc3, 0x35, 0x6f, 0xe0, 0x37, 0xbc, 0x81, 0xef, 0x74, 0xf9, 0x6f, 0xdd, 0x71,
-	0x6d, 0x66, 0xa3, 0x94, 0x98, 0x28, 0xdd, 0xe9, 0x53, 0x76, 0xe2, 0x77, 0xeb, 0x3d, 0x7b, 0xd4,
-	0xe8, 0xdb, 0x7d, 0xbb, 0x21, 0x56, 0xbb, 0xfe, 0x2b, 0x31, 0x12, 0x03, 0xf1, 0x24, 0x51, 0xa5,
-	0x07, 0x31, 0x73, 0x93, 0xf6, 0x6d, 0x66, 0x84, 0x7f, 0x8e, 0x31, 0x19, 0x11, 0x8b, 0x85, 0xff,
-	0x4e, 0x37, 0x7c, 0x92, 0x48, 0x9c, 0x81, 0xd4, 0xa3, 0x91, 0xc3, 0x26, 0xf8, 0x5c, 0x85, 0x64,
-	0x67, 0xe0, 0xa3, 0x32, 0x24, 0xa8, 0xa9, 0x29, 0x55, 0xa5, 0x96, 0x6b, 0x17, 0x66, 0xd3, 0x0a,
-	0x74, 0x3d, 0xdb, 0xda, 0xc5, 0xdf, 0x51, 0x13, 0xeb, 0x09, 0x6a, 0x22, 0x04, 0xaa, 0x65, 0x8c,
-	0x88, 0x96, 0xe0, 0x16, 0xba, 0x78, 0x46, 0xd7, 0x21, 0xe5, 0xb8, 0xb4, 0x47, 0xb4, 0x64, 0x55,
-	0xa9, 0xa9, 0xba, 0x1c, 0xa0, 0x06, 0x64, 0x7b, 0xbe, 0xeb, 0x12, 0xab, 0x37, 0xd1, 0xd4, 0xaa,
-	0x52, 0x2b, 0x34, 0xaf, 0xd5, 0xa3, 0x6d, 0xd4, 0x0f, 0x83, 0x25, 0x3d, 0x32, 0x42, 0xef, 0x43,
-	0xda, 0xe8, 0x31, 0x3a, 0x26, 0x5a, 0xaa, 0xaa, 0xd4, 0xb2, 0x7a, 0x30, 0xe2, 0xf3, 0x8e, 0xe1,
-	0x12, 0x8b, 0x69, 0x69, 0x11, 0x34, 0x18, 0xa1, 0x16, 0x64, 0x47, 0x84, 0x19, 0xa6, 0xc1, 0x0c,
-	0x2d, 0x53, 0x4d, 0xd6, 0xf2, 0x4d, 0xad, 0x2e, 0xca, 0x57, 0xef, 0x0c, 0xfc, 0xfa, 0xf3, 0x60,
-	0xe9, 0x91, 0xc5, 0xdc, 0x89, 0x1e, 0x59, 0xa2, 0x5d, 0x00, 0x83, 0x31, 0x97, 0x76, 0x7d, 0x46,
-	0x3c, 0x2d, 0x2b, 0x70, 0xa5, 0x18, 0xee, 0x20, 0x5a, 0x94, 0xc8, 0x98, 0x35, 0x4f, 0x94, 0x8e,
-	0x8c, 0x3e, 0xd1, 0x72, 0x62, 0x23, 0x72, 0x80, 0x1e, 0xc3, 0x55, 0xc7, 0xe8, 0x0d, 0x8c, 0x3e,
-	0x39, 0xa2, 0x23, 0x62, 0x79, 0xd4, 0xb6, 0x3c, 0x0d, 0xaa, 0x4a, 0x6c, 0x43, 0x5f, 0x2f, 0xaf,
-	0xeb, 0xab, 0x10, 0x54, 0x87, 0x1c, 0xb5, 0xc6, 0xc4, 0x62, 0xb6, 0x3b, 0xd1, 0xf2, 0x02, 0x5f,
-	0x0c, 0xf0, 0x4f, 0xc3, 0x79, 0x7d, 0x6e, 0x82, 0x6e, 0x42, 0xa6, 0xe7, 0x12, 0x83, 0x11, 0x53,
-	0xfb, 0x3d, 0x53, 0x55, 0x6a, 0x49, 0x3d, 0x1c, 0xf3, 0x25, 0xdf, 0x31, 0xc5, 0xd2, 0x1f, 0xc1,
-	0x52, 0x30, 0x2e, 0xed, 0xc1, 0xe5, 0x85, 0xd2, 0xa0, 0x22, 0x24, 0x07, 0x64, 0x22, 0x8f, 0x5c,
-	0xe7, 0x8f, 0x3c, 0xcd, 0xb1, 0x31, 0xf4, 0xc3, 0x43, 0x96, 0x83, 0xdd, 0xc4, 0x03, 0xa5, 0xb4,
-	0x0f, 0x57, 0x96, 0xea, 0xf3, 0x36, 0x70, 0xfc, 0xa3, 0x02, 0xb9, 0x28, 0x15, 0xb4, 0x0b, 0xd9,
-	0x53, 0xdf, 0xb0, 0x18, 0x65, 0x12, 0x9e, 0x6c, 0x97, 0x67, 0xd3, 0x4a, 0x69, 0x6c, 0x0c, 0x29,
-	0xdf, 0xea, 0x2e, 0xb6, 0x47, 0x94, 0x11, 0xde, 0x9d, 0xb7, 0xfb, 0x8c, 0xec, 0xdf, 0xc5, 0x7a,
-	0x64, 0x8f, 0xbe, 0x05, 0x95, 0x4d, 0x1c, 0x19, 0xa2, 0xd0, 0x7c, 0x6f, 0xb9, 0x4c, 0xf5, 0x6f,
-	0x26, 0x0e, 0x69, 0xdf, 0x99, 0x4d, 0x2b, 0x9f, 0xad, 0x73, 0xe7, 0x92, 0x53, 0x9f, 0xba, 0xc4,
-	0x94, 0x7e, 0x6f, 0x0f, 0x19, 0xd9, 0xbf, 0x87, 0x75, 0xe1, 0x11, 0x57, 0x41, 0xe5, 0x60, 0x74,
-	0x09, 0xb2, 0x4f, 0xad, 0x57, 0xd4, 0xa2, 0x8c, 0x14, 0x77, 0x10, 0x40, 0xfa, 0xb1, 0x7c, 0x56,
-	0xf0, 0x5f, 0x0a, 0x5c, 0x5d, 0x39, 0x50, 0xd4, 0x82, 0xf4, 0x09, 0xa1, 0xfd, 0x13, 0x26, 0x72,
-	0x51, 0xda, 0x1f, 0xcc, 0xa6, 0x15, 0x6d, 0x1e, 0x3c, 0x16, 0x92, 0x67, 0x12, 0xd8, 0x72, 0xd4,
-	0x90, 0x58, 0x7d, 0x76, 0x22, 0x32, 0xd9, 0x8a, 0x92, 0xb6, 0x1c, 0x75, 0x26, 0x63, 0x25, 0xdf,
-	0x04, 0x25, 0x6d, 0x51, 0x13, 0x52, 0x67, 0xd4, 0x64, 0x27, 0x82, 0x8d, 0xdb, 0x40, 0xd2, 0x14,
-	0xbf, 0x4e, 0x03, 0x1c, 0x93, 0x33, 0x9d, 0x9c, 0xfa, 0xc4, 0x63, 0xe8, 0x6e, 0xc0, 0x7e, 0xa9,
-	0x0f, 0x17, 0x7b, 0x90, 0xda, 0xf0, 0x7d, 0x4c, 0x05, 0x12, 0x1b, 0x55, 0xa0, 0xdd, 0x98, 0x4d,
-	0x2b, 0x9f, 0xbf, 0xe9, 0x51, 0x35, 0x1f, 0xe0, 0x98, 0x6c, 0x34, 0x22, 0xd9, 0xe0, 0xc5, 0xc8,
-	0xb6, 0x6f, 0xcc, 0xa6, 0x95, 0x6b, 0xab, 0xbb, 0xc2, 0x91, 0x9e, 0xdc, 0x0f, 0xe5, 0x8a, 0xd7,
-	0x41, 0x6d, 0x7f, 0x38, 0x9b, 0x56, 0x6e, 0xae, 0xcd, 0x42, 0xf4, 0x5c, 0xa0, 0x66, 0x5f, 0x44,
-	0x22, 0x94, 0x12, 0xb9, 0x6f, 0x42, 0xf9, 0x3e, 0x35, 0x5b, 0x38, 0xd2, 0xa8, 0xbd, 0x98, 0x46,
-	0xa5, 0x85, 0xd6, 0x54, 0x82, 0x5e, 0x9d, 0x57, 0x75, 0xa3, 0x54, 0xd5, 0x42, 0xb9, 0xc9, 0x88,
-	0x90, 0x68, 0x36, 0xad, 0x14, 0xe6, 0x21, 0x7d, 0x77, 0x88, 0x43, 0x09, 0x22, 0xeb, 0x24, 0x28,
-	0x7b, 0xb1, 0x04, 0x2d, 0xa7, 0x30, 0xaf, 0xb9, 0x49, 0xc7, 0x04, 0xaf, 0x53, 0xa8, 0x67, 0x71,
-	0x85, 0xca, 0xad, 0x57, 0xa8, 0x8d, 0x5d, 0x21, 0xbd, 0xc6, 0xf4, 0xeb, 0x60, 0x41, 0x89, 0x41,
-	0x54, 0xe7, 0xa3, 0xd5, 0xea, 0x5c, 0x20, 0xc8, 0xff, 0xa9, 0x98, 0xed, 0x01, 0x3c, 0x21, 0x2c,
-	0x64, 0xc6, 0x9d, 0xd8, 0x7b, 0x73, 0x4b, 0x6f, 0x24, 0xa8, 0x89, 0x1f, 0xc2, 0xe5, 0x23, 0x32,
-	0x24, 0x8c, 0xbc, 0x23, 0xfe, 0xa7, 0x34, 0x5c, 0x7e, 0x21, 0x14, 0xfd, 0xdd, 0x1c, 0xa0, 0x7b,
-	0xf1, 0xf7, 0xf8, 0xe6, 0x56, 0xd8, 0x44, 0xe5, 0xe4, 0xbf, 0x42, 0xe5, 0xf9, 0x0d, 0x40, 0x5d,
-	0xb8, 0x01, 0xb4, 0x42, 0xc6, 0xa6, 0x04, 0x63, 0xb7, 0xbd, 0x26, 0x02, 0xca, 0x7e, 0xb9, 0x78,
-	0x6f, 0xd8, 0x0c, 0x5b, 0xe2, 0xec, 0xc3, 0x95, 0x7b, 0x05, 0x0e, 0xba, 0x72, 0xa1, 0xe2, 0x1b,
-	0x69, 0xdb, 0x0c, 0x69, 0x9b, 0x5d, 0xa7, 0x92, 0xb1, 0xb0, 0xdb, 0x08, 0x9c, 0xfb, 0xc7, 0x09,
-	0xfc, 0x3c, 0x4e, 0x60, 0xd8, 0x40, 0xe0, 0x2d, 0x6e, 0x63, 0x0c, 0x3e, 0x5a, 0x60, 0x70, 0x5e,
-	0xd4, 0xea, 0x93, 0xb5, 0xb5, 0xfa, 0xbf, 0x92, 0xf8, 0x10, 0x32, 0x9d, 0x81, 0xff, 0x8c, 0x7a,
-	0x0c, 0x61, 0x48, 0xdb, 0xae, 0x49, 0x5c, 0x4f, 0x53, 0x44, 0x22, 0x30, 0xbf, 0x14, 0xea, 0xc1,
-	0x0a, 0x77, 0xc4, 0x6c, 0x66, 0x0c, 0x85, 0xa3, 0x94, 0x2e, 0x07, 0xf8, 0x87, 0x04, 0xe4, 0xb9,
-	0x8b, 0x90, 0x8a, 0x7b, 0xa0, 0x3a, 0xfc, 0xfc, 0xe5, 0xa5, 0xe6, 0xd3, 0xd9, 0xb4, 0xf2, 0xf1,
-	0x76, 0x3e, 0x60, 0x5d, 0x80, 0xd0, 0x57, 0x90, 0x1a, 0xd2, 0x11, 0x65, 0x22, 0x44, 0xb2, 0x7d,
-	0x6b, 0x36, 0xad, 0xe0, 0x2d, 0x68, 0xd1, 0xf3, 0x02, 0x84, 0x5e, 0x82, 0xea, 0xd9, 0x2e, 0x0b,
-	0xf8, 0x79, 0x23, 0x48, 0x21, 0xb6, 0xb9, 0x7a, 0xc7, 0x76, 0xd9, 0x5b, 0xdd, 0x8c, 0x5a, 0x58,
-	0x17, 0x3e, 0xf1, 0x0b, 0x50, 0x39, 0x18, 0xe5, 0x21, 0x73, 0x6c, 0x30, 0xdf, 0x35, 0x86, 0xc5,
-	0x1d, 0x74, 0x05, 0xf2, 0x87, 0xf2, 0xd2, 0x79, 0x44, 0xbc, 0x5e, 0x51, 0x41, 0x05, 0x80, 0x60,
-	0xe2, 0xc0, 0xeb, 0x15, 0x13, 0xdc, 0x40, 0xb6, 0x82, 0x34, 0x48, 0x72, 0x83, 0x60, 0x82, 0x1b,
-	0xa8, 0xcd, 0x3f, 0x15, 0x80, 0xce, 0xc0, 0xef, 0x10, 0x77, 0xcc, 0x59, 0x7b, 0x0b, 0x92, 0xc7,
-	0xe4, 0x0c, 0x5d, 0x5d, 0x79, 0x11, 0x94, 0x62, 0x07, 0x82, 0x77, 0xb8, 0xdd, 0x13, 0xc2, 0x22,
-	0xbb, 0xb9, 0x14, 0x2f, 0xd9, 0xdd, 0x86, 0xb4, 0x0c, 0x87, 0xae, 0xaf, 0xeb, 0xcc, 0x25, 0xeb,
-	0x3a, 0xa4, 0xa5, 0x2e, 0x47, 0xd6, 0x0b, 0x32, 0x5d, 0xba, 0x14, 0xcc, 0xca, 0x8f, 0x26, 0xee,
-	0x5d, 0x15, 0xcd, 0x83, 0x56, 0x2b, 0x5d, 0x2a, 0xcc, 0x3d, 0xf3, 0x69, 0xbc, 0xd3, 0x6e, 0xfd,
-	0x7c, 0x5e, 0x56, 0x7e, 0x39, 0x2f, 0x2b, 0xbf, 0x9e, 0x97, 0x95, 0xd7, 0xbf, 0x95, 0x77, 0x5e,
-	0xe2, 0x8d, 0x5f, 0x6e, 0xd1, 0xd7, 0x61, 0x37, 0x2d, 0x3e, 0xd5, 0xee, 0xff, 0x1d, 0x00, 0x00,
-	0xff, 0xff, 0x3a, 0x51, 0xc3, 0xe2, 0x31, 0x0e, 0x00, 0x00,
+	// 1250 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x5d, 0x8f, 0xd3, 0x46,
+	0x17, 0x5e, 0xc7, 0xce, 0xc7, 0x9e, 0xc0, 0x12, 0x06, 0xde, 0x17, 0x13, 0xb5, 0x49, 0x3a, 0xad,
+	0x68, 0xaa, 0x42, 0x02, 0x21, 0x45, 0x68, 0xb7, 0x20, 0x11, 0x16, 0x10, 0x12, 0xa0, 0xca, 0x29,
+	0x52, 0xcb, 0x4d, 0xeb, 0xc4, 0x43, 0x76, 0x94, 0xc4, 0x36, 0xf6, 0x38, 0xdb, 0xfc, 0x13, 0xfe,
+	0x4b, 0xaf, 0x2b, 0xf5, 0xb2, 0xbf, 0x20, 0xaa, 0xb6, 0x52, 0x3f, 0x6e, 0x73, 0xdb, 0x9b, 0x6a,
+	0x66, 0xfc, 0x95, 0x2f, 0xb2, 0xa0, 0x56, 0xed, 0x4d, 0xe2, 0x33, 0xf3, 0x3c, 0x67, 0x3c, 0x67,
+	0xce, 0x79, 0xe6, 0x18, 0x2e, 0xf8, 0xc3, 0xa0, 0xe9, 0x0f, 0x03, 0xb7, 0xc7, 0x7f, 0x1b, 0xae,
+	0xe7, 0x30, 0x07, 0x65, 0xc5, 0x40, 0xf9, 0xda, 0x80, 0xb2, 0xa3, 0xa0, 0xd7, 0xe8, 0x3b, 0xe3,
+	0xe6, 0xc0, 0x19, 0x38, 0x4d, 0x31, 0xdb, 0x0b, 0x5e, 0x0a, 0x4b, 0x18, 0xe2, 0x49, 0xb2, 0xca,
+	0xb7, 0x53, 0x70, 0x8b, 0x0e, 0x1c, 0x66, 0x46, 0x7f, 0xae, 0x39, 0x1d, 0x13, 0x9b, 0x45, 0xff,
+	0x6e, 0x2f, 0x7a, 0x92, 0x4c, 0x9c, 0x87, 0xec, 0x83, 0xb1, 0xcb, 0xa6, 0xf8, 0x44, 0x03, 0xb5,
+	0x3b, 0x0c, 0x50, 0x05, 0x32, 0xd4, 0xd2, 0x95, 0x9a, 0x52, 0xdf, 0xed, 0xec, 0xcd, 0x67, 0x55,
+	0xe8, 0xf9, 0x8e, 0xbd, 0x8f, 0xbf, 0xa1, 0x16, 0x36, 0x32, 0xd4, 0x42, 0x08, 0x34, 0xdb, 0x1c,
+	0x13, 0x3d, 0xc3, 0x11, 0x86, 0x78, 0x46, 0x17, 0x21, 0xeb, 0x7a, 0xb4, 0x4f, 0x74, 0xb5, 0xa6,
+	0xd4, 0x35, 0x43, 0x1a, 0xa8, 0x09, 0x85, 0x7e, 0xe0, 0x79, 0xc4, 0xee, 0x4f, 0x75, 0xad, 0xa6,
+	0xd4, 0xf7, 0x5a, 0x17, 0x1a, 0xf1, 0x6b, 0x34, 0xee, 0x87, 0x53, 0x46, 0x0c, 0x42, 0xff, 0x87,
+	0x9c, 0xd9, 0x67, 0x74, 0x42, 0xf4, 0x6c, 0x4d, 0xa9, 0x17, 0x8c, 0xd0, 0xe2, 0xe3, 0xae, 0xe9,
+	0x11, 0x9b, 0xe9, 0x39, 0xb1, 0x68, 0x68, 0xa1, 0x36, 0x14, 0xc6, 0x84, 0x99, 0x96, 0xc9, 0x4c,
+	0x3d, 0x5f, 0x53, 0xeb, 0xc5, 0x96, 0xde, 0x10, 0xe1, 0x6b, 0x74, 0x87, 0x41, 0xe3, 0x69, 0x38,
+	0xf5, 0xc0, 0x66, 0xde, 0xd4, 0x88, 0x91, 0x68, 0x1f, 0xc0, 0x64, 0xcc, 0xa3, 0xbd, 0x80, 0x11,
+	0x5f, 0x2f, 0x08, 0x5e, 0x39, 0xc5, 0xbb, 0x17, 0x4f, 0x4a, 0x66, 0x0a, 0xcd, 0x37, 0x4a, 0xc7,
+	0xe6, 0x80, 0xe8, 0xbb, 0xe2, 0x45, 0xa4, 0x81, 0x1e, 0xc2, 0x79, 0xd7, 0xec, 0x0f, 0xcd, 0x01,
+	0x39, 0xa4, 0x63, 0x62, 0xfb, 0xd4, 0xb1, 0x7d, 0x1d, 0x6a, 0x4a, 0xea, 0x85, 0xbe, 0x58, 0x9e,
+	0x37, 0x56, 0x29, 0xa8, 0x01, 0xbb, 0xd4, 0x9e, 0x10, 0x9b, 0x39, 0xde, 0x54, 0x2f, 0x0a, 0x7e,
+	0x29, 0xe4, 0x3f, 0x8e, 0xc6, 0x8d, 0x04, 0x82, 0x2e, 0x43, 0xbe, 0xef, 0x11, 0x93, 0x11, 0x4b,
+	0xff, 0x35, 0x5f, 0x53, 0xea, 0xaa, 0x11, 0xd9, 0x7c, 0x2a, 0x70, 0x2d, 0x31, 0xf5, 0x5b, 0x38,
+	0x15, 0xda, 0xe5, 0x03, 0x38, 0xbb, 0x10, 0x1a, 0x54, 0x02, 0x75, 0x48, 0xa6, 0xf2, 0xc8, 0x0d,
+	0xfe, 0xc8, 0xb7, 0x39, 0x31, 0x47, 0x41, 0x74, 0xc8, 0xd2, 0xd8, 0xcf, 0xdc, 0x56, 0xca, 0x77,
+	0xe0, 0xdc, 0x52, 0x7c, 0xde, 0x86, 0x8e, 0xff, 0x54, 0x60, 0x37, 0xde, 0x0a, 0xda, 0x87, 0xc2,
+	0xab, 0xc0, 0xb4, 0x19, 0x65, 0x92, 0xae, 0x76, 0x2a, 0xf3, 0x59, 0xb5, 0x3c, 0x31, 0x47, 0x94,
+	0xbf, 0xea, 0x3e, 0x76, 0xc6, 0x94, 0x11, 0x9e, 0x9d, 0x57, 0x07, 0x8c, 0xdc, 0xb9, 0x8e, 0x8d,
+	0x18, 0x8f, 0xbe, 0x02, 0x8d, 0x4d, 0x5d, 0xb9, 0xc4, 0x5e, 0xeb, 0x7f, 0xcb, 0x61, 0x6a, 0x7c,
+	0x39, 0x75, 0x49, 0xe7, 0xda, 0x7c, 0x56, 0xfd, 0x64, 0x9d, 0x3b, 0x8f, 0xbc, 0x0a, 0xa8, 0x47,
+	0x2c, 0xe9, 0xf7, 0xea, 0x88, 0x91, 0x3b, 0x37, 0xb0, 0x21, 0x3c, 0xa2, 0x5b, 0x70, 0xc6, 0x23,
+	0x3e, 0xf1, 0x26, 0x26, 0x13, 0x07, 0xa9, 0x8a, 0x0c, 0x41, 0xe1, 0x0a, 0x46, 0x32, 0x65, 0x2c,
+	0xe0, 0x70, 0x0d, 0x34, 0xbe, 0x28, 0x3a, 0x03, 0x85, 0xc7, 0xf6, 0x4b, 0x6a, 0x53, 0x46, 0x4a,
+	0x3b, 0x08, 0x20, 0xf7, 0x50, 0x3e, 0x2b, 0xf8, 0x6b, 0x28, 0xa6, 0xe8, 0x3c, 0x4c, 0x8e, 0x67,
+	0x11, 0x2f, 0x0c, 0x9d, 0x34, 0x50, 0x39, 0x15, 0x94, 0x8c, 0x38, 0xb9, 0x64, 0xd3, 0x3a, 0xe4,
+	0xc9, 0x77, 0x2e, 0xf5, 0x88, 0x2f, 0x2a, 0x4d, 0x35, 0x22, 0x13, 0xff, 0xa1, 0xc0, 0xf9, 0x95,
+	0x1c, 0x43, 0x6d, 0xc8, 0x1d, 0x11, 0x3a, 0x38, 0x62, 0x62, 0x09, 0xa5, 0xf3, 0xde, 0x7c, 0x56,
+	0xd5, 0x93, 0x78, 0xa4, 0xa2, 0xc0, 0x83, 0x1b, 0x62, 0x39, 0x6b, 0x44, 0xec, 0x01, 0x3b, 0xd2,
+	0x33, 0xa7, 0x61, 0x49, 0x2c, 0x67, 0x1d, 0xcb, 0xb5, 0xd4, 0xd3, 0xb0, 0x24, 0x16, 0xb5, 0x20,
+	0x7b, 0x4c, 0x2d, 0x76, 0xa4, 0x6b, 0xa7, 0x20, 0x49, 0x28, 0x7e, 0x9d, 0x03, 0x78, 0x46, 0x8e,
+	0x0d, 0xf2, 0x2a, 0x20, 0x3e, 0x43, 0xd7, 0x43, 0x41, 0x92, 0x92, 0xf5, 0x66, 0x0f, 0x02, 0x89,
+	0xbe, 0x4d, 0x09, 0x53, 0x66, 0xa3, 0x30, 0x75, 0x9a, 0xf3, 0x59, 0xf5, 0xd3, 0xd3, 0x66, 0x4f,
+	0xeb, 0x36, 0x4e, 0x29, 0x59, 0x33, 0x56, 0x32, 0x1e, 0x8c, 0x42, 0xe7, 0xd2, 0x7c, 0x56, 0xbd,
+	0xb0, 0xfa, 0x56, 0x38, 0x96, 0xb8, 0x9b, 0x91, 0x82, 0xf2, 0x38, 0x68, 0x9d, 0xf7, 0xe7, 0xb3,
+	0xea, 0xe5, 0xb5, 0xbb, 0x10, 0x65, 0x20, 0xb1, 0xe8, 0xb3, 0x58, 0x17, 0xb3, 0x62, 0xef, 0x9b,
+	0x58, 0x41, 0x40, 0xad, 0x36, 0x8e, 0x65, 0xf3, 0x20, 0x25, 0x9b, 0x39, 0x91, 0xdc, 0xd5, 0x30,
+	0xb9, 0x93, 0xa8, 0x6e, 0x54, 0xcf, 0x7a, 0xa4, 0x80, 0x79, 0xb1, 0x24, 0x9a, 0xcf, 0xaa, 0x7b,
+	0xc9, 0x92, 0x81, 0x37, 0xc2, 0x91, 0x2a, 0x92, 0x75, 0xaa, 0x58, 0x78, 0xb3, 0x2a, 0x2e, 0x6f,
+	0x21, 0x89, 0xb9, 0x45, 0x27, 0x04, 0xaf, 0x13, 0xcd, 0x27, 0x69, 0xd1, 0xdc, 0x5d, 0x2f, 0x9a,
+	0x1b, 0xb3, 0x42, 0x7a, 0x4d, 0x1c, 0xa0, 0x7b, 0x0b, 0x97, 0x03, 0x88, 0xe8, 0x7c, 0xb0, 0x1a,
+	0x9d, 0x37, 0xdc, 0x11, 0xff, 0xaa, 0xbe, 0x1e, 0x00, 0x3c, 0x22, 0x2c, 0xaa, 0x8c, 0x6b, 0xa9,
+	0xab, 0x7c, 0x4b, 0x6e, 0x64, 0xa8, 0x85, 0xef, 0xc2, 0xd9, 0x43, 0x32, 0x22, 0x8c, 0xbc, 0x23,
+	0xff, 0x87, 0x1c, 0x9c, 0x7d, 0x2e, 0x2e, 0x99, 0x77, 0x73, 0x80, 0x6e, 0xa4, 0x5b, 0x8b, 0xcd,
+	0xa9, 0xb0, 0xa9, 0x94, 0xd5, 0x7f, 0xa4, 0x94, 0x93, 0xa6, 0x44, 0x5b, 0x68, 0x4a, 0xda, 0x51,
+	0xc5, 0x66, 0x45, 0xc5, 0x6e, 0xbb, 0xb9, 0xc2, 0x92, 0xbd, 0xb5, 0xd8, 0xca, 0x6c, 0xa6, 0x2d,
+	0xd5, 0xec, 0xdd, 0x95, 0x56, 0x07, 0x87, 0x59, 0xb9, 0x10, 0xf1, 0x8d, 0x65, 0xdb, 0x8a, 0xca,
+	0xb6, 0xb0, 0x4e, 0x25, 0x53, 0xcb, 0x6e, 0x2b, 0xe0, 0xdd, 0xbf, 0xbd, 0x80, 0x9f, 0xa6, 0x0b,
+	0x18, 0x36, 0x14, 0xf0, 0x16, 0xb7, 0xa9, 0x0a, 0x3e, 0x5c, 0xa8, 0xe0, 0xa2, 0x88, 0xd5, 0x47,
+	0x6b, 0x63, 0xf5, 0x5f, 0x2d, 0xe2, 0xfb, 0x90, 0xef, 0x0e, 0x83, 0x27, 0xd4, 0x67, 0x08, 0x43,
+	0x4e, 0x74, 0x05, 0xbe, 0xae, 0x88, 0x8d, 0x40, 0xd2, 0xa7, 0x1a, 0xe1, 0x0c, 0x77, 0xc4, 0x1c,
+	0x66, 0x8e, 0x84, 0xa3, 0xac, 0x21, 0x0d, 0xfc, 0x7d, 0x06, 0x8a, 0xdc, 0x45, 0x54, 0x8a, 0x07,
+	0xa0, 0xb9, 0xfc, 0xfc, 0x65, 0x9f, 0xf5, 0xf1, 0x7c, 0x56, 0xfd, 0x70, 0x7b, 0x3d, 0x60, 0x43,
+	0x90, 0xd0, 0xe7, 0x90, 0x1d, 0xd1, 0x31, 0x65, 0xb2, 0x21, 0xe9, 0x5c, 0x99, 0xcf, 0xaa, 0x78,
+	0x0b, 0x5b, 0xe4, 0xbc, 0x20, 0xa1, 0x17, 0xa0, 0xf9, 0x8e, 0xc7, 0xc2, 0xfa, 0xbc, 0x14, 0x6e,
+	0x21, 0xf5, 0x72, 0x8d, 0xae, 0xe3, 0xb1, 0xb7, 0x6a, 0xd6, 0xda, 0xd8, 0x10, 0x3e, 0xf1, 0x73,
+	0xd0, 0x38, 0x19, 0x15, 0x21, 0xff, 0xcc, 0x64, 0x81, 0x67, 0x8e, 0x4a, 0x3b, 0xe8, 0x1c, 0x14,
+	0xef, 0xcb, 0x3e, 0xf8, 0x90, 0xf8, 0xfd, 0x92, 0x82, 0xf6, 0x00, 0xc2, 0x81, 0x7b, 0x7e, 0xbf,
+	0x94, 0xe1, 0x00, 0x99, 0x0a, 0x12, 0xa0, 0x72, 0x40, 0x38, 0xc0, 0x01, 0x5a, 0xeb, 0x77, 0x05,
+	0xa0, 0x3b, 0x0c, 0xba, 0xc4, 0x9b, 0xf0, 0xaa, 0xbd, 0x02, 0xea, 0x33, 0x72, 0x8c, 0xce, 0xaf,
+	0x5c, 0x04, 0xe5, 0xd4, 0x81, 0xe0, 0x1d, 0x8e, 0x7b, 0x44, 0x58, 0x8c, 0x4b, 0xa4, 0x78, 0x09,
+	0x77, 0x15, 0x72, 0x72, 0x39, 0x74, 0x71, 0x5d, 0x66, 0x2e, 0xa1, 0x1b, 0x90, 0x93, 0xba, 0x1c,
+	0xa3, 0x17, 0x64, 0xba, 0x7c, 0x26, 0x1c, 0x95, 0xdf, 0x71, 0xdc, 0xbb, 0x26, 0x92, 0x07, 0xad,
+	0x46, 0xba, 0xbc, 0x97, 0x78, 0xe6, 0xc3, 0x78, 0xa7, 0xd3, 0xfe, 0xf1, 0xa4, 0xa2, 0xfc, 0x74,
+	0x52, 0x51, 0x7e, 0x3e, 0xa9, 0x28, 0xaf, 0x7f, 0xa9, 0xec, 0xbc, 0xc0, 0x1b, 0x3f, 0x26, 0xe3,
+	0x0f, 0xd6, 0x5e, 0x4e, 0x7c, 0x3d, 0xde, 0xfc, 0x6b, 0x00, 0xfc, 0x04, 0x8c, 0xc2, 0xc4, 0x0e,
+	0x00, 0x00,
 }
@@ -106,8 +106,36 @@ func (h *handler) ListParent(parent string, obj object.Interfaces) (err error) {
 	return s.DB(h.database).C(obj.GetNamespace()).Find(bson.M{"parent": parent}).All(obj)
 }
 
+// filterQuery converts the list filters into mongo query
+func filterQuery(filters []object.Filter) bson.M {
+	if len(filters) == 0 {
+		return nil
+	}
+	q := bson.M{}
+	for _, f := range filters {
+		var op string
+		switch f.Op {
+		case object.OpEq:
+			op = "$eq"
+		case object.OpLt:
+			op = "$lt"
+		case object.OpGt:
+			op = "$gt"
+		}
+		m, ok := q[f.Field].(bson.M)
+		if !ok {
+			m = bson.M{}
+			q[f.Field] = m
+		}
+		m[op] = f.Value
+	}
+	return q
+}
+
 func (h *handler) List(obj object.Interfaces, opt object.ListOpt) (n int, err error) {
 
+	q := filterQuery(opt.Filters)
+
 	wg := sync.WaitGroup{}
 	wg.Add(2)
 
@@ -129,7 +157,7 @@ func (h *handler) List(obj object.Interfaces, opt object.ListOpt) (n int, err er
 		case object.SortUpdatedAsc:
 			msort = "+updated"
 		}
-		err = s.DB(h.database).C(obj.GetNamespace()).Find(nil).Skip(int(opt.Page * opt.Limit)).Limit(int(opt.Limit)).Sort(msort).All(obj)
+		err = s.DB(h.database).C(obj.GetNamespace()).Find(q).Skip(int(opt.Page * opt.Limit)).Limit(int(opt.Limit)).Sort(msort).All(obj)
 	}()
 
 	// count
@@ -137,7 +165,7 @@ func (h *handler) List(obj object.Interfaces, opt object.ListOpt) (n int, err er
 		defer wg.Done()
 		s := h.client.Clone()
 		defer s.Close()
-		n, err = s.DB(h.database).C(obj.GetNamespace()).Count()
+		n, err = s.DB(h.database).C(obj.GetNamespace()).Find(q).Count()
 	}()
 
 	wg.Wait()
@@ -169,6 +197,11 @@ func (h *handler) One(obj object.Interface) error {
 
 	defer s.Close()
 
+	// the fencing token belongs to the lock holder, not to the stored object
+	if v, ok := obj.(object.Fencer); ok {
+		defer v.SetFence(v.GetFence())
+	}
+
 	if err := s.DB(h.database).C(obj.GetNamespace()).Find(bson.D{bson.DocElem{Name: "_id", Value: obj.GetId()}}).One(obj); err != nil {
 		return status.Errorf(codes.NotFound, "`%s::%s::%s`", obj.GetNamespace(), obj.GetId(), err.Error())
 	}
@@ -211,6 +244,14 @@ func (h *handler) Update(obj object.Interface) error {
 		v.SetUpdated(time.Now().Unix())
 	}
 
+	if v, ok := obj.(object.Fencer); ok && v.GetFence() > 0 {
+		return h.updateFenced(s, obj, v.GetFence())
+	}
+
+	if _, ok := obj.(object.Fencer); ok {
+		return h.updateKeepFence(s, obj)
+	}
+
 	if err := s.DB(h.database).C(obj.GetNamespace()).UpdateId(obj.GetId(), obj); err != nil {
 		return status.Error(codes.Internal, err.Error())
 	}
@@ -219,6 +260,69 @@ func (h *handler) Update(obj object.Interface) error {
 
 }
 
+// updateKeepFence updates obj without a fencing token, the last written
+// token is kept so older lock holders are still rejected.
+func (h *handler) updateKeepFence(s *mgo.Session, obj object.Interface) error {
+
+	doc, err := toM(obj)
+	if err != nil {
+		return err
+	}
+	delete(doc, "_id")
+	delete(doc, object.FenceField)
+
+	if err := s.DB(h.database).C(obj.GetNamespace()).UpdateId(obj.GetId(), bson.M{"$set": doc}); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+
+}
+
+// toM converts obj to its bson document
+func toM(obj object.Interface) (bson.M, error) {
+	b, err := bson.Marshal(obj)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	doc := bson.M{}
+	if err := bson.Unmarshal(b, doc); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return doc, nil
+}
+
+// updateFenced replaces obj only if no newer fencing token than fence has
+// been written to it, and stores fence along with it.
+func (h *handler) updateFenced(s *mgo.Session, obj object.Interface, fence int64) error {
+
+	c := s.DB(h.database).C(obj.GetNamespace())
+
+	doc, err := toM(obj)
+	if err != nil {
+		return err
+	}
+	doc[object.FenceField] = fence
+
+	// matches objects with older or no token at all
+	selector := bson.M{
+		"_id":             obj.GetId(),
+		object.FenceField: bson.M{"$not": bson.M{"$gt": fence}},
+	}
+
+	if err := c.Update(selector, doc); err != nil {
+		if err == mgo.ErrNotFound {
+			if n, _ := c.FindId(obj.GetId()).Count(); n > 0 {
+				return status.Errorf(codes.Aborted, "`%s::%s` has been written with a newer fencing token", obj.GetNamespace(), obj.GetId())
+			}
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+
+}
+
 func (h *handler) Remove(obj object.Interface) error {
 
 	s := h.client.Clone()
@@ -232,3 +336,28 @@ func (h *handler) Remove(obj object.Interface) error {
 	return nil
 
 }
+
+// NextFence increments and returns the fencing token counter of obj
+func (h *handler) NextFence(obj object.Interface) (int64, error) {
+
+	s := h.client.Clone()
+
+	defer s.Close()
+
+	counter := struct {
+		Token int64 `bson:"token"`
+	}{}
+
+	change := mgo.Change{
+		Update:    bson.M{"$inc": bson.M{"token": 1}},
+		Upsert:    true,
+		ReturnNew: true,
+	}
+
+	if _, err := s.DB(h.database).C(object.FenceNamespace).FindId(obj.GetNamespace()+"/"+obj.GetId()).Apply(change, &counter); err != nil {
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return counter.Token, nil
+
+}
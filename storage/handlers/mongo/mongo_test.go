@@ -26,6 +26,9 @@ import (
 	"github.com/digota/digota/config"
 	"github.com/digota/digota/storage/object"
 	"github.com/satori/go.uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/mgo.v2/bson"
 	"log"
 	"reflect"
 	"testing"
@@ -105,6 +108,28 @@ func (o *testObjWithTimeTracker) SetUpdated(t int64) {
 	o.Updated = t
 }
 
+type testObjWithFence struct {
+	Id    string `bson:"_id"`
+	Data  string
+	fence int64
+}
+
+func (o *testObjWithFence) GetNamespace() string {
+	return "mongo_test"
+}
+
+func (o *testObjWithFence) GetId() string {
+	return o.Id
+}
+
+func (o *testObjWithFence) SetFence(t int64) {
+	o.fence = t
+}
+
+func (o *testObjWithFence) GetFence() int64 {
+	return o.fence
+}
+
 func TestNewHandler(t *testing.T) {
 
 	iface := NewHandler(config.Storage{
@@ -119,6 +144,27 @@ func TestNewHandler(t *testing.T) {
 
 }
 
+func TestFilterQuery(t *testing.T) {
+
+	if q := filterQuery(nil); q != nil {
+		t.Fatal(q)
+	}
+
+	q := filterQuery([]object.Filter{
+		{Field: "status", Op: object.OpEq, Value: 0},
+		{Field: "expires", Op: object.OpGt, Value: 0},
+		{Field: "expires", Op: object.OpLt, Value: 10},
+	})
+
+	if !reflect.DeepEqual(q, bson.M{
+		"status":  bson.M{"$eq": 0},
+		"expires": bson.M{"$gt": 0, "$lt": 10},
+	}) {
+		t.Fatal(q)
+	}
+
+}
+
 func TestHandler_Prepare(t *testing.T) {
 
 	db := uuid.NewV4().String()
@@ -430,6 +476,23 @@ func TestHandler_List(t *testing.T) {
 		}
 	}
 
+	// filtered
+	other := uuid.NewV4().String()
+	if err := iface.Insert(&testParentObj{
+		Id:     uuid.NewV4().String(),
+		Parent: other,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := iface.List(slice, object.ListOpt{
+		Limit:   10,
+		Filters: []object.Filter{{Field: "parent", Op: object.OpEq, Value: other}},
+	})
+	if err != nil || n != 1 || len(*slice) != 1 || (*slice)[0].Parent != other {
+		t.Fatal(err, n)
+	}
+
 }
 
 func TestHandler_DropCollection(t *testing.T) {
@@ -463,3 +526,106 @@ func TestHandler_DropCollection(t *testing.T) {
 	}
 
 }
+
+func TestHandler_UpdateFenced(t *testing.T) {
+
+	db := uuid.NewV4().String()
+
+	iface := NewHandler(config.Storage{
+		Address:  []string{"localhost"},
+		Database: db,
+	})
+
+	defer func() {
+		iface.DropDatabase(db)
+		iface.Close()
+	}()
+
+	if err := iface.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+
+	obj := &testObjWithFence{
+		Id:   uuid.NewV4().String(),
+		Data: "beforeUpdate",
+	}
+
+	if err := iface.Insert(obj); err != nil {
+		t.Fatal(err)
+	}
+
+	// first fenced write
+	obj.SetFence(2)
+	obj.Data = "afterUpdate"
+	if err := iface.Update(obj); err != nil {
+		t.Fatal(err)
+	}
+
+	// fence survives loading the object
+	if err := iface.One(obj); err != nil || obj.Data != "afterUpdate" || obj.GetFence() != 2 {
+		t.Fatal(err)
+	}
+
+	// stale holder
+	stale := &testObjWithFence{Id: obj.Id, Data: "stale", fence: 1}
+	if err := iface.Update(stale); status.Code(err) != codes.Aborted {
+		t.Fatal(err)
+	}
+
+	// newer holder
+	obj.SetFence(3)
+	if err := iface.Update(obj); err != nil {
+		t.Fatal(err)
+	}
+
+	// unfenced write keeps the stored token
+	unfenced := &testObjWithFence{Id: obj.Id, Data: "unfenced"}
+	if err := iface.Update(unfenced); err != nil {
+		t.Fatal(err)
+	}
+	stale.SetFence(2)
+	if err := iface.Update(stale); status.Code(err) != codes.Aborted {
+		t.Fatal(err)
+	}
+
+	// missing object
+	obj.Id = uuid.NewV4().String()
+	if err := iface.Update(obj); status.Code(err) != codes.Internal {
+		t.Fatal(err)
+	}
+
+}
+
+func TestHandler_NextFence(t *testing.T) {
+
+	db := uuid.NewV4().String()
+
+	iface := NewHandler(config.Storage{
+		Address:  []string{"localhost"},
+		Database: db,
+	})
+
+	defer func() {
+		iface.DropDatabase(db)
+		iface.Close()
+	}()
+
+	if err := iface.Prepare(); err != nil {
+		t.Fatal(err)
+	}
+
+	obj := &testObjWithFence{Id: uuid.NewV4().String()}
+	other := &testObjWithFence{Id: uuid.NewV4().String()}
+
+	for i := int64(1); i <= 3; i++ {
+		if token, err := iface.NextFence(obj); err != nil || token != i {
+			t.Fatal(token, err)
+		}
+	}
+
+	// counters are per object
+	if token, err := iface.NextFence(other); err != nil || token != 1 {
+		t.Fatal(token, err)
+	}
+
+}
@@ -25,6 +25,14 @@ package object
 // DefaultDatabase is used if nothing else specified
 const DefaultDatabase = "digota"
 
+// FenceField is the field storage handlers keep the last written
+// fencing token of an object in
+const FenceField = "fence"
+
+// FenceNamespace is the namespace storage handlers keep the fencing token
+// counters of objects in
+const FenceNamespace = "fence"
+
 const (
 	// SortNatural use natural order
 	SortNatural Sort = iota
@@ -38,6 +46,15 @@ const (
 	SortUpdatedAsc
 )
 
+const (
+	// OpEq field equals value
+	OpEq Op = iota
+	// OpLt field is less than value
+	OpLt
+	// OpGt field is greater than value
+	OpGt
+)
+
 type (
 	// Sort type for storage handlers
 	Sort int
@@ -66,10 +83,29 @@ type (
 		SetId(string)
 	}
 
+	// Fencer holds the fencing token the locker handed out with the object
+	// lock. storage handlers reject updates carrying a token older than the
+	// last one written, so a lock holder that lost its lock can't write.
+	Fencer interface {
+		SetFence(token int64)
+		GetFence() int64
+	}
+
+	// Op compares a field to a filter value
+	Op int
+
+	// Filter matches objects whose Field compares to Value by Op
+	Filter struct {
+		Field string
+		Op    Op
+		Value interface{}
+	}
+
 	// ListOpt options for listing objects
 	ListOpt struct {
-		Page  int64
-		Limit int64
-		Sort  Sort
+		Page    int64
+		Limit   int64
+		Sort    Sort
+		Filters []Filter
 	}
 )
@@ -48,6 +48,7 @@ type (
 		Insert(doc object.Interface) error
 		Update(doc object.Interface) error
 		Remove(doc object.Interface) error
+		NextFence(doc object.Interface) (int64, error)
 	}
 )
 
@@ -40,6 +40,7 @@ func (d *dummyStorage) ListParent(parent string, docs object.Interfaces) error
 func (d *dummyStorage) Insert(doc object.Interface) error                            { return nil }
 func (d *dummyStorage) Update(doc object.Interface) error                            { return nil }
 func (d *dummyStorage) Remove(doc object.Interface) error                            { return nil }
+func (d *dummyStorage) NextFence(doc object.Interface) (int64, error)                { return 0, nil }
 
 func TestNew(t *testing.T) {
 
@@ -0,0 +1,273 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"fmt"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/order"
+	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/sku"
+	"github.com/digota/digota/sku/skupb"
+	"github.com/digota/digota/storage"
+	"github.com/digota/digota/storage/object"
+	subscriptionInterface "github.com/digota/digota/subscription"
+	"github.com/digota/digota/subscription/subscriptionpb"
+	"github.com/digota/digota/util"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+const (
+	// renewBatch is the max number of subscriptions of a single status
+	// billed by one renewal run
+	renewBatch = 500
+)
+
+// renewer is locked by the renewal run, the node holding the lock is the
+// only one billing
+type renewer struct{}
+
+// implements object.Interface interface
+func (r *renewer) GetNamespace() string { return ns }
+
+// implements object.Interface interface
+func (r *renewer) GetId() string { return "renewer" }
+
+// Renew implements the subscription.Renewer interface.
+// Bills the subscriptions which trial, period or retry delay ended, returns
+// the number of subscriptions billed.
+func (s *subscriptionService) Renew(ctx context.Context) (int, error) {
+	// only one node renews at a time
+	unlock, err := locker.Handler().TryLockContext(ctx, &renewer{}, locker.DefaultTimeout)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	now := time.Now()
+
+	var n int
+	for _, st := range []subscriptionpb.Subscription_Status{
+		subscriptionpb.Subscription_Trialing,
+		subscriptionpb.Subscription_Active,
+		subscriptionpb.Subscription_PastDue,
+	} {
+		slice := subscriptions{}
+		if _, err := storage.Handler().List(&slice, object.ListOpt{
+			Limit: renewBatch,
+			Sort:  object.SortCreatedAsc,
+			Filters: []object.Filter{
+				{Field: "status", Op: object.OpEq, Value: st},
+				{Field: "nextbilling", Op: object.OpGt, Value: 0},
+				{Field: "nextbilling", Op: object.OpLt, Value: now.Unix() + 1},
+			},
+		}); err != nil {
+			return n, err
+		}
+		for _, v := range slice {
+			billed, err := renew(ctx, v.GetId(), now)
+			if err != nil {
+				log.Warnf("Could not renew subscription %s => %s", v.GetId(), err.Error())
+				continue
+			}
+			if billed {
+				n++
+			}
+		}
+	}
+	return n, nil
+}
+
+// renew bills the next period of the subscription if it is due, failed
+// renewals are retried later. returns whether a period was billed.
+func renew(ctx context.Context, id string, now time.Time) (bool, error) {
+	sub, unlock, err := lockSubscription(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	// it may have changed since listed
+	switch sub.GetStatus() {
+	case subscriptionpb.Subscription_Trialing, subscriptionpb.Subscription_Active, subscriptionpb.Subscription_PastDue:
+	default:
+		return false, nil
+	}
+	if sub.GetNextBilling() <= 0 || sub.GetNextBilling() > now.Unix() {
+		return false, nil
+	}
+
+	billed := false
+	if sub.GetCancelAtPeriodEnd() {
+		sub.cancel(now)
+	} else {
+		p, err := getPlan(sub.GetPlan())
+		if err != nil {
+			return false, err
+		}
+		// periods follow each other, a past due period starts when paid
+		start := now
+		if sub.GetStatus() != subscriptionpb.Subscription_PastDue {
+			start = time.Unix(sub.GetCurrentPeriodEnd(), 0)
+		}
+		if err := sub.bill(ctx, p, start); err != nil {
+			sub.fail(err, now)
+		} else {
+			billed = true
+		}
+	}
+
+	if err := util.Retry(func() error { return storage.Handler().Update(sub) }); err != nil {
+		return billed, status.Error(codes.DataLoss, "Storage could not update object.")
+	}
+
+	return billed, nil
+}
+
+// bill creates the renewal order of p for the period starting at start and
+// pays it with the stored payment method, the credit is discounted from the
+// order. the subscription is not saved.
+func (s *subscription) bill(ctx context.Context, p *plan, start time.Time) error {
+	credit := s.creditFor(ctx, p)
+
+	// retries of the same attempt after a crash get the same order and
+	// payment instead of charging again, every billed order and failed
+	// attempt moves to the next key
+	key := fmt.Sprintf("subscription/%s/%d/%d", s.GetId(), len(s.GetOrders()), s.GetFailedAttempts())
+
+	o, err := order.Service().NewWithCredit(ctx, &order.NewWithCreditRequest{
+		Order: &orderpb.NewRequest{
+			Currency: s.GetCurrency(),
+			Items: []*orderpb.OrderItem{
+				{
+					Type:     orderpb.OrderItem_sku,
+					Parent:   p.GetSku(),
+					Quantity: p.GetQuantity(),
+				},
+			},
+			Email:          s.GetEmail(),
+			Shipping:       s.GetShipping(),
+			Metadata:       map[string]string{"subscription": s.GetId()},
+			IdempotencyKey: key,
+		},
+		Credit: credit,
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := order.Service().Pay(ctx, &orderpb.PayRequest{
+		Id:                o.GetId(),
+		PaymentProviderId: s.GetPaymentMethod().GetProviderId(),
+		PaymentMethod:     s.GetPaymentMethod().GetReference(),
+		IdempotencyKey:    key,
+	}); err != nil {
+		// release the stock held by the unpaid order
+		if _, err := order.Service().Cancel(ctx, &orderpb.CancelRequest{Id: o.GetId()}); err != nil {
+			log.Warnf("Could not cancel renewal order %s => %s", o.GetId(), err.Error())
+		}
+		return err
+	}
+
+	s.Status = subscriptionpb.Subscription_Active
+	s.CurrentPeriodStart = start.Unix()
+	s.CurrentPeriodEnd = periodEnd(start, &p.Plan).Unix()
+	s.NextBilling = s.GetCurrentPeriodEnd()
+	s.PeriodAmount = o.GetAmount() + credit
+	s.Credit -= credit
+	s.FailedAttempts = 0
+	s.LastError = ""
+	s.Orders = append(s.Orders, o.GetId())
+
+	return nil
+}
+
+// creditFor returns the part of the credit discounted from a renewal of p,
+// orders of zero amount can't be paid so at least one unit of the plan items
+// amount is left to charge
+func (s *subscription) creditFor(ctx context.Context, p *plan) int64 {
+	if s.GetCredit() <= 0 {
+		return 0
+	}
+	item, err := sku.Service().Get(ctx, &skupb.GetRequest{Id: p.GetSku()})
+	// the credit is kept till it can be discounted in the same currency
+	if err != nil || item.GetCurrency() != s.GetCurrency() {
+		return 0
+	}
+	amount := int64(item.GetPrice())*p.GetQuantity() - 1
+	if amount <= 0 {
+		return 0
+	}
+	if s.GetCredit() > amount {
+		return amount
+	}
+	return s.GetCredit()
+}
+
+// fail records the failed renewal, it is retried after the retry interval
+// times the failed attempts, the subscription is canceled once the retries
+// are exhausted
+func (s *subscription) fail(err error, now time.Time) {
+	s.FailedAttempts++
+	s.LastError = err.Error()
+	if int(s.GetFailedAttempts()) > subscriptionInterface.Retries() {
+		s.cancel(now)
+		return
+	}
+	s.Status = subscriptionpb.Subscription_PastDue
+	s.NextBilling = now.Add(subscriptionInterface.RetryInterval() * time.Duration(s.GetFailedAttempts())).Unix()
+}
+
+// periodEnd returns the end of the period of p starting at start
+func periodEnd(start time.Time, p *subscriptionpb.Plan) time.Time {
+	n := int(p.GetIntervalCount())
+	if n <= 0 {
+		n = 1
+	}
+	switch p.GetInterval() {
+	case subscriptionpb.Plan_Day:
+		return start.AddDate(0, 0, n)
+	case subscriptionpb.Plan_Week:
+		return start.AddDate(0, 0, 7*n)
+	case subscriptionpb.Plan_Month:
+		return start.AddDate(0, n, 0)
+	default:
+		return start.AddDate(n, 0, 0)
+	}
+}
+
+// prorate returns the part of amount paid for the period [start, end)
+// which is left at now
+func prorate(amount, start, end, now int64) int64 {
+	if end <= start || now >= end {
+		return 0
+	}
+	if now < start {
+		now = start
+	}
+	return amount * (end - now) / (end - start)
+}
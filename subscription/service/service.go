@@ -0,0 +1,499 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/payment"
+	"github.com/digota/digota/payment/paymentpb"
+	"github.com/digota/digota/sku"
+	"github.com/digota/digota/sku/skupb"
+	"github.com/digota/digota/storage"
+	"github.com/digota/digota/storage/object"
+	subscriptionInterface "github.com/digota/digota/subscription"
+	"github.com/digota/digota/subscription/subscriptionpb"
+	"github.com/digota/digota/util"
+	"github.com/digota/digota/validation"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+const (
+	ns     = "subscription"
+	planNs = "subscription_plan"
+)
+
+func init() {
+	subscriptionInterface.RegisterService(&subscriptionService{})
+}
+
+type plans []*subscriptionpb.Plan
+
+func (p *plans) GetNamespace() string { return planNs }
+
+// Plan wrapper
+type plan struct {
+	subscriptionpb.Plan `bson:",inline"`
+}
+
+// implements object.Interface interface
+func (p *plan) GetNamespace() string { return planNs }
+
+// implements object.IdSetter interface
+func (p *plan) SetId(id string) { p.Id = id }
+
+// implements object.TimeTracker interface
+func (p *plan) SetCreated(t int64) { p.Created = t }
+
+// implements object.TimeTracker interface
+func (p *plan) SetUpdated(t int64) { p.Updated = t }
+
+type subscriptions []*subscriptionpb.Subscription
+
+func (s *subscriptions) GetNamespace() string { return ns }
+
+// Subscription wrapper
+type subscription struct {
+	subscriptionpb.Subscription `bson:",inline"`
+	fence                       int64
+}
+
+// implements object.Interface interface
+func (s *subscription) GetNamespace() string { return ns }
+
+// implements object.IdSetter interface
+func (s *subscription) SetId(id string) { s.Id = id }
+
+// implements object.TimeTracker interface
+func (s *subscription) SetCreated(t int64) { s.Created = t }
+
+// implements object.TimeTracker interface
+func (s *subscription) SetUpdated(t int64) { s.Updated = t }
+
+// implements object.Fencer interface
+func (s *subscription) SetFence(t int64) { s.fence = t }
+
+// implements object.Fencer interface
+func (s *subscription) GetFence() int64 { return s.fence }
+
+type subscriptionService struct{}
+
+// NewPlan creates a plan of the sku, the plan is priced by the sku
+func (s *subscriptionService) NewPlan(ctx context.Context, req *subscriptionpb.NewPlanRequest) (*subscriptionpb.Plan, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	if err := validation.Metadata(req.GetMetadata()); err != nil {
+		return nil, err
+	}
+
+	item, err := sku.Service().Get(ctx, &skupb.GetRequest{Id: req.GetSku()})
+	if err != nil {
+		return nil, err
+	}
+
+	p := &plan{
+		Plan: subscriptionpb.Plan{
+			Name:          req.GetName(),
+			Sku:           req.GetSku(),
+			Quantity:      req.GetQuantity(),
+			Interval:      req.GetInterval(),
+			IntervalCount: req.GetIntervalCount(),
+			TrialDays:     req.GetTrialDays(),
+			Currency:      item.GetCurrency(),
+			Metadata:      req.GetMetadata(),
+		},
+	}
+
+	if p.Quantity == 0 {
+		p.Quantity = 1
+	}
+
+	if p.IntervalCount == 0 {
+		p.IntervalCount = 1
+	}
+
+	if err := storage.Handler().Insert(p); err != nil {
+		return nil, err
+	}
+
+	return &p.Plan, nil
+
+}
+
+// GetPlan
+func (s *subscriptionService) GetPlan(ctx context.Context, req *subscriptionpb.GetRequest) (*subscriptionpb.Plan, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	p, err := getPlan(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &p.Plan, nil
+
+}
+
+// ListPlans
+func (s *subscriptionService) ListPlans(ctx context.Context, req *subscriptionpb.ListRequest) (*subscriptionpb.PlanList, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	slice := &plans{}
+
+	n, err := storage.Handler().List(slice, object.ListOpt{
+		Limit: req.GetLimit(),
+		Page:  req.GetPage(),
+		Sort:  object.SortCreatedAsc,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscriptionpb.PlanList{Plans: *slice, Total: int32(n)}, nil
+
+}
+
+// New subscribes to the plan, the card is saved with the payment provider
+// and charged for the first period right away unless the plan has a trial
+func (s *subscriptionService) New(ctx context.Context, req *subscriptionpb.NewRequest) (*subscriptionpb.Subscription, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	if err := validation.Metadata(req.GetMetadata()); err != nil {
+		return nil, err
+	}
+
+	p, err := getPlan(req.GetPlan())
+	if err != nil {
+		return nil, err
+	}
+
+	// only the provider reference of the card is kept
+	pm, err := payment.Service().SavePaymentMethod(ctx, &paymentpb.PaymentMethodRequest{
+		Card:              req.GetCard(),
+		Email:             req.GetEmail(),
+		PaymentProviderId: req.GetPaymentProviderId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	sub := &subscription{
+		Subscription: subscriptionpb.Subscription{
+			Plan:          p.GetId(),
+			Status:        subscriptionpb.Subscription_Active,
+			Email:         req.GetEmail(),
+			Shipping:      req.GetShipping(),
+			PaymentMethod: pm,
+			Currency:      p.GetCurrency(),
+			Metadata:      req.GetMetadata(),
+		},
+	}
+
+	// the trial is the first period, it is billed when it ends
+	if p.GetTrialDays() > 0 {
+		sub.Status = subscriptionpb.Subscription_Trialing
+		sub.CurrentPeriodStart = now.Unix()
+		sub.TrialEnd = now.AddDate(0, 0, int(p.GetTrialDays())).Unix()
+		sub.CurrentPeriodEnd = sub.TrialEnd
+		sub.NextBilling = sub.TrialEnd
+		if err := storage.Handler().Insert(sub); err != nil {
+			return nil, err
+		}
+		return &sub.Subscription, nil
+	}
+
+	// insert first, the renewal orders refer to the subscription
+	if err := storage.Handler().Insert(sub); err != nil {
+		return nil, err
+	}
+
+	unlock, err := locker.Handler().TryLockContext(ctx, sub, locker.DefaultTimeout)
+	if err != nil {
+		storage.Handler().Remove(sub)
+		return nil, err
+	}
+	defer unlock()
+
+	// the subscription is not created if the first period is not paid
+	if err := sub.bill(ctx, p, now); err != nil {
+		storage.Handler().Remove(sub)
+		return nil, err
+	}
+
+	if err := util.Retry(func() error { return storage.Handler().Update(sub) }); err != nil {
+		return nil, status.Error(codes.DataLoss, "Storage could not update object.")
+	}
+
+	return &sub.Subscription, nil
+
+}
+
+// Get
+func (s *subscriptionService) Get(ctx context.Context, req *subscriptionpb.GetRequest) (*subscriptionpb.Subscription, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	sub := &subscription{
+		Subscription: subscriptionpb.Subscription{
+			Id: req.GetId(),
+		},
+	}
+
+	return &sub.Subscription, storage.Handler().One(sub)
+
+}
+
+// List
+func (s *subscriptionService) List(ctx context.Context, req *subscriptionpb.ListRequest) (*subscriptionpb.SubscriptionList, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	slice := &subscriptions{}
+
+	n, err := storage.Handler().List(slice, object.ListOpt{
+		Limit: req.GetLimit(),
+		Page:  req.GetPage(),
+		Sort:  object.SortCreatedAsc,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscriptionpb.SubscriptionList{Subscriptions: *slice, Total: int32(n)}, nil
+
+}
+
+// ChangePlan moves the subscription to another plan of the same currency.
+// an active subscription is credited the unused part of the current period
+// and the new plan period is billed right away, trials and past due
+// subscriptions are billed for the new plan on their next billing.
+func (s *subscriptionService) ChangePlan(ctx context.Context, req *subscriptionpb.ChangePlanRequest) (*subscriptionpb.Subscription, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	sub, unlock, err := lockSubscription(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	switch sub.GetStatus() {
+	case subscriptionpb.Subscription_Paused, subscriptionpb.Subscription_Canceled:
+		return nil, status.Errorf(codes.FailedPrecondition, "Subscription is %s.", sub.GetStatus())
+	}
+
+	if sub.GetPlan() == req.GetPlan() {
+		return nil, status.Error(codes.InvalidArgument, "Subscription is already on the plan.")
+	}
+
+	p, err := getPlan(req.GetPlan())
+	if err != nil {
+		return nil, err
+	}
+
+	if p.GetCurrency() != sub.GetCurrency() {
+		return nil, status.Error(codes.InvalidArgument, "Plan currency differs from the subscription currency.")
+	}
+
+	sub.Plan = p.GetId()
+
+	if sub.GetStatus() == subscriptionpb.Subscription_Active {
+		now := time.Now()
+		sub.Credit += prorate(sub.GetPeriodAmount(), sub.GetCurrentPeriodStart(), sub.GetCurrentPeriodEnd(), now.Unix())
+		sub.PeriodAmount = 0
+		if err := sub.bill(ctx, p, now); err != nil {
+			sub.fail(err, now)
+		}
+	}
+
+	if err := util.Retry(func() error { return storage.Handler().Update(sub) }); err != nil {
+		return nil, status.Error(codes.DataLoss, "Storage could not update object.")
+	}
+
+	return &sub.Subscription, nil
+
+}
+
+// Pause stops billing the subscription till it is resumed
+func (s *subscriptionService) Pause(ctx context.Context, req *subscriptionpb.PauseRequest) (*subscriptionpb.Subscription, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	sub, unlock, err := lockSubscription(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	switch sub.GetStatus() {
+	case subscriptionpb.Subscription_Trialing, subscriptionpb.Subscription_Active:
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "Subscription is %s.", sub.GetStatus())
+	}
+
+	sub.Status = subscriptionpb.Subscription_Paused
+	sub.Paused = time.Now().Unix()
+	sub.NextBilling = 0
+
+	if err := util.Retry(func() error { return storage.Handler().Update(sub) }); err != nil {
+		return nil, status.Error(codes.DataLoss, "Storage could not update object.")
+	}
+
+	return &sub.Subscription, nil
+
+}
+
+// Resume continues a paused subscription, the current period (or trial)
+// is extended by the time it was paused
+func (s *subscriptionService) Resume(ctx context.Context, req *subscriptionpb.ResumeRequest) (*subscriptionpb.Subscription, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	sub, unlock, err := lockSubscription(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if sub.GetStatus() != subscriptionpb.Subscription_Paused {
+		return nil, status.Errorf(codes.FailedPrecondition, "Subscription is %s.", sub.GetStatus())
+	}
+
+	paused := time.Now().Unix() - sub.GetPaused()
+
+	sub.Status = subscriptionpb.Subscription_Active
+	// paused during the trial
+	if sub.GetTrialEnd() > sub.GetPaused() {
+		sub.Status = subscriptionpb.Subscription_Trialing
+		sub.TrialEnd += paused
+	}
+	sub.CurrentPeriodEnd += paused
+	sub.NextBilling = sub.GetCurrentPeriodEnd()
+	sub.Paused = 0
+
+	if err := util.Retry(func() error { return storage.Handler().Update(sub) }); err != nil {
+		return nil, status.Error(codes.DataLoss, "Storage could not update object.")
+	}
+
+	return &sub.Subscription, nil
+
+}
+
+// Cancel stops the subscription right away, or when the current period
+// ends if atPeriodEnd. paid periods are not refunded.
+func (s *subscriptionService) Cancel(ctx context.Context, req *subscriptionpb.CancelRequest) (*subscriptionpb.Subscription, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	sub, unlock, err := lockSubscription(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	switch sub.GetStatus() {
+	case subscriptionpb.Subscription_Canceled:
+		return nil, status.Error(codes.FailedPrecondition, "Subscription is already canceled.")
+	case subscriptionpb.Subscription_Trialing, subscriptionpb.Subscription_Active:
+		if req.GetAtPeriodEnd() {
+			sub.CancelAtPeriodEnd = true
+			break
+		}
+		fallthrough
+	default:
+		sub.cancel(time.Now())
+	}
+
+	if err := util.Retry(func() error { return storage.Handler().Update(sub) }); err != nil {
+		return nil, status.Error(codes.DataLoss, "Storage could not update object.")
+	}
+
+	return &sub.Subscription, nil
+
+}
+
+// cancel moves the subscription to canceled status
+func (s *subscription) cancel(now time.Time) {
+	s.Status = subscriptionpb.Subscription_Canceled
+	s.Canceled = now.Unix()
+	s.NextBilling = 0
+}
+
+// getPlan returns the plan of id
+func getPlan(id string) (*plan, error) {
+	p := &plan{
+		Plan: subscriptionpb.Plan{
+			Id: id,
+		},
+	}
+	if err := storage.Handler().One(p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// lockSubscription locks the subscription of id and gets it, unlock must be
+// called when done
+func lockSubscription(ctx context.Context, id string) (*subscription, func() error, error) {
+	sub := &subscription{
+		Subscription: subscriptionpb.Subscription{
+			Id: id,
+		},
+	}
+	unlock, err := locker.Handler().TryLockContext(ctx, sub, locker.DefaultTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := storage.Handler().One(sub); err != nil {
+		unlock()
+		return nil, nil, err
+	}
+	return sub, unlock, nil
+}
@@ -0,0 +1,487 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	_ "github.com/digota/digota/order/service"
+	_ "github.com/digota/digota/payment/service"
+	_ "github.com/digota/digota/product/service"
+	_ "github.com/digota/digota/promotion/service"
+	_ "github.com/digota/digota/shipping/service"
+	_ "github.com/digota/digota/sku/service"
+	_ "github.com/digota/digota/tax/service"
+)
+
+import (
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/order"
+	"github.com/digota/digota/order/orderpb"
+	"github.com/digota/digota/payment/paymentpb"
+	"github.com/digota/digota/payment/service/providers"
+	"github.com/digota/digota/product"
+	"github.com/digota/digota/product/productpb"
+	"github.com/digota/digota/sku"
+	"github.com/digota/digota/sku/skupb"
+	"github.com/digota/digota/storage"
+	subscriptionInterface "github.com/digota/digota/subscription"
+	"github.com/digota/digota/subscription/subscriptionpb"
+	"github.com/icrowley/fake"
+	"github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"os"
+	"testing"
+	"time"
+)
+
+var service = &subscriptionService{}
+var db = "testing-subscription-" + uuid.NewV4().String()
+
+func TestMain(m *testing.M) {
+	// storage
+	if err := storage.New(config.Storage{
+		Address:  []string{"localhost"},
+		Handler:  "mongodb",
+		Database: db,
+	}); err != nil {
+		panic(err)
+	}
+
+	// in-memory locker
+	locker.New(config.Locker{})
+
+	providers.New([]config.PaymentProvider{{Provider: "DigotaInternalTestOnly"}})
+
+	retCode := m.Run()
+	storage.Handler().DropDatabase(db)
+	// teardown
+	os.Exit(retCode)
+}
+
+func createSku(currency paymentpb.Currency) (*skupb.Sku, error) {
+	p, err := product.Service().New(context.Background(), &productpb.NewRequest{
+		Active:      true,
+		Name:        fake.Sentences(),
+		Description: fake.Sentences(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sku.Service().New(context.Background(), &skupb.NewRequest{
+		Parent:    p.GetId(),
+		Name:      fake.Sentences(),
+		Currency:  currency,
+		Active:    true,
+		Price:     1500,
+		Image:     "http://" + fake.Characters() + ".com",
+		Inventory: &skupb.Inventory{Type: skupb.Inventory_Infinite},
+	})
+}
+
+func createPlan(quantity int64, trialDays int32) (*subscriptionpb.Plan, error) {
+	s, err := createSku(paymentpb.Currency_USD)
+	if err != nil {
+		return nil, err
+	}
+	return service.NewPlan(context.Background(), &subscriptionpb.NewPlanRequest{
+		Name:      "Monthly box",
+		Sku:       s.GetId(),
+		Quantity:  quantity,
+		Interval:  subscriptionpb.Plan_Month,
+		TrialDays: trialDays,
+	})
+}
+
+func subscribe(p *subscriptionpb.Plan, email string) (*subscriptionpb.Subscription, error) {
+	return service.New(context.Background(), &subscriptionpb.NewRequest{
+		Plan:              p.GetId(),
+		Email:             email,
+		PaymentProviderId: paymentpb.PaymentProviderId_Stripe,
+		Card: &paymentpb.Card{
+			Type:        paymentpb.CardType_Visa,
+			CVC:         "123",
+			ExpireMonth: "12",
+			ExpireYear:  "2099",
+			FirstName:   "Yaron",
+			LastName:    "Sumel",
+			Number:      "4242424242424242",
+		},
+	})
+}
+
+func TestPlans_GetNamespace(t *testing.T) {
+	p := plans{}
+	if p.GetNamespace() != planNs {
+		t.FailNow()
+	}
+}
+
+func TestSubscriptions_GetNamespace(t *testing.T) {
+	s := subscriptions{}
+	if s.GetNamespace() != ns {
+		t.FailNow()
+	}
+}
+
+func TestPeriodEnd(t *testing.T) {
+	start := time.Date(2018, 1, 10, 0, 0, 0, 0, time.UTC)
+	for _, v := range []struct {
+		interval subscriptionpb.Plan_Interval
+		count    int32
+		end      time.Time
+	}{
+		{subscriptionpb.Plan_Day, 3, time.Date(2018, 1, 13, 0, 0, 0, 0, time.UTC)},
+		{subscriptionpb.Plan_Week, 2, time.Date(2018, 1, 24, 0, 0, 0, 0, time.UTC)},
+		{subscriptionpb.Plan_Month, 0, time.Date(2018, 2, 10, 0, 0, 0, 0, time.UTC)},
+		{subscriptionpb.Plan_Year, 1, time.Date(2019, 1, 10, 0, 0, 0, 0, time.UTC)},
+	} {
+		p := &subscriptionpb.Plan{Interval: v.interval, IntervalCount: v.count}
+		if end := periodEnd(start, p); !end.Equal(v.end) {
+			t.Fatal(v.interval, end)
+		}
+	}
+}
+
+func TestProrate(t *testing.T) {
+	for _, v := range []struct {
+		amount, start, end, now, res int64
+	}{
+		{1000, 0, 100, 25, 750},
+		{1000, 0, 100, 0, 1000},
+		{1000, 0, 100, -10, 1000},
+		{1000, 0, 100, 100, 0},
+		{1000, 0, 100, 200, 0},
+		{1000, 100, 100, 50, 0},
+	} {
+		if res := prorate(v.amount, v.start, v.end, v.now); res != v.res {
+			t.Fatal(v, res)
+		}
+	}
+}
+
+func TestService_NewPlan(t *testing.T) {
+	if _, err := service.NewPlan(context.Background(), &subscriptionpb.NewPlanRequest{
+		Name:     "Monthly box",
+		Sku:      uuid.NewV4().String(),
+		Interval: subscriptionpb.Plan_Month,
+	}); err == nil {
+		t.Fatal("plan of missing sku")
+	}
+
+	p, err := createPlan(0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.GetQuantity() != 1 || p.GetIntervalCount() != 1 || p.GetCurrency() != paymentpb.Currency_USD {
+		t.Fatal(p)
+	}
+
+	if got, err := service.GetPlan(context.Background(), &subscriptionpb.GetRequest{Id: p.GetId()}); err != nil || got.GetName() != p.GetName() {
+		t.Fatal(got, err)
+	}
+
+	if l, err := service.ListPlans(context.Background(), &subscriptionpb.ListRequest{}); err != nil || l.GetTotal() == 0 {
+		t.Fatal(l, err)
+	}
+}
+
+func TestService_New(t *testing.T) {
+	p, err := createPlan(2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := subscribe(p, "yaron@digota.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.GetStatus() != subscriptionpb.Subscription_Active || len(s.GetOrders()) != 1 || s.GetPeriodAmount() != 3000 {
+		t.Fatal(s)
+	}
+	if s.GetPaymentMethod().GetReference() == "" || s.GetPaymentMethod().GetLast4() != "4242" {
+		t.Fatal(s.GetPaymentMethod())
+	}
+	if end := periodEnd(time.Unix(s.GetCurrentPeriodStart(), 0), p); s.GetNextBilling() != end.Unix() || s.GetCurrentPeriodEnd() != end.Unix() {
+		t.Fatal(s)
+	}
+
+	o, err := order.Service().Get(context.Background(), &orderpb.GetRequest{Id: s.GetOrders()[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.GetStatus() != orderpb.Order_Paid || o.GetAmount() != 3000 || o.GetMetadata()["subscription"] != s.GetId() {
+		t.Fatal(o)
+	}
+
+	// declined cards are not subscribed
+	if _, err := subscribe(p, "error@error.com"); err == nil {
+		t.Fatal("declined card subscribed")
+	}
+
+	// nothing is charged during the trial
+	p, err = createPlan(1, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err = subscribe(p, "yaron@digota.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.GetStatus() != subscriptionpb.Subscription_Trialing || len(s.GetOrders()) != 0 || s.GetNextBilling() != s.GetTrialEnd() {
+		t.Fatal(s)
+	}
+}
+
+func TestService_Renew(t *testing.T) {
+	p, err := createPlan(1, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := subscribe(p, "yaron@digota.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// not due yet
+	if billed, err := renew(context.Background(), s.GetId(), time.Now()); err != nil || billed {
+		t.Fatal(billed, err)
+	}
+
+	trialEnd := time.Unix(s.GetTrialEnd(), 0)
+	if billed, err := renew(context.Background(), s.GetId(), trialEnd); err != nil || !billed {
+		t.Fatal(billed, err)
+	}
+
+	s, err = service.Get(context.Background(), &subscriptionpb.GetRequest{Id: s.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.GetStatus() != subscriptionpb.Subscription_Active || len(s.GetOrders()) != 1 {
+		t.Fatal(s)
+	}
+	// the first period starts when the trial ends
+	if s.GetCurrentPeriodStart() != trialEnd.Unix() || s.GetNextBilling() != periodEnd(trialEnd, p).Unix() {
+		t.Fatal(s)
+	}
+
+	// the scheduler bills only due subscriptions
+	if _, err := service.Renew(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if s, err = service.Get(context.Background(), &subscriptionpb.GetRequest{Id: s.GetId()}); err != nil || len(s.GetOrders()) != 1 {
+		t.Fatal(s, err)
+	}
+}
+
+func TestService_RenewFailed(t *testing.T) {
+	p, err := createPlan(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the stored payment method of error@error.com is declined
+	s, err := subscribe(p, "error@error.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Unix(s.GetNextBilling(), 0)
+	for i := 1; i <= subscriptionInterface.Retries(); i++ {
+		if billed, err := renew(context.Background(), s.GetId(), now); err != nil || billed {
+			t.Fatal(billed, err)
+		}
+		if s, err = service.Get(context.Background(), &subscriptionpb.GetRequest{Id: s.GetId()}); err != nil {
+			t.Fatal(err)
+		}
+		if s.GetStatus() != subscriptionpb.Subscription_PastDue || s.GetFailedAttempts() != int32(i) || s.GetLastError() == "" {
+			t.Fatal(s)
+		}
+		// retries are further apart with every failed attempt
+		if next := now.Add(subscriptionInterface.RetryInterval() * time.Duration(i)); s.GetNextBilling() != next.Unix() {
+			t.Fatal(s)
+		}
+		now = time.Unix(s.GetNextBilling(), 0)
+	}
+
+	// the retries are exhausted
+	if _, err := renew(context.Background(), s.GetId(), now); err != nil {
+		t.Fatal(err)
+	}
+	if s, err = service.Get(context.Background(), &subscriptionpb.GetRequest{Id: s.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	if s.GetStatus() != subscriptionpb.Subscription_Canceled || s.GetNextBilling() != 0 || len(s.GetOrders()) != 0 {
+		t.Fatal(s)
+	}
+}
+
+func TestService_PauseResume(t *testing.T) {
+	p, err := createPlan(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := subscribe(p, "yaron@digota.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paused, err := service.Pause(context.Background(), &subscriptionpb.PauseRequest{Id: s.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if paused.GetStatus() != subscriptionpb.Subscription_Paused || paused.GetNextBilling() != 0 || paused.GetPaused() == 0 {
+		t.Fatal(paused)
+	}
+
+	if _, err := service.Pause(context.Background(), &subscriptionpb.PauseRequest{Id: s.GetId()}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	if _, err := service.ChangePlan(context.Background(), &subscriptionpb.ChangePlanRequest{Id: s.GetId(), Plan: p.GetId()}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	resumed, err := service.Resume(context.Background(), &subscriptionpb.ResumeRequest{Id: s.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resumed.GetStatus() != subscriptionpb.Subscription_Active || resumed.GetPaused() != 0 {
+		t.Fatal(resumed)
+	}
+	// the period is extended by the pause
+	if resumed.GetCurrentPeriodEnd() < s.GetCurrentPeriodEnd() || resumed.GetNextBilling() != resumed.GetCurrentPeriodEnd() {
+		t.Fatal(resumed)
+	}
+
+	if _, err := service.Resume(context.Background(), &subscriptionpb.ResumeRequest{Id: s.GetId()}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+}
+
+func TestService_Cancel(t *testing.T) {
+	p, err := createPlan(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := subscribe(p, "yaron@digota.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := service.Cancel(context.Background(), &subscriptionpb.CancelRequest{Id: s.GetId(), AtPeriodEnd: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.GetStatus() != subscriptionpb.Subscription_Active || !c.GetCancelAtPeriodEnd() {
+		t.Fatal(c)
+	}
+
+	// canceled instead of renewed
+	if billed, err := renew(context.Background(), s.GetId(), time.Unix(s.GetCurrentPeriodEnd(), 0)); err != nil || billed {
+		t.Fatal(billed, err)
+	}
+	if c, err = service.Get(context.Background(), &subscriptionpb.GetRequest{Id: s.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	if c.GetStatus() != subscriptionpb.Subscription_Canceled || len(c.GetOrders()) != 1 {
+		t.Fatal(c)
+	}
+
+	if _, err := service.Cancel(context.Background(), &subscriptionpb.CancelRequest{Id: s.GetId()}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatal(err)
+	}
+
+	// canceled right away
+	s, err = subscribe(p, "yaron@digota.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c, err = service.Cancel(context.Background(), &subscriptionpb.CancelRequest{Id: s.GetId()}); err != nil || c.GetStatus() != subscriptionpb.Subscription_Canceled || c.GetCanceled() == 0 {
+		t.Fatal(c, err)
+	}
+}
+
+func TestService_ChangePlan(t *testing.T) {
+	small, err := createPlan(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	large, err := createPlan(2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := subscribe(small, "yaron@digota.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.ChangePlan(context.Background(), &subscriptionpb.ChangePlanRequest{Id: s.GetId(), Plan: small.GetId()}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+
+	// plans of other currencies can't be prorated
+	eur, err := createSku(paymentpb.Currency_EUR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	eurPlan, err := service.NewPlan(context.Background(), &subscriptionpb.NewPlanRequest{Name: "Euro box", Sku: eur.GetId(), Interval: subscriptionpb.Plan_Month})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.ChangePlan(context.Background(), &subscriptionpb.ChangePlanRequest{Id: s.GetId(), Plan: eurPlan.GetId()}); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+
+	c, err := service.ChangePlan(context.Background(), &subscriptionpb.ChangePlanRequest{Id: s.GetId(), Plan: large.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.GetPlan() != large.GetId() || c.GetStatus() != subscriptionpb.Subscription_Active || len(c.GetOrders()) != 2 || c.GetCredit() != 0 || c.GetPeriodAmount() != 3000 {
+		t.Fatal(c)
+	}
+
+	// the unused part of the small plan period is discounted
+	o, err := order.Service().Get(context.Background(), &orderpb.GetRequest{Id: c.GetOrders()[1]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.GetAmount() < 1500 || o.GetAmount() >= 3000 {
+		t.Fatal(o)
+	}
+
+	// downgrades keep the credit left for the next renewals
+	c, err = service.ChangePlan(context.Background(), &subscriptionpb.ChangePlanRequest{Id: s.GetId(), Plan: small.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.GetOrders()) != 3 {
+		t.Fatal(c)
+	}
+	if o, err = order.Service().Get(context.Background(), &orderpb.GetRequest{Id: c.GetOrders()[2]}); err != nil {
+		t.Fatal(err)
+	}
+	if o.GetAmount() != 1 || c.GetCredit() <= 0 || c.GetCredit() >= 3000 {
+		t.Fatal(o, c)
+	}
+}
@@ -0,0 +1,154 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package subscription
+
+import (
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/subscription/subscriptionpb"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"regexp"
+	"time"
+)
+
+const (
+	baseMethod = "^(.subscriptionpb.SubscriptionService/)"
+	// DefaultInterval is the time between renewal runs if not configured
+	DefaultInterval = time.Minute
+	// DefaultRetries is the number of retries of a failed renewal if not
+	// configured
+	DefaultRetries = 3
+	// DefaultRetryInterval is the time between retries of a failed renewal
+	// if not configured, it grows with every failed attempt
+	DefaultRetryInterval = time.Hour * 24
+)
+
+var service Interface
+
+var conf config.Subscription
+
+// Interface defines the functionality of the subscription service
+type Interface interface {
+	subscriptionpb.SubscriptionServiceServer
+}
+
+// Renewer is implemented by services which bill due subscriptions, Renew
+// returns the number of subscriptions it billed
+type Renewer interface {
+	Renew(ctx context.Context) (int, error)
+}
+
+// New saves the subscription config and starts the renewal scheduler of the
+// registered service in the background
+func New(c config.Subscription) {
+	conf = c
+	if r, ok := Service().(Renewer); ok {
+		go schedule(r, Interval())
+	}
+}
+
+// Interval returns the configured time between renewal runs
+func Interval() time.Duration {
+	if conf.Interval <= 0 {
+		return DefaultInterval
+	}
+	return conf.Interval
+}
+
+// Retries returns the configured number of retries of a failed renewal
+func Retries() int {
+	if conf.Retries <= 0 {
+		return DefaultRetries
+	}
+	return conf.Retries
+}
+
+// RetryInterval returns the configured time between retries of a failed
+// renewal
+func RetryInterval() time.Duration {
+	if conf.RetryInterval <= 0 {
+		return DefaultRetryInterval
+	}
+	return conf.RetryInterval
+}
+
+// schedule bills the due subscriptions every interval
+func schedule(r Renewer, interval time.Duration) {
+	for range time.Tick(interval) {
+		n, err := r.Renew(context.Background())
+		switch {
+		// another node is renewing
+		case status.Code(err) == codes.Aborted:
+		case err != nil:
+			log.Warnf("Subscription renewal failed => %s", err.Error())
+		case n > 0:
+			log.Infof("Subscription renewal billed %d subscriptions", n)
+		}
+	}
+}
+
+// RegisterService register p as the service provider
+func RegisterService(p Interface) {
+	if service != nil {
+		panic("SubscriptionService is already registered")
+	}
+	service = p
+}
+
+// RegisterSubscriptionServer register service to the grpc server
+func RegisterSubscriptionServer(server *grpc.Server) {
+	subscriptionpb.RegisterSubscriptionServiceServer(server, Service())
+}
+
+// Service returns the registered service
+func Service() Interface {
+	if service == nil {
+		panic("SubscriptionService is not registered")
+	}
+	return service
+}
+
+// ReadMethods returns regexp slice of readable methods, mostly used by the acl
+func ReadMethods() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		regexp.MustCompile(baseMethod + "Get"),
+		regexp.MustCompile(baseMethod + "List"),
+		regexp.MustCompile(baseMethod + "GetPlan"),
+		regexp.MustCompile(baseMethod + "ListPlans"),
+	}
+}
+
+// WriteMethods returns regexp slice of writable methods, mostly used by the acl
+func WriteMethods() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		regexp.MustCompile(baseMethod + "NewPlan"),
+		regexp.MustCompile(baseMethod + "New"),
+		regexp.MustCompile(baseMethod + "ChangePlan"),
+		regexp.MustCompile(baseMethod + "Pause"),
+		regexp.MustCompile(baseMethod + "Resume"),
+		regexp.MustCompile(baseMethod + "Cancel"),
+	}
+}
@@ -0,0 +1,176 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package subscription
+
+import (
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/subscription/subscriptionpb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+)
+
+// dummy service
+type dummyService struct{}
+
+// dummy implementations
+func (s *dummyService) NewPlan(context.Context, *subscriptionpb.NewPlanRequest) (*subscriptionpb.Plan, error) {
+	return nil, nil
+}
+func (s *dummyService) GetPlan(context.Context, *subscriptionpb.GetRequest) (*subscriptionpb.Plan, error) {
+	return nil, nil
+}
+func (s *dummyService) ListPlans(context.Context, *subscriptionpb.ListRequest) (*subscriptionpb.PlanList, error) {
+	return nil, nil
+}
+func (s *dummyService) New(context.Context, *subscriptionpb.NewRequest) (*subscriptionpb.Subscription, error) {
+	return nil, nil
+}
+func (s *dummyService) Get(context.Context, *subscriptionpb.GetRequest) (*subscriptionpb.Subscription, error) {
+	return nil, nil
+}
+func (s *dummyService) List(context.Context, *subscriptionpb.ListRequest) (*subscriptionpb.SubscriptionList, error) {
+	return nil, nil
+}
+func (s *dummyService) ChangePlan(context.Context, *subscriptionpb.ChangePlanRequest) (*subscriptionpb.Subscription, error) {
+	return nil, nil
+}
+func (s *dummyService) Pause(context.Context, *subscriptionpb.PauseRequest) (*subscriptionpb.Subscription, error) {
+	return nil, nil
+}
+func (s *dummyService) Resume(context.Context, *subscriptionpb.ResumeRequest) (*subscriptionpb.Subscription, error) {
+	return nil, nil
+}
+func (s *dummyService) Cancel(context.Context, *subscriptionpb.CancelRequest) (*subscriptionpb.Subscription, error) {
+	return nil, nil
+}
+
+// dummy renewer
+type dummyRenewer struct {
+	dummyService
+	renewed chan struct{}
+}
+
+func (s *dummyRenewer) Renew(context.Context) (int, error) {
+	select {
+	case s.renewed <- struct{}{}:
+	default:
+	}
+	return 0, nil
+}
+
+func TestNew(t *testing.T) {
+	defer func() { conf = config.Subscription{} }()
+
+	r := &dummyRenewer{renewed: make(chan struct{}, 1)}
+	service = r
+
+	New(config.Subscription{Interval: time.Millisecond, Retries: 5, RetryInterval: time.Hour})
+
+	if Interval() != time.Millisecond || Retries() != 5 || RetryInterval() != time.Hour {
+		t.Fatal(conf)
+	}
+
+	select {
+	case <-r.renewed:
+	case <-time.After(time.Second):
+		t.Fatal("scheduler did not run")
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	conf = config.Subscription{}
+	if Interval() != DefaultInterval || Retries() != DefaultRetries || RetryInterval() != DefaultRetryInterval {
+		t.Fatal()
+	}
+}
+
+func TestRegisterSubscriptionServer(t *testing.T) {
+	service = &dummyService{}
+	server := grpc.NewServer()
+	RegisterSubscriptionServer(server)
+}
+
+func TestRegisterService(t *testing.T) {
+	service = nil
+	s := &dummyService{}
+	RegisterService(s)
+	if !reflect.DeepEqual(service, s) {
+		t.Fatal()
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal(r)
+		}
+	}()
+	RegisterService(s)
+}
+
+func TestService(t *testing.T) {
+	service = &dummyService{}
+	if !reflect.DeepEqual(Service(), service) {
+		t.FailNow()
+	}
+	service = nil
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal(r)
+		}
+	}()
+	Service()
+}
+
+func TestReadMethods(t *testing.T) {
+	methods := []*regexp.Regexp{
+		regexp.MustCompile(baseMethod + "Get"),
+		regexp.MustCompile(baseMethod + "List"),
+		regexp.MustCompile(baseMethod + "GetPlan"),
+		regexp.MustCompile(baseMethod + "ListPlans"),
+	}
+	// check methods in same order
+	for k, v := range ReadMethods() {
+		if v.String() != methods[k].String() {
+			t.FailNow()
+		}
+	}
+}
+
+func TestWriteMethods(t *testing.T) {
+	methods := []*regexp.Regexp{
+		regexp.MustCompile(baseMethod + "NewPlan"),
+		regexp.MustCompile(baseMethod + "New"),
+		regexp.MustCompile(baseMethod + "ChangePlan"),
+		regexp.MustCompile(baseMethod + "Pause"),
+		regexp.MustCompile(baseMethod + "Resume"),
+		regexp.MustCompile(baseMethod + "Cancel"),
+	}
+	// check methods in same order
+	for k, v := range WriteMethods() {
+		if v.String() != methods[k].String() {
+			t.FailNow()
+		}
+	}
+}
@@ -0,0 +1,4645 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: subscription/subscriptionpb/subscription.proto
+
+/*
+	Package subscriptionpb is a generated protocol buffer package.
+
+	It is generated from these files:
+		subscription/subscriptionpb/subscription.proto
+
+	It has these top-level messages:
+		Plan
+		PlanList
+		Subscription
+		SubscriptionList
+		NewPlanRequest
+		GetRequest
+		ListRequest
+		NewRequest
+		ChangePlanRequest
+		PauseRequest
+		ResumeRequest
+		CancelRequest
+*/
+package subscriptionpb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import paymentpb "github.com/digota/digota/payment/paymentpb"
+import orderpb "github.com/digota/digota/order/orderpb"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Plan_Interval int32
+
+const (
+	Plan_Reserved Plan_Interval = 0
+	Plan_Day      Plan_Interval = 1
+	Plan_Week     Plan_Interval = 2
+	Plan_Month    Plan_Interval = 3
+	Plan_Year     Plan_Interval = 4
+)
+
+var Plan_Interval_name = map[int32]string{
+	0: "Reserved",
+	1: "Day",
+	2: "Week",
+	3: "Month",
+	4: "Year",
+}
+var Plan_Interval_value = map[string]int32{
+	"Reserved": 0,
+	"Day":      1,
+	"Week":     2,
+	"Month":    3,
+	"Year":     4,
+}
+
+func (x Plan_Interval) String() string {
+	return proto.EnumName(Plan_Interval_name, int32(x))
+}
+func (Plan_Interval) EnumDescriptor() ([]byte, []int) { return fileDescriptorSubscription, []int{0, 0} }
+
+type Subscription_Status int32
+
+const (
+	Subscription_Reserved Subscription_Status = 0
+	Subscription_Trialing Subscription_Status = 1
+	Subscription_Active   Subscription_Status = 2
+	// the last renewal failed and is retried
+	Subscription_PastDue  Subscription_Status = 3
+	Subscription_Paused   Subscription_Status = 4
+	Subscription_Canceled Subscription_Status = 5
+)
+
+var Subscription_Status_name = map[int32]string{
+	0: "Reserved",
+	1: "Trialing",
+	2: "Active",
+	3: "PastDue",
+	4: "Paused",
+	5: "Canceled",
+}
+var Subscription_Status_value = map[string]int32{
+	"Reserved": 0,
+	"Trialing": 1,
+	"Active":   2,
+	"PastDue":  3,
+	"Paused":   4,
+	"Canceled": 5,
+}
+
+func (x Subscription_Status) String() string {
+	return proto.EnumName(Subscription_Status_name, int32(x))
+}
+func (Subscription_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorSubscription, []int{2, 0}
+}
+
+// Plan bills quantity of the sku every intervalCount intervals, the plan
+// currency is the sku currency
+type Plan struct {
+	Id            string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
+	Name          string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Sku           string        `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity      int64         `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Interval      Plan_Interval `protobuf:"varint,5,opt,name=interval,proto3,enum=subscriptionpb.Plan_Interval" json:"interval,omitempty"`
+	IntervalCount int32         `protobuf:"varint,6,opt,name=intervalCount,proto3" json:"intervalCount,omitempty"`
+	// days before the first renewal, nothing is charged till then
+	TrialDays int32              `protobuf:"varint,7,opt,name=trialDays,proto3" json:"trialDays,omitempty"`
+	Currency  paymentpb.Currency `protobuf:"varint,8,opt,name=currency,proto3,enum=paymentpb.Currency" json:"currency,omitempty"`
+	Metadata  map[string]string  `protobuf:"bytes,9,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Created   int64              `protobuf:"varint,998,opt,name=created,proto3" json:"created,omitempty"`
+	Updated   int64              `protobuf:"varint,999,opt,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (m *Plan) Reset()                    { *m = Plan{} }
+func (m *Plan) String() string            { return proto.CompactTextString(m) }
+func (*Plan) ProtoMessage()               {}
+func (*Plan) Descriptor() ([]byte, []int) { return fileDescriptorSubscription, []int{0} }
+
+func (m *Plan) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Plan) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Plan) GetSku() string {
+	if m != nil {
+		return m.Sku
+	}
+	return ""
+}
+
+func (m *Plan) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *Plan) GetInterval() Plan_Interval {
+	if m != nil {
+		return m.Interval
+	}
+	return Plan_Reserved
+}
+
+func (m *Plan) GetIntervalCount() int32 {
+	if m != nil {
+		return m.IntervalCount
+	}
+	return 0
+}
+
+func (m *Plan) GetTrialDays() int32 {
+	if m != nil {
+		return m.TrialDays
+	}
+	return 0
+}
+
+func (m *Plan) GetCurrency() paymentpb.Currency {
+	if m != nil {
+		return m.Currency
+	}
+	return paymentpb.Currency_CUR_RESERVED
+}
+
+func (m *Plan) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Plan) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *Plan) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
+}
+
+type PlanList struct {
+	Plans []*Plan `protobuf:"bytes,1,rep,name=plans" json:"plans,omitempty"`
+	Total int32   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *PlanList) Reset()                    { *m = PlanList{} }
+func (m *PlanList) String() string            { return proto.CompactTextString(m) }
+func (*PlanList) ProtoMessage()               {}
+func (*PlanList) Descriptor() ([]byte, []int) { return fileDescriptorSubscription, []int{1} }
+
+func (m *PlanList) GetPlans() []*Plan {
+	if m != nil {
+		return m.Plans
+	}
+	return nil
+}
+
+func (m *PlanList) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type Subscription struct {
+	Id                 string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
+	Plan               string                   `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	Status             Subscription_Status      `protobuf:"varint,3,opt,name=status,proto3,enum=subscriptionpb.Subscription_Status" json:"status,omitempty"`
+	Email              string                   `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Shipping           *orderpb.Shipping        `protobuf:"bytes,5,opt,name=shipping" json:"shipping,omitempty"`
+	PaymentMethod      *paymentpb.PaymentMethod `protobuf:"bytes,6,opt,name=paymentMethod" json:"paymentMethod,omitempty"`
+	Currency           paymentpb.Currency       `protobuf:"varint,7,opt,name=currency,proto3,enum=paymentpb.Currency" json:"currency,omitempty"`
+	CurrentPeriodStart int64                    `protobuf:"varint,8,opt,name=currentPeriodStart,proto3" json:"currentPeriodStart,omitempty"`
+	CurrentPeriodEnd   int64                    `protobuf:"varint,9,opt,name=currentPeriodEnd,proto3" json:"currentPeriodEnd,omitempty"`
+	// amount paid for the current period, unused parts are credited on
+	// plan changes
+	PeriodAmount int64 `protobuf:"varint,10,opt,name=periodAmount,proto3" json:"periodAmount,omitempty"`
+	TrialEnd     int64 `protobuf:"varint,11,opt,name=trialEnd,proto3" json:"trialEnd,omitempty"`
+	// time of the next renewal or retry, empty if none
+	NextBilling int64 `protobuf:"varint,12,opt,name=nextBilling,proto3" json:"nextBilling,omitempty"`
+	// credit of earlier periods, discounted from the next renewals
+	Credit int64 `protobuf:"varint,13,opt,name=credit,proto3" json:"credit,omitempty"`
+	// failed renewals since the last paid one
+	FailedAttempts int32  `protobuf:"varint,14,opt,name=failedAttempts,proto3" json:"failedAttempts,omitempty"`
+	LastError      string `protobuf:"bytes,15,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	// renewal orders
+	Orders            []string          `protobuf:"bytes,16,rep,name=orders" json:"orders,omitempty"`
+	CancelAtPeriodEnd bool              `protobuf:"varint,17,opt,name=cancelAtPeriodEnd,proto3" json:"cancelAtPeriodEnd,omitempty"`
+	Paused            int64             `protobuf:"varint,18,opt,name=paused,proto3" json:"paused,omitempty"`
+	Canceled          int64             `protobuf:"varint,19,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	Metadata          map[string]string `protobuf:"bytes,20,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Created           int64             `protobuf:"varint,998,opt,name=created,proto3" json:"created,omitempty"`
+	Updated           int64             `protobuf:"varint,999,opt,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (m *Subscription) Reset()                    { *m = Subscription{} }
+func (m *Subscription) String() string            { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()               {}
+func (*Subscription) Descriptor() ([]byte, []int) { return fileDescriptorSubscription, []int{2} }
+
+func (m *Subscription) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Subscription) GetPlan() string {
+	if m != nil {
+		return m.Plan
+	}
+	return ""
+}
+
+func (m *Subscription) GetStatus() Subscription_Status {
+	if m != nil {
+		return m.Status
+	}
+	return Subscription_Reserved
+}
+
+func (m *Subscription) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *Subscription) GetShipping() *orderpb.Shipping {
+	if m != nil {
+		return m.Shipping
+	}
+	return nil
+}
+
+func (m *Subscription) GetPaymentMethod() *paymentpb.PaymentMethod {
+	if m != nil {
+		return m.PaymentMethod
+	}
+	return nil
+}
+
+func (m *Subscription) GetCurrency() paymentpb.Currency {
+	if m != nil {
+		return m.Currency
+	}
+	return paymentpb.Currency_CUR_RESERVED
+}
+
+func (m *Subscription) GetCurrentPeriodStart() int64 {
+	if m != nil {
+		return m.CurrentPeriodStart
+	}
+	return 0
+}
+
+func (m *Subscription) GetCurrentPeriodEnd() int64 {
+	if m != nil {
+		return m.CurrentPeriodEnd
+	}
+	return 0
+}
+
+func (m *Subscription) GetPeriodAmount() int64 {
+	if m != nil {
+		return m.PeriodAmount
+	}
+	return 0
+}
+
+func (m *Subscription) GetTrialEnd() int64 {
+	if m != nil {
+		return m.TrialEnd
+	}
+	return 0
+}
+
+func (m *Subscription) GetNextBilling() int64 {
+	if m != nil {
+		return m.NextBilling
+	}
+	return 0
+}
+
+func (m *Subscription) GetCredit() int64 {
+	if m != nil {
+		return m.Credit
+	}
+	return 0
+}
+
+func (m *Subscription) GetFailedAttempts() int32 {
+	if m != nil {
+		return m.FailedAttempts
+	}
+	return 0
+}
+
+func (m *Subscription) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *Subscription) GetOrders() []string {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *Subscription) GetCancelAtPeriodEnd() bool {
+	if m != nil {
+		return m.CancelAtPeriodEnd
+	}
+	return false
+}
+
+func (m *Subscription) GetPaused() int64 {
+	if m != nil {
+		return m.Paused
+	}
+	return 0
+}
+
+func (m *Subscription) GetCanceled() int64 {
+	if m != nil {
+		return m.Canceled
+	}
+	return 0
+}
+
+func (m *Subscription) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Subscription) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *Subscription) GetUpdated() int64 {
+	if m != nil {
+		return m.Updated
+	}
+	return 0
+}
+
+type SubscriptionList struct {
+	Subscriptions []*Subscription `protobuf:"bytes,1,rep,name=subscriptions" json:"subscriptions,omitempty"`
+	Total         int32           `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *SubscriptionList) Reset()                    { *m = SubscriptionList{} }
+func (m *SubscriptionList) String() string            { return proto.CompactTextString(m) }
+func (*SubscriptionList) ProtoMessage()               {}
+func (*SubscriptionList) Descriptor() ([]byte, []int) { return fileDescriptorSubscription, []int{3} }
+
+func (m *SubscriptionList) GetSubscriptions() []*Subscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+func (m *SubscriptionList) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type NewPlanRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" validate:"required,gt=0"`
+	Sku  string `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty" validate:"uuid4,required"`
+	// 1 if empty
+	Quantity int64         `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty" validate:"omitempty,gte=0"`
+	Interval Plan_Interval `protobuf:"varint,4,opt,name=interval,proto3,enum=subscriptionpb.Plan_Interval" json:"interval,omitempty" validate:"required,gte=1,lte=4"`
+	// 1 if empty
+	IntervalCount int32             `protobuf:"varint,5,opt,name=intervalCount,proto3" json:"intervalCount,omitempty" validate:"omitempty,gte=0"`
+	TrialDays     int32             `protobuf:"varint,6,opt,name=trialDays,proto3" json:"trialDays,omitempty" validate:"omitempty,gte=0"`
+	Metadata      map[string]string `protobuf:"bytes,7,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *NewPlanRequest) Reset()                    { *m = NewPlanRequest{} }
+func (m *NewPlanRequest) String() string            { return proto.CompactTextString(m) }
+func (*NewPlanRequest) ProtoMessage()               {}
+func (*NewPlanRequest) Descriptor() ([]byte, []int) { return fileDescriptorSubscription, []int{4} }
+
+func (m *NewPlanRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NewPlanRequest) GetSku() string {
+	if m != nil {
+		return m.Sku
+	}
+	return ""
+}
+
+func (m *NewPlanRequest) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *NewPlanRequest) GetInterval() Plan_Interval {
+	if m != nil {
+		return m.Interval
+	}
+	return Plan_Reserved
+}
+
+func (m *NewPlanRequest) GetIntervalCount() int32 {
+	if m != nil {
+		return m.IntervalCount
+	}
+	return 0
+}
+
+func (m *NewPlanRequest) GetTrialDays() int32 {
+	if m != nil {
+		return m.TrialDays
+	}
+	return 0
+}
+
+func (m *NewPlanRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type GetRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+}
+
+func (m *GetRequest) Reset()                    { *m = GetRequest{} }
+func (m *GetRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()               {}
+func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptorSubscription, []int{5} }
+
+func (m *GetRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ListRequest struct {
+	Page  int64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty" validate:"omitempty,required,gte=0"`
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" validate:"omitempty,required,gt=0"`
+}
+
+func (m *ListRequest) Reset()                    { *m = ListRequest{} }
+func (m *ListRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListRequest) ProtoMessage()               {}
+func (*ListRequest) Descriptor() ([]byte, []int) { return fileDescriptorSubscription, []int{6} }
+
+func (m *ListRequest) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *ListRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// NewRequest subscribes to the plan, the card is saved with the payment
+// provider and the first period is charged unless the plan has a trial
+type NewRequest struct {
+	Plan              string                      `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty" validate:"uuid4,required"`
+	Email             string                      `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty" validate:"required,email"`
+	Shipping          *orderpb.Shipping           `protobuf:"bytes,3,opt,name=shipping" json:"shipping,omitempty" validate:"omitempty,dive"`
+	Card              *paymentpb.Card             `protobuf:"bytes,4,opt,name=card" json:"card,omitempty" validate:"required,dive"`
+	PaymentProviderId paymentpb.PaymentProviderId `protobuf:"varint,5,opt,name=paymentProviderId,proto3,enum=paymentpb.PaymentProviderId" json:"paymentProviderId,omitempty" validate:"required,gte=1,lte=1"`
+	Metadata          map[string]string           `protobuf:"bytes,6,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *NewRequest) Reset()                    { *m = NewRequest{} }
+func (m *NewRequest) String() string            { return proto.CompactTextString(m) }
+func (*NewRequest) ProtoMessage()               {}
+func (*NewRequest) Descriptor() ([]byte, []int) { return fileDescriptorSubscription, []int{7} }
+
+func (m *NewRequest) GetPlan() string {
+	if m != nil {
+		return m.Plan
+	}
+	return ""
+}
+
+func (m *NewRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *NewRequest) GetShipping() *orderpb.Shipping {
+	if m != nil {
+		return m.Shipping
+	}
+	return nil
+}
+
+func (m *NewRequest) GetCard() *paymentpb.Card {
+	if m != nil {
+		return m.Card
+	}
+	return nil
+}
+
+func (m *NewRequest) GetPaymentProviderId() paymentpb.PaymentProviderId {
+	if m != nil {
+		return m.PaymentProviderId
+	}
+	return paymentpb.PaymentProviderId_PROVIDER_Reserved
+}
+
+func (m *NewRequest) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// ChangePlanRequest moves the subscription to the plan, the new plan period
+// starts right away and the unused part of the current period is credited
+type ChangePlanRequest struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+	Plan string `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty" validate:"uuid4,required"`
+}
+
+func (m *ChangePlanRequest) Reset()                    { *m = ChangePlanRequest{} }
+func (m *ChangePlanRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChangePlanRequest) ProtoMessage()               {}
+func (*ChangePlanRequest) Descriptor() ([]byte, []int) { return fileDescriptorSubscription, []int{8} }
+
+func (m *ChangePlanRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ChangePlanRequest) GetPlan() string {
+	if m != nil {
+		return m.Plan
+	}
+	return ""
+}
+
+type PauseRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+}
+
+func (m *PauseRequest) Reset()                    { *m = PauseRequest{} }
+func (m *PauseRequest) String() string            { return proto.CompactTextString(m) }
+func (*PauseRequest) ProtoMessage()               {}
+func (*PauseRequest) Descriptor() ([]byte, []int) { return fileDescriptorSubscription, []int{9} }
+
+func (m *PauseRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ResumeRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+}
+
+func (m *ResumeRequest) Reset()                    { *m = ResumeRequest{} }
+func (m *ResumeRequest) String() string            { return proto.CompactTextString(m) }
+func (*ResumeRequest) ProtoMessage()               {}
+func (*ResumeRequest) Descriptor() ([]byte, []int) { return fileDescriptorSubscription, []int{10} }
+
+func (m *ResumeRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type CancelRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"uuid4,required"`
+	// cancel when the current period ends instead of right away
+	AtPeriodEnd bool `protobuf:"varint,2,opt,name=atPeriodEnd,proto3" json:"atPeriodEnd,omitempty"`
+}
+
+func (m *CancelRequest) Reset()                    { *m = CancelRequest{} }
+func (m *CancelRequest) String() string            { return proto.CompactTextString(m) }
+func (*CancelRequest) ProtoMessage()               {}
+func (*CancelRequest) Descriptor() ([]byte, []int) { return fileDescriptorSubscription, []int{11} }
+
+func (m *CancelRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CancelRequest) GetAtPeriodEnd() bool {
+	if m != nil {
+		return m.AtPeriodEnd
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*Plan)(nil), "subscriptionpb.Plan")
+	proto.RegisterType((*PlanList)(nil), "subscriptionpb.PlanList")
+	proto.RegisterType((*Subscription)(nil), "subscriptionpb.Subscription")
+	proto.RegisterType((*SubscriptionList)(nil), "subscriptionpb.SubscriptionList")
+	proto.RegisterType((*NewPlanRequest)(nil), "subscriptionpb.NewPlanRequest")
+	proto.RegisterType((*GetRequest)(nil), "subscriptionpb.GetRequest")
+	proto.RegisterType((*ListRequest)(nil), "subscriptionpb.ListRequest")
+	proto.RegisterType((*NewRequest)(nil), "subscriptionpb.NewRequest")
+	proto.RegisterType((*ChangePlanRequest)(nil), "subscriptionpb.ChangePlanRequest")
+	proto.RegisterType((*PauseRequest)(nil), "subscriptionpb.PauseRequest")
+	proto.RegisterType((*ResumeRequest)(nil), "subscriptionpb.ResumeRequest")
+	proto.RegisterType((*CancelRequest)(nil), "subscriptionpb.CancelRequest")
+	proto.RegisterEnum("subscriptionpb.Plan_Interval", Plan_Interval_name, Plan_Interval_value)
+	proto.RegisterEnum("subscriptionpb.Subscription_Status", Subscription_Status_name, Subscription_Status_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for SubscriptionService service
+
+type SubscriptionServiceClient interface {
+	NewPlan(ctx context.Context, in *NewPlanRequest, opts ...grpc.CallOption) (*Plan, error)
+	GetPlan(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Plan, error)
+	ListPlans(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*PlanList, error)
+	New(ctx context.Context, in *NewRequest, opts ...grpc.CallOption) (*Subscription, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Subscription, error)
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*SubscriptionList, error)
+	ChangePlan(ctx context.Context, in *ChangePlanRequest, opts ...grpc.CallOption) (*Subscription, error)
+	Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*Subscription, error)
+	Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*Subscription, error)
+	Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*Subscription, error)
+}
+
+type subscriptionServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSubscriptionServiceClient(cc *grpc.ClientConn) SubscriptionServiceClient {
+	return &subscriptionServiceClient{cc}
+}
+
+func (c *subscriptionServiceClient) NewPlan(ctx context.Context, in *NewPlanRequest, opts ...grpc.CallOption) (*Plan, error) {
+	out := new(Plan)
+	err := grpc.Invoke(ctx, "/subscriptionpb.SubscriptionService/NewPlan", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) GetPlan(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Plan, error) {
+	out := new(Plan)
+	err := grpc.Invoke(ctx, "/subscriptionpb.SubscriptionService/GetPlan", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) ListPlans(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*PlanList, error) {
+	out := new(PlanList)
+	err := grpc.Invoke(ctx, "/subscriptionpb.SubscriptionService/ListPlans", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) New(ctx context.Context, in *NewRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	out := new(Subscription)
+	err := grpc.Invoke(ctx, "/subscriptionpb.SubscriptionService/New", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	out := new(Subscription)
+	err := grpc.Invoke(ctx, "/subscriptionpb.SubscriptionService/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*SubscriptionList, error) {
+	out := new(SubscriptionList)
+	err := grpc.Invoke(ctx, "/subscriptionpb.SubscriptionService/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) ChangePlan(ctx context.Context, in *ChangePlanRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	out := new(Subscription)
+	err := grpc.Invoke(ctx, "/subscriptionpb.SubscriptionService/ChangePlan", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	out := new(Subscription)
+	err := grpc.Invoke(ctx, "/subscriptionpb.SubscriptionService/Pause", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	out := new(Subscription)
+	err := grpc.Invoke(ctx, "/subscriptionpb.SubscriptionService/Resume", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	out := new(Subscription)
+	err := grpc.Invoke(ctx, "/subscriptionpb.SubscriptionService/Cancel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for SubscriptionService service
+
+type SubscriptionServiceServer interface {
+	NewPlan(context.Context, *NewPlanRequest) (*Plan, error)
+	GetPlan(context.Context, *GetRequest) (*Plan, error)
+	ListPlans(context.Context, *ListRequest) (*PlanList, error)
+	New(context.Context, *NewRequest) (*Subscription, error)
+	Get(context.Context, *GetRequest) (*Subscription, error)
+	List(context.Context, *ListRequest) (*SubscriptionList, error)
+	ChangePlan(context.Context, *ChangePlanRequest) (*Subscription, error)
+	Pause(context.Context, *PauseRequest) (*Subscription, error)
+	Resume(context.Context, *ResumeRequest) (*Subscription, error)
+	Cancel(context.Context, *CancelRequest) (*Subscription, error)
+}
+
+func RegisterSubscriptionServiceServer(s *grpc.Server, srv SubscriptionServiceServer) {
+	s.RegisterService(&_SubscriptionService_serviceDesc, srv)
+}
+
+func _SubscriptionService_NewPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).NewPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/subscriptionpb.SubscriptionService/NewPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).NewPlan(ctx, req.(*NewPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_GetPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).GetPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/subscriptionpb.SubscriptionService/GetPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).GetPlan(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_ListPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).ListPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/subscriptionpb.SubscriptionService/ListPlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).ListPlans(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_New_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).New(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/subscriptionpb.SubscriptionService/New",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).New(ctx, req.(*NewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/subscriptionpb.SubscriptionService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/subscriptionpb.SubscriptionService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_ChangePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).ChangePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/subscriptionpb.SubscriptionService/ChangePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).ChangePlan(ctx, req.(*ChangePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/subscriptionpb.SubscriptionService/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).Pause(ctx, req.(*PauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/subscriptionpb.SubscriptionService/Resume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).Resume(ctx, req.(*ResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/subscriptionpb.SubscriptionService/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).Cancel(ctx, req.(*CancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SubscriptionService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "subscriptionpb.SubscriptionService",
+	HandlerType: (*SubscriptionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NewPlan",
+			Handler:    _SubscriptionService_NewPlan_Handler,
+		},
+		{
+			MethodName: "GetPlan",
+			Handler:    _SubscriptionService_GetPlan_Handler,
+		},
+		{
+			MethodName: "ListPlans",
+			Handler:    _SubscriptionService_ListPlans_Handler,
+		},
+		{
+			MethodName: "New",
+			Handler:    _SubscriptionService_New_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _SubscriptionService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _SubscriptionService_List_Handler,
+		},
+		{
+			MethodName: "ChangePlan",
+			Handler:    _SubscriptionService_ChangePlan_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _SubscriptionService_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _SubscriptionService_Resume_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _SubscriptionService_Cancel_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "subscription/subscriptionpb/subscription.proto",
+}
+
+func (m *Plan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Plan) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Sku) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Sku)))
+		i += copy(dAtA[i:], m.Sku)
+	}
+	if m.Quantity != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Quantity))
+	}
+	if m.Interval != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Interval))
+	}
+	if m.IntervalCount != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.IntervalCount))
+	}
+	if m.TrialDays != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.TrialDays))
+	}
+	if m.Currency != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Currency))
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x4a
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovSubscription(uint64(len(k))) + 1 + len(v) + sovSubscription(uint64(len(v)))
+			i = encodeVarintSubscription(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintSubscription(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintSubscription(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.Created != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Created))
+	}
+	if m.Updated != 0 {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Updated))
+	}
+	return i, nil
+}
+
+func (m *PlanList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for _, msg := range m.Plans {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintSubscription(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Total != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Total))
+	}
+	return i, nil
+}
+
+func (m *Subscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Subscription) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Plan) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Plan)))
+		i += copy(dAtA[i:], m.Plan)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Status))
+	}
+	if len(m.Email) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Email)))
+		i += copy(dAtA[i:], m.Email)
+	}
+	if m.Shipping != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Shipping.Size()))
+		n1, err := m.Shipping.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.PaymentMethod != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.PaymentMethod.Size()))
+		n2, err := m.PaymentMethod.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.Currency != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Currency))
+	}
+	if m.CurrentPeriodStart != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.CurrentPeriodStart))
+	}
+	if m.CurrentPeriodEnd != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.CurrentPeriodEnd))
+	}
+	if m.PeriodAmount != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.PeriodAmount))
+	}
+	if m.TrialEnd != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.TrialEnd))
+	}
+	if m.NextBilling != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.NextBilling))
+	}
+	if m.Credit != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Credit))
+	}
+	if m.FailedAttempts != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.FailedAttempts))
+	}
+	if len(m.LastError) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.LastError)))
+		i += copy(dAtA[i:], m.LastError)
+	}
+	if len(m.Orders) > 0 {
+		for _, s := range m.Orders {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.CancelAtPeriodEnd {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.CancelAtPeriodEnd {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Paused != 0 {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Paused))
+	}
+	if m.Canceled != 0 {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Canceled))
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0xa2
+			i++
+			dAtA[i] = 0x1
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovSubscription(uint64(len(k))) + 1 + len(v) + sovSubscription(uint64(len(v)))
+			i = encodeVarintSubscription(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintSubscription(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintSubscription(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.Created != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Created))
+	}
+	if m.Updated != 0 {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x3e
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Updated))
+	}
+	return i, nil
+}
+
+func (m *SubscriptionList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, msg := range m.Subscriptions {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintSubscription(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Total != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Total))
+	}
+	return i, nil
+}
+
+func (m *NewPlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NewPlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Sku) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Sku)))
+		i += copy(dAtA[i:], m.Sku)
+	}
+	if m.Quantity != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Quantity))
+	}
+	if m.Interval != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Interval))
+	}
+	if m.IntervalCount != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.IntervalCount))
+	}
+	if m.TrialDays != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.TrialDays))
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x3a
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovSubscription(uint64(len(k))) + 1 + len(v) + sovSubscription(uint64(len(v)))
+			i = encodeVarintSubscription(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintSubscription(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintSubscription(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *ListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Page != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *NewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NewRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Plan) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Plan)))
+		i += copy(dAtA[i:], m.Plan)
+	}
+	if len(m.Email) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Email)))
+		i += copy(dAtA[i:], m.Email)
+	}
+	if m.Shipping != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Shipping.Size()))
+		n3, err := m.Shipping.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.Card != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.Card.Size()))
+		n4, err := m.Card.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.PaymentProviderId != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(m.PaymentProviderId))
+	}
+	if len(m.Metadata) > 0 {
+		for k, _ := range m.Metadata {
+			dAtA[i] = 0x32
+			i++
+			v := m.Metadata[k]
+			mapSize := 1 + len(k) + sovSubscription(uint64(len(k))) + 1 + len(v) + sovSubscription(uint64(len(v)))
+			i = encodeVarintSubscription(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintSubscription(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintSubscription(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *ChangePlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangePlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Plan) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Plan)))
+		i += copy(dAtA[i:], m.Plan)
+	}
+	return i, nil
+}
+
+func (m *PauseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *ResumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *CancelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.AtPeriodEnd {
+		dAtA[i] = 0x10
+		i++
+		if m.AtPeriodEnd {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func encodeFixed64Subscription(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Subscription(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintSubscription(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Plan) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	l = len(m.Sku)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovSubscription(uint64(m.Quantity))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovSubscription(uint64(m.Interval))
+	}
+	if m.IntervalCount != 0 {
+		n += 1 + sovSubscription(uint64(m.IntervalCount))
+	}
+	if m.TrialDays != 0 {
+		n += 1 + sovSubscription(uint64(m.TrialDays))
+	}
+	if m.Currency != 0 {
+		n += 1 + sovSubscription(uint64(m.Currency))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubscription(uint64(len(k))) + 1 + len(v) + sovSubscription(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubscription(uint64(mapEntrySize))
+		}
+	}
+	if m.Created != 0 {
+		n += 2 + sovSubscription(uint64(m.Created))
+	}
+	if m.Updated != 0 {
+		n += 2 + sovSubscription(uint64(m.Updated))
+	}
+	return n
+}
+
+func (m *PlanList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovSubscription(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 1 + sovSubscription(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *Subscription) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	l = len(m.Plan)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSubscription(uint64(m.Status))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	if m.Shipping != nil {
+		l = m.Shipping.Size()
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	if m.PaymentMethod != nil {
+		l = m.PaymentMethod.Size()
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	if m.Currency != 0 {
+		n += 1 + sovSubscription(uint64(m.Currency))
+	}
+	if m.CurrentPeriodStart != 0 {
+		n += 1 + sovSubscription(uint64(m.CurrentPeriodStart))
+	}
+	if m.CurrentPeriodEnd != 0 {
+		n += 1 + sovSubscription(uint64(m.CurrentPeriodEnd))
+	}
+	if m.PeriodAmount != 0 {
+		n += 1 + sovSubscription(uint64(m.PeriodAmount))
+	}
+	if m.TrialEnd != 0 {
+		n += 1 + sovSubscription(uint64(m.TrialEnd))
+	}
+	if m.NextBilling != 0 {
+		n += 1 + sovSubscription(uint64(m.NextBilling))
+	}
+	if m.Credit != 0 {
+		n += 1 + sovSubscription(uint64(m.Credit))
+	}
+	if m.FailedAttempts != 0 {
+		n += 1 + sovSubscription(uint64(m.FailedAttempts))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	if len(m.Orders) > 0 {
+		for _, s := range m.Orders {
+			l = len(s)
+			n += 2 + l + sovSubscription(uint64(l))
+		}
+	}
+	if m.CancelAtPeriodEnd {
+		n += 3
+	}
+	if m.Paused != 0 {
+		n += 2 + sovSubscription(uint64(m.Paused))
+	}
+	if m.Canceled != 0 {
+		n += 2 + sovSubscription(uint64(m.Canceled))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubscription(uint64(len(k))) + 1 + len(v) + sovSubscription(uint64(len(v)))
+			n += mapEntrySize + 2 + sovSubscription(uint64(mapEntrySize))
+		}
+	}
+	if m.Created != 0 {
+		n += 2 + sovSubscription(uint64(m.Created))
+	}
+	if m.Updated != 0 {
+		n += 2 + sovSubscription(uint64(m.Updated))
+	}
+	return n
+}
+
+func (m *SubscriptionList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovSubscription(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 1 + sovSubscription(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *NewPlanRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	l = len(m.Sku)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovSubscription(uint64(m.Quantity))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovSubscription(uint64(m.Interval))
+	}
+	if m.IntervalCount != 0 {
+		n += 1 + sovSubscription(uint64(m.IntervalCount))
+	}
+	if m.TrialDays != 0 {
+		n += 1 + sovSubscription(uint64(m.TrialDays))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubscription(uint64(len(k))) + 1 + len(v) + sovSubscription(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubscription(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	return n
+}
+
+func (m *ListRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Page != 0 {
+		n += 1 + sovSubscription(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovSubscription(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *NewRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Plan)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	if m.Shipping != nil {
+		l = m.Shipping.Size()
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	if m.Card != nil {
+		l = m.Card.Size()
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	if m.PaymentProviderId != 0 {
+		n += 1 + sovSubscription(uint64(m.PaymentProviderId))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubscription(uint64(len(k))) + 1 + len(v) + sovSubscription(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubscription(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ChangePlanRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	l = len(m.Plan)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	return n
+}
+
+func (m *ResumeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	if m.AtPeriodEnd {
+		n += 2
+	}
+	return n
+}
+
+func sovSubscription(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozSubscription(x uint64) (n int) {
+	return sovSubscription(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Plan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Plan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Plan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sku", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sku = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= (Plan_Interval(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalCount", wireType)
+			}
+			m.IntervalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrialDays", wireType)
+			}
+			m.TrialDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrialDays |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			m.Currency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Currency |= (paymentpb.Currency(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubscription
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubscription
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthSubscription
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Metadata[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Metadata[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 998:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 999:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			m.Updated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updated |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlanList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlanList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlanList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plans = append(m.Plans, &Plan{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Subscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plan = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (Subscription_Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shipping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Shipping == nil {
+				m.Shipping = &orderpb.Shipping{}
+			}
+			if err := m.Shipping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentMethod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PaymentMethod == nil {
+				m.PaymentMethod = &paymentpb.PaymentMethod{}
+			}
+			if err := m.PaymentMethod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			m.Currency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Currency |= (paymentpb.Currency(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPeriodStart", wireType)
+			}
+			m.CurrentPeriodStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentPeriodStart |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPeriodEnd", wireType)
+			}
+			m.CurrentPeriodEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentPeriodEnd |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodAmount", wireType)
+			}
+			m.PeriodAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodAmount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrialEnd", wireType)
+			}
+			m.TrialEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrialEnd |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBilling", wireType)
+			}
+			m.NextBilling = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextBilling |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credit", wireType)
+			}
+			m.Credit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Credit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAttempts", wireType)
+			}
+			m.FailedAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedAttempts |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelAtPeriodEnd", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CancelAtPeriodEnd = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			m.Paused = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Paused |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canceled", wireType)
+			}
+			m.Canceled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Canceled |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubscription
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubscription
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthSubscription
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Metadata[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Metadata[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 998:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 999:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			m.Updated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updated |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriptionList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriptionList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriptionList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, &Subscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewPlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewPlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sku", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sku = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= (Plan_Interval(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalCount", wireType)
+			}
+			m.IntervalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrialDays", wireType)
+			}
+			m.TrialDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrialDays |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubscription
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubscription
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthSubscription
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Metadata[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Metadata[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plan = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shipping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Shipping == nil {
+				m.Shipping = &orderpb.Shipping{}
+			}
+			if err := m.Shipping.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Card", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Card == nil {
+				m.Card = &paymentpb.Card{}
+			}
+			if err := m.Card.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentProviderId", wireType)
+			}
+			m.PaymentProviderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaymentProviderId |= (paymentpb.PaymentProviderId(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubscription
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubscription
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthSubscription
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Metadata[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Metadata[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangePlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangePlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangePlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plan = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResumeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtPeriodEnd", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AtPeriodEnd = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSubscription(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthSubscription
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowSubscription
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipSubscription(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthSubscription = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSubscription   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() {
+	proto.RegisterFile("subscription/subscriptionpb/subscription.proto", fileDescriptorSubscription)
+}
+
+var fileDescriptorSubscription = []byte{
+	// 1424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x12, 0x37, 0x45, 0xfd, 0x1d, 0xd9, 0x0e, 0xbd, 0x09, 0x1e, 0x18, 0x3d, 0x47, 0xd2, 0x63, 0x1e,
+	0x52, 0x21, 0x70, 0xe4, 0x44, 0xc9, 0x21, 0xb5, 0xe3, 0xa0, 0x92, 0xed, 0xb8, 0x01, 0x92, 0x54,
+	0xa0, 0x0b, 0x04, 0xed, 0xa1, 0xed, 0x4a, 0xdc, 0xc8, 0x8b, 0x50, 0x24, 0x43, 0x2e, 0x95, 0xea,
+	0x1b, 0xf4, 0xd2, 0x7b, 0xd1, 0x4f, 0xd4, 0x63, 0x4f, 0x3d, 0xaa, 0x45, 0x0a, 0xb4, 0x77, 0xdd,
+	0x7a, 0x2b, 0x76, 0x49, 0x4a, 0xa4, 0xfe, 0x59, 0x4d, 0x51, 0xa0, 0x17, 0x69, 0x67, 0x77, 0x66,
+	0x38, 0x33, 0xfb, 0x9b, 0xf9, 0x11, 0x84, 0xba, 0xe7, 0x77, 0xbc, 0xae, 0x4b, 0x1d, 0x46, 0x6d,
+	0x6b, 0x3f, 0x2e, 0x38, 0x9d, 0x84, 0x58, 0x77, 0x5c, 0x9b, 0xd9, 0x68, 0x3b, 0xa9, 0x52, 0xba,
+	0xd3, 0xa3, 0xec, 0xc2, 0xef, 0xd4, 0xbb, 0x76, 0x7f, 0xbf, 0x67, 0xf7, 0xec, 0x7d, 0xa1, 0xd6,
+	0xf1, 0x5f, 0x09, 0x49, 0x08, 0x62, 0x15, 0x98, 0x97, 0x1e, 0xc6, 0xd4, 0x0d, 0xda, 0xb3, 0x19,
+	0x8e, 0xfe, 0x1c, 0x3c, 0xec, 0x13, 0x8b, 0x45, 0xff, 0x4e, 0x27, 0x5a, 0x85, 0x96, 0x8d, 0xa5,
+	0x96, 0xb6, 0x6b, 0x10, 0x37, 0xf8, 0x75, 0x3a, 0xc1, 0x7f, 0x60, 0xa3, 0x7d, 0x9b, 0x86, 0x74,
+	0xdb, 0xc4, 0x16, 0x2a, 0x43, 0x8a, 0x1a, 0xaa, 0x54, 0x95, 0x6a, 0x85, 0xd6, 0xf6, 0x78, 0x54,
+	0x81, 0x8e, 0x67, 0x5b, 0x07, 0xda, 0x97, 0xd4, 0xd0, 0xf4, 0x14, 0x35, 0x10, 0x82, 0xb4, 0x85,
+	0xfb, 0x44, 0x4d, 0x71, 0x0d, 0x5d, 0xac, 0x91, 0x02, 0xb2, 0xf7, 0xda, 0x57, 0x65, 0xb1, 0xc5,
+	0x97, 0xa8, 0x04, 0xf9, 0x37, 0x3e, 0xb6, 0x18, 0x65, 0x43, 0x35, 0x5d, 0x95, 0x6a, 0xb2, 0x3e,
+	0x91, 0xd1, 0x87, 0x90, 0xa7, 0x16, 0x23, 0xee, 0x00, 0x9b, 0x6a, 0xa6, 0x2a, 0xd5, 0xb6, 0x1b,
+	0x37, 0xea, 0xc9, 0x52, 0xd5, 0x79, 0x24, 0xf5, 0xa7, 0xa1, 0x92, 0x3e, 0x51, 0x47, 0xff, 0x87,
+	0xad, 0x68, 0x7d, 0x6c, 0xfb, 0x16, 0x53, 0xb3, 0x55, 0xa9, 0x96, 0xd1, 0x93, 0x9b, 0x68, 0x17,
+	0x0a, 0xcc, 0xa5, 0xd8, 0x3c, 0xc1, 0x43, 0x4f, 0xcd, 0x09, 0x8d, 0xe9, 0x06, 0xda, 0x87, 0x7c,
+	0xd7, 0x77, 0x5d, 0x62, 0x75, 0x87, 0x6a, 0x5e, 0x3c, 0xfe, 0x6a, 0x7d, 0x52, 0xc9, 0xfa, 0x71,
+	0x78, 0xa4, 0x4f, 0x94, 0xd0, 0x63, 0xc8, 0xf7, 0x09, 0xc3, 0x06, 0x66, 0x58, 0x2d, 0x54, 0xe5,
+	0x5a, 0xb1, 0xa1, 0x2d, 0x8c, 0xf7, 0x79, 0xa8, 0x74, 0x6a, 0x31, 0x77, 0xa8, 0x4f, 0x6c, 0xd0,
+	0x75, 0xc8, 0x75, 0x5d, 0x82, 0x19, 0x31, 0xd4, 0xdf, 0x72, 0xa2, 0x16, 0x91, 0xcc, 0x8f, 0x7c,
+	0xc7, 0x10, 0x47, 0xbf, 0x87, 0x47, 0xa1, 0x5c, 0x3a, 0x84, 0xad, 0x84, 0x43, 0x5e, 0xe4, 0xd7,
+	0x64, 0x18, 0xdc, 0x8c, 0xce, 0x97, 0xe8, 0x1a, 0x64, 0x06, 0xd8, 0xf4, 0xa3, 0xbb, 0x08, 0x84,
+	0x83, 0xd4, 0x43, 0x49, 0xfb, 0x08, 0xf2, 0x51, 0xf5, 0xd0, 0x26, 0xe4, 0x75, 0xe2, 0x11, 0x77,
+	0x40, 0x0c, 0x65, 0x03, 0xe5, 0x40, 0x3e, 0xc1, 0x43, 0x45, 0x42, 0x79, 0x48, 0xbf, 0x24, 0xe4,
+	0xb5, 0x92, 0x42, 0x05, 0xc8, 0x3c, 0xb7, 0x2d, 0x76, 0xa1, 0xc8, 0x7c, 0xf3, 0x33, 0x82, 0x5d,
+	0x25, 0xad, 0x3d, 0x83, 0x3c, 0x4f, 0xea, 0x19, 0xf5, 0x18, 0xba, 0x0d, 0x19, 0xc7, 0xc4, 0x96,
+	0xa7, 0x4a, 0x22, 0xfb, 0x6b, 0x8b, 0xb2, 0xd7, 0x03, 0x15, 0x1e, 0x13, 0xb3, 0x19, 0x36, 0x45,
+	0x4c, 0x19, 0x3d, 0x10, 0xb4, 0x9f, 0x73, 0xb0, 0x79, 0x1e, 0x33, 0x5a, 0x07, 0x65, 0xdc, 0x5f,
+	0x84, 0x32, 0xbe, 0x46, 0x87, 0x90, 0xf5, 0x18, 0x66, 0xbe, 0x27, 0x80, 0xb6, 0xdd, 0xb8, 0x39,
+	0x1b, 0x47, 0xfc, 0x09, 0xf5, 0x73, 0xa1, 0xaa, 0x87, 0x26, 0x3c, 0x2e, 0xd2, 0xc7, 0xd4, 0x14,
+	0x68, 0x2c, 0xe8, 0x81, 0x80, 0xee, 0x40, 0xde, 0xbb, 0xa0, 0x8e, 0x43, 0xad, 0x9e, 0x80, 0x62,
+	0xb1, 0xb1, 0x53, 0x0f, 0xbb, 0xa3, 0x7e, 0x1e, 0x1e, 0xe8, 0x13, 0x15, 0xf4, 0x18, 0xb6, 0x42,
+	0xa4, 0x3c, 0x27, 0xec, 0xc2, 0x36, 0x04, 0xfc, 0x8a, 0x0d, 0x35, 0x86, 0x9f, 0x76, 0xfc, 0x5c,
+	0x4f, 0xaa, 0x27, 0xa0, 0x97, 0x5b, 0x07, 0x7a, 0x75, 0x40, 0xc1, 0x9a, 0xb5, 0x89, 0x4b, 0x6d,
+	0xe3, 0x9c, 0x61, 0x97, 0x09, 0xd4, 0xca, 0xfa, 0x82, 0x13, 0x74, 0x1b, 0x94, 0xc4, 0xee, 0xa9,
+	0x65, 0xa8, 0x05, 0xa1, 0x3d, 0xb7, 0x8f, 0x34, 0xd8, 0x74, 0x84, 0xd0, 0xec, 0x8b, 0x56, 0x02,
+	0xa1, 0x97, 0xd8, 0xe3, 0x6d, 0x2c, 0x1a, 0x87, 0xfb, 0x29, 0x06, 0x6d, 0x1c, 0xc9, 0xa8, 0x0a,
+	0x45, 0x8b, 0x7c, 0xcd, 0x5a, 0xd4, 0x34, 0x79, 0xf9, 0x36, 0xc5, 0x71, 0x7c, 0x0b, 0xfd, 0x07,
+	0xb2, 0x5d, 0x97, 0x18, 0x94, 0xa9, 0x5b, 0xe2, 0x30, 0x94, 0xd0, 0x2d, 0xd8, 0x7e, 0x85, 0xa9,
+	0x49, 0x8c, 0x26, 0x63, 0xa4, 0xef, 0x30, 0x4f, 0xdd, 0x16, 0x60, 0x99, 0xd9, 0xe5, 0x7d, 0x6c,
+	0x62, 0x8f, 0x9d, 0xba, 0xae, 0xed, 0xaa, 0x57, 0xc4, 0xbd, 0x4d, 0x37, 0xb8, 0x77, 0x71, 0x55,
+	0x9e, 0xaa, 0x54, 0xe5, 0x5a, 0x41, 0x0f, 0x25, 0xb4, 0x07, 0x3b, 0x5d, 0x6c, 0x75, 0x89, 0xd9,
+	0x8c, 0x15, 0x61, 0xa7, 0x2a, 0xd5, 0xf2, 0xfa, 0xfc, 0x01, 0xf7, 0xe2, 0x60, 0xdf, 0x23, 0x86,
+	0x8a, 0x82, 0x18, 0x03, 0x89, 0x67, 0x1e, 0x28, 0x13, 0x43, 0xbd, 0x1a, 0x64, 0x1e, 0xc9, 0xe8,
+	0x49, 0x6c, 0x20, 0x5c, 0x13, 0x2d, 0x71, 0x7b, 0x25, 0x14, 0xff, 0x45, 0x83, 0xe1, 0x25, 0x64,
+	0x83, 0xc6, 0x98, 0x19, 0x0b, 0x9b, 0x90, 0xff, 0x94, 0x5f, 0x2c, 0xb5, 0x7a, 0x8a, 0x84, 0x00,
+	0xb2, 0xcd, 0x2e, 0xa3, 0x03, 0xa2, 0xa4, 0x50, 0x11, 0x72, 0x6d, 0xec, 0xb1, 0x13, 0x9f, 0x28,
+	0x32, 0x3f, 0x68, 0x8b, 0xfa, 0x28, 0x69, 0x6e, 0x72, 0x1c, 0x56, 0x44, 0xc9, 0x68, 0x26, 0x28,
+	0xf1, 0x9c, 0xc5, 0xdc, 0x68, 0xc1, 0x56, 0xbc, 0x2c, 0xd1, 0xfc, 0xd8, 0x5d, 0x55, 0x2c, 0x3d,
+	0x69, 0xb2, 0x64, 0x9e, 0x7c, 0x9f, 0x86, 0xed, 0x17, 0xe4, 0xad, 0x18, 0x3c, 0xe4, 0x8d, 0x4f,
+	0x3c, 0x86, 0xee, 0x86, 0xbc, 0x14, 0xcc, 0x94, 0xdd, 0xf1, 0xa8, 0xa2, 0x0e, 0xb0, 0x49, 0x79,
+	0xcd, 0x0e, 0x34, 0x97, 0xbc, 0xf1, 0xa9, 0x4b, 0x8c, 0xbd, 0x1e, 0x3b, 0xba, 0xab, 0x85, 0xac,
+	0xb5, 0x1f, 0xb0, 0x96, 0xa8, 0x51, 0xeb, 0xc6, 0x78, 0x54, 0xb9, 0x3e, 0x35, 0xf0, 0x7d, 0x6a,
+	0x3c, 0xd8, 0x8b, 0xcc, 0xb4, 0x80, 0xd4, 0x0e, 0x62, 0xa4, 0xc6, 0x47, 0x90, 0xdc, 0x2a, 0x8f,
+	0x47, 0x95, 0xd2, 0xd4, 0xca, 0xee, 0x53, 0x01, 0xdd, 0xe1, 0x5e, 0x8f, 0x11, 0xfe, 0xa0, 0x89,
+	0x3e, 0xfa, 0x22, 0x46, 0x7a, 0xe9, 0x35, 0x48, 0xaf, 0x75, 0x73, 0x3c, 0xaa, 0x54, 0x16, 0x66,
+	0x40, 0x8e, 0xee, 0xed, 0x99, 0x8c, 0x1c, 0x3d, 0xd0, 0x62, 0xcc, 0x78, 0x32, 0xcb, 0x8c, 0x7c,
+	0x9c, 0x65, 0x2e, 0x0d, 0x30, 0x69, 0x84, 0x1e, 0xc5, 0x99, 0x33, 0xbb, 0x96, 0x87, 0xa9, 0x01,
+	0xfa, 0x38, 0xd6, 0x17, 0x39, 0x71, 0xd5, 0x7b, 0xb3, 0x39, 0x26, 0x2f, 0x6d, 0x59, 0x67, 0xfc,
+	0x3d, 0x8c, 0x1f, 0x02, 0x9c, 0x11, 0x16, 0xe1, 0xe2, 0x4e, 0x8c, 0x69, 0x2e, 0xb9, 0xe4, 0x14,
+	0x35, 0xb4, 0x6f, 0x24, 0x28, 0x72, 0xf0, 0x46, 0xe6, 0x87, 0x90, 0x76, 0x70, 0x2f, 0x80, 0x95,
+	0xdc, 0xfa, 0x60, 0x3c, 0xaa, 0xdc, 0x5c, 0x54, 0x8c, 0xc4, 0xf5, 0x70, 0x84, 0x71, 0x23, 0xf4,
+	0x08, 0x32, 0x26, 0xed, 0x53, 0x26, 0x62, 0x94, 0x5b, 0xb7, 0xc6, 0xa3, 0x8a, 0x76, 0x89, 0x35,
+	0x37, 0x0e, 0x8c, 0xb4, 0x3f, 0x64, 0x80, 0x17, 0xe4, 0x6d, 0x14, 0xc9, 0xbd, 0x90, 0x12, 0xd7,
+	0x4a, 0x45, 0xa8, 0xa2, 0xfb, 0x11, 0xe9, 0x2d, 0xc4, 0xf8, 0xe4, 0xa9, 0x42, 0x47, 0x8b, 0x38,
+	0xf1, 0x59, 0x8c, 0x13, 0xe5, 0x25, 0x9c, 0x38, 0xeb, 0x6a, 0x9a, 0x8a, 0x41, 0x07, 0x44, 0x8b,
+	0x51, 0x66, 0x13, 0xd2, 0x5d, 0xec, 0x1a, 0x02, 0xf3, 0xc5, 0xc6, 0x95, 0x38, 0xdd, 0x61, 0xd7,
+	0x58, 0xda, 0xa7, 0x81, 0x1b, 0x61, 0x8a, 0x6c, 0xd8, 0x09, 0xad, 0xda, 0xae, 0x3d, 0xa0, 0x06,
+	0x71, 0x9f, 0x1a, 0xe1, 0x8b, 0xe3, 0xee, 0x3c, 0xf3, 0x4e, 0x75, 0xd6, 0x68, 0xa1, 0x7b, 0x9a,
+	0x3e, 0xef, 0x1b, 0x9d, 0xc4, 0x70, 0x9c, 0x15, 0x38, 0xae, 0x2d, 0xc0, 0xf1, 0x3f, 0x8a, 0x61,
+	0x1f, 0x76, 0x8e, 0x2f, 0xb0, 0xd5, 0x23, 0xf1, 0x11, 0xf7, 0xd7, 0xa0, 0x3c, 0x01, 0x4c, 0x6a,
+	0x6d, 0xc0, 0x68, 0x47, 0xb0, 0x29, 0xe6, 0xfb, 0x7b, 0x36, 0xcf, 0x63, 0xd8, 0xd2, 0x89, 0xe7,
+	0xf7, 0xdf, 0xd7, 0xfe, 0x2b, 0xd8, 0x0a, 0x28, 0xe5, 0x3d, 0x33, 0xae, 0x42, 0x11, 0xc7, 0x48,
+	0x3f, 0x25, 0x48, 0x3f, 0xbe, 0xd5, 0xf8, 0x29, 0x03, 0x57, 0xe3, 0x74, 0x73, 0x4e, 0xdc, 0x01,
+	0xed, 0x12, 0xd4, 0x84, 0x5c, 0x38, 0x9a, 0x50, 0x79, 0xf5, 0xcc, 0x2a, 0x2d, 0x7c, 0xfd, 0xd5,
+	0x36, 0xd0, 0x11, 0xe4, 0xce, 0x08, 0x13, 0x2e, 0x4a, 0xb3, 0x2a, 0xd3, 0x79, 0xb4, 0xd4, 0xfc,
+	0x04, 0x0a, 0x7c, 0xee, 0xb4, 0xc5, 0x5b, 0xf4, 0x7f, 0x67, 0x95, 0x62, 0x23, 0xa9, 0xa4, 0x2e,
+	0xf2, 0xc0, 0x15, 0xb4, 0x0d, 0xd4, 0x04, 0xf9, 0x05, 0x79, 0x8b, 0x4a, 0xcb, 0xf1, 0x5a, 0x5a,
+	0x49, 0xbf, 0x81, 0x8b, 0x33, 0xc2, 0x56, 0xe6, 0x70, 0x99, 0x8b, 0x33, 0x48, 0x8b, 0x17, 0x80,
+	0x95, 0x69, 0x54, 0x57, 0x39, 0x09, 0xd3, 0xf9, 0x04, 0x60, 0xda, 0x06, 0xe8, 0x7f, 0xb3, 0x16,
+	0x73, 0x2d, 0x72, 0x69, 0x64, 0xa7, 0x90, 0x11, 0x00, 0x47, 0x73, 0x8a, 0x71, 0xdc, 0xaf, 0x91,
+	0x60, 0x36, 0x00, 0x3a, 0x9a, 0x63, 0xf1, 0x44, 0x03, 0xac, 0xe3, 0x28, 0x40, 0xfc, 0xbc, 0xa3,
+	0x44, 0x27, 0x5c, 0xe6, 0xa8, 0xf5, 0xe4, 0x87, 0x77, 0x65, 0xe9, 0xc7, 0x77, 0x65, 0xe9, 0x97,
+	0x77, 0x65, 0xe9, 0xbb, 0x5f, 0xcb, 0x1b, 0x9f, 0x3f, 0x58, 0xfa, 0x15, 0x60, 0xc5, 0x77, 0x8c,
+	0x4e, 0x56, 0x7c, 0x0e, 0xb8, 0xff, 0xe7, 0x00, 0x8c, 0x62, 0x24, 0x8b, 0xed, 0x10, 0x00, 0x00,
+}
@@ -0,0 +1,86 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tax
+
+import (
+	"strings"
+
+	"github.com/digota/digota/tax/taxpb"
+)
+
+// rateBase is the rate of 100%, rates are in hundredths of a percent
+const rateBase = 10000
+
+// Match returns the most specific active rate for the address and product
+// category or nil if none matches. rates of the category win over general
+// ones, then the longer postal prefix and then the state.
+func Match(rates []*taxpb.TaxRate, country, state, postalCode, category string) *taxpb.TaxRate {
+	var (
+		match *taxpb.TaxRate
+		best  = -1
+	)
+	postalCode = strings.ToUpper(strings.Replace(postalCode, " ", "", -1))
+	for _, r := range rates {
+		if !r.GetActive() || !strings.EqualFold(r.GetCountry(), country) {
+			continue
+		}
+		if r.GetState() != "" && !strings.EqualFold(r.GetState(), state) {
+			continue
+		}
+		prefix := strings.ToUpper(strings.Replace(r.GetPostalPrefix(), " ", "", -1))
+		if !strings.HasPrefix(postalCode, prefix) {
+			continue
+		}
+		if r.GetCategory() != "" && r.GetCategory() != category {
+			continue
+		}
+		score := len(prefix) << 1
+		if r.GetCategory() != "" {
+			score += 1 << 16
+		}
+		if r.GetState() != "" {
+			score++
+		}
+		if score > best {
+			match, best = r, score
+		}
+	}
+	return match
+}
+
+// Amount returns the tax of amount by r, for inclusive rates it is the part
+// of amount that is tax
+func Amount(r *taxpb.TaxRate, amount int64) int64 {
+	if r.GetInclusive() {
+		return amount - round(amount*rateBase, rateBase+r.GetRate())
+	}
+	return round(amount*r.GetRate(), rateBase)
+}
+
+// round returns a/b rounded half away from zero
+func round(a, b int64) int64 {
+	if a < 0 {
+		return -round(-a, b)
+	}
+	return (a + b/2) / b
+}
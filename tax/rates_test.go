@@ -0,0 +1,73 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package tax
+
+import (
+	"testing"
+
+	"github.com/digota/digota/tax/taxpb"
+)
+
+func TestMatch(t *testing.T) {
+	country := &taxpb.TaxRate{Id: "country", Active: true, Country: "US", Rate: 100}
+	state := &taxpb.TaxRate{Id: "state", Active: true, Country: "US", State: "CA", Rate: 725}
+	postal := &taxpb.TaxRate{Id: "postal", Active: true, Country: "US", State: "CA", PostalPrefix: "951", Rate: 925}
+	food := &taxpb.TaxRate{Id: "food", Active: true, Country: "US", Category: "food"}
+	inactive := &taxpb.TaxRate{Id: "inactive", Country: "US", PostalPrefix: "95123", Rate: 100}
+	rates := []*taxpb.TaxRate{country, state, postal, food, inactive}
+
+	for _, v := range []struct {
+		country, state, postal, category string
+		match                            *taxpb.TaxRate
+	}{
+		{"us", "NY", "10001", "", country},
+		{"US", "ca", "90001", "", state},
+		{"US", "CA", "95123", "", postal},
+		{"US", "CA", "95123", "food", food},
+		{"IL", "", "", "", nil},
+	} {
+		if m := Match(rates, v.country, v.state, v.postal, v.category); m != v.match {
+			t.Fatal(v, m)
+		}
+	}
+}
+
+func TestAmount(t *testing.T) {
+	// exclusive 17%
+	r := &taxpb.TaxRate{Rate: 1700}
+	if a := Amount(r, 1000); a != 170 {
+		t.Fatal(a)
+	}
+	// rounded
+	if a := Amount(r, 1003); a != 171 {
+		t.Fatal(a)
+	}
+	// inclusive 17%, 1170 = 1000 + 170
+	r.Inclusive = true
+	if a := Amount(r, 1170); a != 170 {
+		t.Fatal(a)
+	}
+	if a := Amount(r, 0); a != 0 {
+		t.Fatal(a)
+	}
+}
@@ -0,0 +1,238 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"strings"
+	"time"
+
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/storage"
+	"github.com/digota/digota/storage/object"
+	taxInterface "github.com/digota/digota/tax"
+	"github.com/digota/digota/tax/taxpb"
+	"github.com/digota/digota/validation"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const ns = "tax"
+
+func init() {
+	taxInterface.RegisterService(&taxService{})
+}
+
+type rates []*taxpb.TaxRate
+
+func (r *rates) GetNamespace() string { return ns }
+
+type rate struct {
+	taxpb.TaxRate `bson:",inline"`
+	fence         int64
+}
+
+func (r *rate) GetNamespace() string { return ns }
+
+func (r *rate) SetId(id string) { r.Id = id }
+
+func (r *rate) SetCreated(t int64) { r.Created = t }
+
+func (r *rate) SetUpdated(t int64) { r.Updated = t }
+
+func (r *rate) SetFence(t int64) { r.fence = t }
+
+func (r *rate) GetFence() int64 { return r.fence }
+
+type taxService struct{}
+
+// New
+func (s *taxService) New(ctx context.Context, req *taxpb.NewRequest) (*taxpb.TaxRate, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	r := &rate{
+		TaxRate: taxpb.TaxRate{
+			Name:         req.GetName(),
+			Active:       req.GetActive(),
+			Rate:         req.GetRate(),
+			Inclusive:    req.GetInclusive(),
+			Country:      strings.ToUpper(req.GetCountry()),
+			State:        req.GetState(),
+			PostalPrefix: req.GetPostalPrefix(),
+			Category:     req.GetCategory(),
+		},
+	}
+
+	return &r.TaxRate, storage.Handler().Insert(r)
+
+}
+
+// Get
+func (s *taxService) Get(ctx context.Context, req *taxpb.GetRequest) (*taxpb.TaxRate, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	r := &rate{
+		TaxRate: taxpb.TaxRate{
+			Id: req.GetId(),
+		},
+	}
+
+	unlock, err := locker.Handler().TryLockContext(ctx, r, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return &r.TaxRate, storage.Handler().One(r)
+
+}
+
+// Update
+func (s *taxService) Update(ctx context.Context, req *taxpb.UpdateRequest) (*taxpb.TaxRate, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	r := &rate{
+		TaxRate: taxpb.TaxRate{
+			Id: req.GetId(),
+		},
+	}
+
+	unlock, err := locker.Handler().TryLockContext(ctx, r, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if err := storage.Handler().One(r); err != nil {
+		return nil, err
+	}
+
+	// update fields and keep the rest the same
+
+	r.Active = req.GetActive()
+	r.Inclusive = req.GetInclusive()
+
+	if x := req.GetName(); x != "" {
+		r.Name = x
+	}
+
+	if x := req.GetRate(); x != 0 {
+		r.Rate = x
+	}
+
+	if x := req.GetCountry(); x != "" {
+		r.Country = strings.ToUpper(x)
+	}
+
+	if x := req.GetState(); x != "" {
+		r.State = x
+	}
+
+	if x := req.GetPostalPrefix(); x != "" {
+		r.PostalPrefix = x
+	}
+
+	if x := req.GetCategory(); x != "" {
+		r.Category = x
+	}
+
+	return &r.TaxRate, storage.Handler().Update(r)
+
+}
+
+// Delete
+func (s *taxService) Delete(ctx context.Context, req *taxpb.DeleteRequest) (*taxpb.Empty, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	r := &rate{
+		TaxRate: taxpb.TaxRate{
+			Id: req.GetId(),
+		},
+	}
+
+	unlock, err := locker.Handler().TryLockContext(ctx, r, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return &taxpb.Empty{}, storage.Handler().Remove(r)
+
+}
+
+// List
+func (s *taxService) List(ctx context.Context, req *taxpb.ListRequest) (*taxpb.TaxRateList, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	slice := &rates{}
+
+	n, err := storage.Handler().List(slice, object.ListOpt{
+		Limit: req.GetLimit(),
+		Page:  req.GetPage(),
+		Sort:  object.SortNatural,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &taxpb.TaxRateList{Rates: *slice, Total: int32(n)}, nil
+
+}
+
+// Rates returns the active rates of the country
+func (s *taxService) Rates(ctx context.Context, req *taxInterface.RatesRequest) ([]*taxpb.TaxRate, error) {
+
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+
+	slice := rates{}
+
+	if _, err := storage.Handler().List(&slice, object.ListOpt{
+		Filters: []object.Filter{
+			{Field: "country", Op: object.OpEq, Value: strings.ToUpper(req.Country)},
+			{Field: "active", Op: object.OpEq, Value: true},
+		},
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return slice, nil
+
+}
@@ -0,0 +1,192 @@
+// Digota <http://digota.com> - eCommerce microservice
+// Copyright (c) 2018 Yaron Sumel <yaron@digota.com>
+//
+// MIT License
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"github.com/digota/digota/config"
+	"github.com/digota/digota/locker"
+	"github.com/digota/digota/storage"
+	taxInterface "github.com/digota/digota/tax"
+	"github.com/digota/digota/tax/taxpb"
+	"github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+	"os"
+	"testing"
+	"time"
+)
+
+var service = &taxService{}
+var db = "testing-tax-" + uuid.NewV4().String()
+
+func TestMain(m *testing.M) {
+	// storage
+	if err := storage.New(config.Storage{
+		Address:  []string{"localhost"},
+		Handler:  "mongodb",
+		Database: db,
+	}); err != nil {
+		panic(err)
+	}
+
+	// in-memory locker
+	locker.New(config.Locker{})
+
+	retCode := m.Run()
+	storage.Handler().DropDatabase(db)
+	// teardown
+	os.Exit(retCode)
+}
+
+func TestRates_GetNamespace(t *testing.T) {
+	r := rates{}
+	if r.GetNamespace() != "tax" {
+		t.Fatal()
+	}
+}
+
+func TestRate_GetNamespace(t *testing.T) {
+	r := rate{}
+	if r.GetNamespace() != "tax" {
+		t.Fatal()
+	}
+}
+
+func TestRate_SetId(t *testing.T) {
+	r := rate{}
+	uid := uuid.NewV4().String()
+	r.SetId(uid)
+	if r.GetId() != uid {
+		t.Fatal()
+	}
+}
+
+func TestRate_SetCreated(t *testing.T) {
+	r := rate{}
+	ti := time.Now().Unix()
+	r.SetCreated(ti)
+	if r.Created != ti {
+		t.Fatal()
+	}
+}
+
+func TestTaxService_New(t *testing.T) {
+
+	// ok
+	r, err := service.New(context.Background(), &taxpb.NewRequest{
+		Name:    "VAT",
+		Active:  true,
+		Rate:    1700,
+		Country: "il",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.GetCountry() != "IL" {
+		t.Fatal(r)
+	}
+
+	// validation fail
+	if _, err := service.New(context.Background(), &taxpb.NewRequest{
+		Name: "VAT",
+	}); err == nil {
+		t.Fatal()
+	}
+
+}
+
+func TestTaxService_Update(t *testing.T) {
+
+	r, err := service.New(context.Background(), &taxpb.NewRequest{
+		Name:    "VAT",
+		Active:  true,
+		Rate:    1700,
+		Country: "IL",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r1, err := service.Update(context.Background(), &taxpb.UpdateRequest{
+		Id:        r.GetId(),
+		Active:    true,
+		Rate:      1800,
+		Inclusive: true,
+	})
+	if err != nil || r1.GetRate() != 1800 || !r1.GetInclusive() || r1.GetName() != "VAT" {
+		t.Fatal(err)
+	}
+
+}
+
+func TestTaxService_Rates(t *testing.T) {
+
+	country := uuid.NewV4().String()[:8]
+
+	for _, active := range []bool{true, false} {
+		if _, err := service.New(context.Background(), &taxpb.NewRequest{
+			Name:    "Sales tax",
+			Active:  active,
+			Rate:    725,
+			Country: country,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// active only
+	l, err := service.Rates(context.Background(), &taxInterface.RatesRequest{Country: country})
+	if err != nil || len(l) != 1 {
+		t.Fatal(err, l)
+	}
+
+}
+
+func TestTaxService_Delete(t *testing.T) {
+
+	r, err := service.New(context.Background(), &taxpb.NewRequest{
+		Name:    "VAT",
+		Rate:    1700,
+		Country: "IL",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.Delete(context.Background(), &taxpb.DeleteRequest{Id: r.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := service.Get(context.Background(), &taxpb.GetRequest{Id: r.GetId()}); err == nil {
+		t.Fatal()
+	}
+
+}
+
+func TestTaxService_List(t *testing.T) {
+
+	l, err := service.List(context.Background(), &taxpb.ListRequest{Limit: 10})
+	if err != nil || l.GetTotal() == 0 {
+		t.Fatal(err)
+	}
+
+}
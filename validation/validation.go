@@ -23,6 +23,8 @@
 package validation
 
 import (
+	"strings"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gopkg.in/go-playground/validator.v9"
@@ -41,3 +43,14 @@ func Validate(req interface{}) error {
 	}
 	return nil
 }
+
+// Metadata validates metadata keys, keys are stored as document fields
+// and can't be empty, contain dots or start with $
+func Metadata(m map[string]string) error {
+	for k := range m {
+		if k == "" || strings.Contains(k, ".") || strings.HasPrefix(k, "$") {
+			return status.Errorf(codes.InvalidArgument, "Invalid metadata key %q.", k)
+		}
+	}
+	return nil
+}
@@ -60,3 +60,17 @@ func TestValidate(t *testing.T) {
 	}()
 	Validate(&fail{A: "x"})
 }
+
+func TestMetadata(t *testing.T) {
+	if err := Metadata(map[string]string{"cart": "1", "campaign-tag": "x"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := Metadata(nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, k := range []string{"", "a.b", "$where"} {
+		if err := Metadata(map[string]string{k: "x"}); err == nil {
+			t.Fatal(k)
+		}
+	}
+}